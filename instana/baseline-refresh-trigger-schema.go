@@ -0,0 +1,41 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceFieldBaselineRefreshTrigger constant value for field baseline_refresh_trigger of smart alert configs which support the recalculation of historic baseline thresholds
+const ResourceFieldBaselineRefreshTrigger = "baseline_refresh_trigger"
+
+var baselineRefreshTriggerSchema = &schema.Schema{
+	Type: schema.TypeMap,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+	Optional:    true,
+	Description: "Arbitrary map of values which triggers the recalculation of the historic baseline threshold when changed",
+}
+
+// withBaselineRefreshTriggerSchema returns a copy of the given resource schema which additionally contains the baseline refresh trigger field
+func withBaselineRefreshTriggerSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(resourceSchema)+1)
+	for k, v := range resourceSchema {
+		result[k] = v
+	}
+	result[ResourceFieldBaselineRefreshTrigger] = baselineRefreshTriggerSchema
+	return result
+}
+
+// refreshBaselineOnTriggerChange triggers the recalculation of the historic baseline of the given alert configuration when the baseline refresh trigger has changed and returns the refreshed alert configuration
+func refreshBaselineOnTriggerChange[T restapi.EnableAwareInstanaDataObject](d *schema.ResourceData, config T, restResourceProvider func() restapi.BaselineAwareAlertConfigRestResource[T]) (T, error) {
+	if !d.HasChange(ResourceFieldBaselineRefreshTrigger) {
+		return config, nil
+	}
+	restResource := restResourceProvider()
+	id := config.GetIDForResourcePath()
+	if err := restResource.UpdateBaseline(id); err != nil {
+		return config, err
+	}
+	return restResource.GetOne(id)
+}
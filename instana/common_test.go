@@ -1,6 +1,7 @@
 package instana_test
 
 import (
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/require"
 	"log"
@@ -9,10 +10,12 @@ import (
 	"testing"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
 	"github.com/gorilla/mux"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const contentType = "Content-Type"
@@ -130,12 +133,13 @@ func testStepImport(resourceName string) resource.TestStep {
 	}
 }
 
-func testStepImportWithCustomID(resourceName string, resourceID string) resource.TestStep {
+func testStepImportWithCustomID(resourceName string, resourceID string, ignoredFields ...string) resource.TestStep {
 	return resource.TestStep{
-		ResourceName:      resourceName,
-		ImportState:       true,
-		ImportStateVerify: true,
-		ImportStateId:     resourceID,
+		ResourceName:            resourceName,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateId:           resourceID,
+		ImportStateVerifyIgnore: ignoredFields,
 	}
 }
 
@@ -155,3 +159,16 @@ func setValueOnResourceData(t *testing.T, r *schema.ResourceData, key string, va
 	err := r.Set(key, value)
 	require.NoError(t, err)
 }
+
+func createResourceDataWithChangesForResourceHandle[T restapi.InstanaDataObject](t *testing.T, resourceHandle ResourceHandle[T], id string, previousData map[string]interface{}, data map[string]interface{}) *schema.ResourceData {
+	previous := schema.TestResourceDataRaw(t, resourceHandle.MetaData().Schema, previousData)
+	previous.SetId(id)
+	state := previous.State()
+
+	schemaMap := schema.InternalMap(resourceHandle.MetaData().Schema)
+	diff, err := schemaMap.Diff(context.Background(), state, terraform.NewResourceConfigRaw(data), nil, nil, true)
+	require.NoError(t, err)
+	result, err := schemaMap.Data(state, diff)
+	require.NoError(t, err)
+	return result
+}
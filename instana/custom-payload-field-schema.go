@@ -173,7 +173,7 @@ func mapDynamicCustomFieldValueFromSchema(key string, dynamicValue map[string]in
 
 func extractDynamicCustomPayloadFieldValue(field map[string]interface{}) (map[string]interface{}, bool) {
 	val, ok := field[CustomPayloadFieldsFieldDynamicValue]
-	if ok && len(val.([]interface{})) > 0 {
+	if ok && len(val.([]interface{})) > 0 && val.([]interface{})[0] != nil {
 		return val.([]interface{})[0].(map[string]interface{}), true
 	}
 	return nil, false
@@ -0,0 +1,173 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewAlertConfigVersionsDataSource creates a new DataSource for the version history of alert configurations
+func NewAlertConfigVersionsDataSource() DataSource {
+	return &alertConfigVersionsDataSource{}
+}
+
+const (
+	//AlertConfigVersionsFieldAlertConfigID constant value for the schema field alert_config_id
+	AlertConfigVersionsFieldAlertConfigID = "alert_config_id"
+	//AlertConfigVersionsFieldAlertConfigType constant value for the schema field alert_config_type
+	AlertConfigVersionsFieldAlertConfigType = "alert_config_type"
+	//AlertConfigVersionsFieldVersions constant value for the computed schema field versions
+	AlertConfigVersionsFieldVersions = "versions"
+	//AlertConfigVersionsFieldVersionCreated constant value for the computed schema field versions.created
+	AlertConfigVersionsFieldVersionCreated = "created"
+	//AlertConfigVersionsFieldVersionEnabled constant value for the computed schema field versions.enabled
+	AlertConfigVersionsFieldVersionEnabled = "enabled"
+	//AlertConfigVersionsFieldVersionDeleted constant value for the computed schema field versions.deleted
+	AlertConfigVersionsFieldVersionDeleted = "deleted"
+	//AlertConfigVersionsFieldVersionChangeType constant value for the computed schema field versions.change_type
+	AlertConfigVersionsFieldVersionChangeType = "change_type"
+	//AlertConfigVersionsFieldVersionAuthorID constant value for the computed schema field versions.author_id
+	AlertConfigVersionsFieldVersionAuthorID = "author_id"
+	//AlertConfigVersionsFieldVersionAuthorType constant value for the computed schema field versions.author_type
+	AlertConfigVersionsFieldVersionAuthorType = "author_type"
+	//DataSourceAlertConfigVersions the name of the terraform-provider-instana data source for the version history of alert configurations
+	DataSourceAlertConfigVersions = "instana_alert_config_versions"
+
+	//AlertConfigTypeApplication alert config type of application alert configs (instana_application_alert_config)
+	AlertConfigTypeApplication = "application"
+	//AlertConfigTypeGlobalApplication alert config type of global application alert configs (instana_global_application_alert_config)
+	AlertConfigTypeGlobalApplication = "global_application"
+	//AlertConfigTypeWebsite alert config type of website alert configs (instana_website_alert_config)
+	AlertConfigTypeWebsite = "website"
+)
+
+var supportedAlertConfigTypes = []string{AlertConfigTypeApplication, AlertConfigTypeGlobalApplication, AlertConfigTypeWebsite}
+
+type alertConfigVersionsDataSource struct{}
+
+// CreateResource creates the resource handle for the version history of alert configurations
+func (ds *alertConfigVersionsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			AlertConfigVersionsFieldAlertConfigID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The ID of the alert configuration",
+			},
+			AlertConfigVersionsFieldAlertConfigType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(supportedAlertConfigTypes, false),
+				Description:  fmt.Sprintf("The type of the alert configuration. Supported values: %v", supportedAlertConfigTypes),
+			},
+			AlertConfigVersionsFieldVersions: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the alert configuration sorted descending by their creation time",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						AlertConfigVersionsFieldVersionCreated: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The unix timestamp in milliseconds when the version was created. The timestamp identifies the version when restoring it",
+						},
+						AlertConfigVersionsFieldVersionEnabled: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag indicating whether the alert configuration was enabled in this version",
+						},
+						AlertConfigVersionsFieldVersionDeleted: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag indicating whether the alert configuration was deleted in this version",
+						},
+						AlertConfigVersionsFieldVersionChangeType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the change which created this version (e.g. CREATE, UPDATE, ENABLE, DISABLE, RESTORE); empty when not provided by the Instana API",
+						},
+						AlertConfigVersionsFieldVersionAuthorID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the author of the change; empty when not provided by the Instana API",
+						},
+						AlertConfigVersionsFieldVersionAuthorType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the author of the change (e.g. API, USER, INSTANA); empty when not provided by the Instana API",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *alertConfigVersionsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+	alertConfigID := d.Get(AlertConfigVersionsFieldAlertConfigID).(string)
+
+	restResource, err := ds.getRestResource(instanaAPI, d.Get(AlertConfigVersionsFieldAlertConfigType).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	versions, err := restResource.GetVersions(alertConfigID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, alertConfigID, *versions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *alertConfigVersionsDataSource) getRestResource(api restapi.InstanaAPI, alertConfigType string) (restapi.VersionsAwareRestResource, error) {
+	switch alertConfigType {
+	case AlertConfigTypeApplication:
+		return api.ApplicationAlertConfigs(), nil
+	case AlertConfigTypeGlobalApplication:
+		return api.GlobalApplicationAlertConfigs(), nil
+	case AlertConfigTypeWebsite:
+		return api.WebsiteAlertConfig(), nil
+	}
+	return nil, fmt.Errorf("unsupported alert config type %s", alertConfigType)
+}
+
+func (ds *alertConfigVersionsDataSource) updateState(d *schema.ResourceData, alertConfigID string, versions []*restapi.ConfigVersion) error {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Created > versions[j].Created
+	})
+	versionsState := make([]interface{}, len(versions))
+	for i, version := range versions {
+		versionState := map[string]interface{}{
+			AlertConfigVersionsFieldVersionCreated:    int(version.Created),
+			AlertConfigVersionsFieldVersionEnabled:    version.Enabled,
+			AlertConfigVersionsFieldVersionDeleted:    version.Deleted,
+			AlertConfigVersionsFieldVersionChangeType: "",
+			AlertConfigVersionsFieldVersionAuthorID:   "",
+			AlertConfigVersionsFieldVersionAuthorType: "",
+		}
+		if version.ChangeSummary != nil {
+			versionState[AlertConfigVersionsFieldVersionChangeType] = version.ChangeSummary.ChangeType
+			versionState[AlertConfigVersionsFieldVersionAuthorID] = version.ChangeSummary.Author.ID
+			versionState[AlertConfigVersionsFieldVersionAuthorType] = version.ChangeSummary.Author.Type
+		}
+		versionsState[i] = versionState
+	}
+
+	d.SetId(alertConfigID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		AlertConfigVersionsFieldVersions: versionsState,
+	})
+}
@@ -0,0 +1,172 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const alertConfigVersionsTestID = "alert-config-id"
+
+func TestAlertConfigVersionsDataSource(t *testing.T) {
+	unitTest := &dataSourceAlertConfigVersionsUnitTest{}
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should return versions of application alert config sorted descending by creation time", unitTest.shouldReturnVersionsOfApplicationAlertConfigSortedDescendingByCreationTime)
+	t.Run("should return versions of global application alert config", unitTest.shouldReturnVersionsOfGlobalApplicationAlertConfig)
+	t.Run("should return versions of website alert config", unitTest.shouldReturnVersionsOfWebsiteAlertConfig)
+	t.Run("should fail to read versions when api call fails", unitTest.shouldFailToReadVersionsWhenApiCallFails)
+	t.Run("should fail to read versions when alert config type is not supported", unitTest.shouldFailToReadVersionsWhenAlertConfigTypeIsNotSupported)
+}
+
+type dataSourceAlertConfigVersionsUnitTest struct{}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewAlertConfigVersionsDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 3)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertConfigVersionsFieldAlertConfigID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertConfigVersionsFieldAlertConfigType)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertConfigVersionsFieldVersions)
+
+	versionSchema := schemaData[AlertConfigVersionsFieldVersions].Elem.(*schema.Resource).Schema
+	require.Len(t, versionSchema, 6)
+	versionSchemaAssert := testutils.NewTerraformSchemaAssert(versionSchema, t)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeInt(AlertConfigVersionsFieldVersionCreated)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeBool(AlertConfigVersionsFieldVersionEnabled)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeBool(AlertConfigVersionsFieldVersionDeleted)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertConfigVersionsFieldVersionChangeType)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertConfigVersionsFieldVersionAuthorID)
+	versionSchemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertConfigVersionsFieldVersionAuthorType)
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) createVersions() *[]*restapi.ConfigVersion {
+	return &[]*restapi.ConfigVersion{
+		{ID: alertConfigVersionsTestID, Created: 1000, Enabled: true, ChangeSummary: &restapi.ChangeSummary{Author: restapi.ChangeAuthor{ID: "api-token-id", Type: "API"}, ChangeType: "CREATE"}},
+		{ID: alertConfigVersionsTestID, Created: 3000, Enabled: false, Deleted: true},
+		{ID: alertConfigVersionsTestID, Created: 2000, Enabled: false, ChangeSummary: &restapi.ChangeSummary{Author: restapi.ChangeAuthor{ID: "user-id", Type: "USER"}, ChangeType: "DISABLE"}},
+	}
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) read(t *testing.T, meta *ProviderMeta, alertConfigType string) *schema.ResourceData {
+	sut := NewAlertConfigVersionsDataSource().CreateResource()
+	resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+		AlertConfigVersionsFieldAlertConfigID:   alertConfigVersionsTestID,
+		AlertConfigVersionsFieldAlertConfigType: alertConfigType,
+	})
+
+	diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+	require.Nil(t, diag)
+	require.Equal(t, alertConfigVersionsTestID, resourceData.Id())
+	return resourceData
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) createdTimestamps(resourceData *schema.ResourceData) []int {
+	result := make([]int, 0)
+	for _, v := range resourceData.Get(AlertConfigVersionsFieldVersions).([]interface{}) {
+		result = append(result, v.(map[string]interface{})[AlertConfigVersionsFieldVersionCreated].(int))
+	}
+	return result
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) shouldReturnVersionsOfApplicationAlertConfigSortedDescendingByCreationTime(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		restResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.ApplicationAlertConfig](ctrl)
+		restResource.EXPECT().GetVersions(alertConfigVersionsTestID).Times(1).Return(r.createVersions(), nil)
+		mockInstanaApi.EXPECT().ApplicationAlertConfigs().Return(restResource).Times(1)
+
+		resourceData := r.read(t, meta, AlertConfigTypeApplication)
+
+		require.Equal(t, []int{3000, 2000, 1000}, r.createdTimestamps(resourceData))
+		require.Equal(t, map[string]interface{}{
+			AlertConfigVersionsFieldVersionCreated:    2000,
+			AlertConfigVersionsFieldVersionEnabled:    false,
+			AlertConfigVersionsFieldVersionDeleted:    false,
+			AlertConfigVersionsFieldVersionChangeType: "DISABLE",
+			AlertConfigVersionsFieldVersionAuthorID:   "user-id",
+			AlertConfigVersionsFieldVersionAuthorType: "USER",
+		}, resourceData.Get(AlertConfigVersionsFieldVersions).([]interface{})[1])
+		require.Equal(t, map[string]interface{}{
+			AlertConfigVersionsFieldVersionCreated:    3000,
+			AlertConfigVersionsFieldVersionEnabled:    false,
+			AlertConfigVersionsFieldVersionDeleted:    true,
+			AlertConfigVersionsFieldVersionChangeType: "",
+			AlertConfigVersionsFieldVersionAuthorID:   "",
+			AlertConfigVersionsFieldVersionAuthorType: "",
+		}, resourceData.Get(AlertConfigVersionsFieldVersions).([]interface{})[0])
+	})
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) shouldReturnVersionsOfGlobalApplicationAlertConfig(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		restResource := mocks.NewMockAlertConfigRestResource[*restapi.ApplicationAlertConfig](ctrl)
+		restResource.EXPECT().GetVersions(alertConfigVersionsTestID).Times(1).Return(r.createVersions(), nil)
+		mockInstanaApi.EXPECT().GlobalApplicationAlertConfigs().Return(restResource).Times(1)
+
+		resourceData := r.read(t, meta, AlertConfigTypeGlobalApplication)
+
+		require.Equal(t, []int{3000, 2000, 1000}, r.createdTimestamps(resourceData))
+	})
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) shouldReturnVersionsOfWebsiteAlertConfig(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		restResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.WebsiteAlertConfig](ctrl)
+		restResource.EXPECT().GetVersions(alertConfigVersionsTestID).Times(1).Return(r.createVersions(), nil)
+		mockInstanaApi.EXPECT().WebsiteAlertConfig().Return(restResource).Times(1)
+
+		resourceData := r.read(t, meta, AlertConfigTypeWebsite)
+
+		require.Equal(t, []int{3000, 2000, 1000}, r.createdTimestamps(resourceData))
+	})
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) shouldFailToReadVersionsWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+		restResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.ApplicationAlertConfig](ctrl)
+		restResource.EXPECT().GetVersions(alertConfigVersionsTestID).Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().ApplicationAlertConfigs().Return(restResource).Times(1)
+
+		sut := NewAlertConfigVersionsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			AlertConfigVersionsFieldAlertConfigID:   alertConfigVersionsTestID,
+			AlertConfigVersionsFieldAlertConfigType: AlertConfigTypeApplication,
+		})
+
+		diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+	})
+}
+
+func (r *dataSourceAlertConfigVersionsUnitTest) shouldFailToReadVersionsWhenAlertConfigTypeIsNotSupported(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		sut := NewAlertConfigVersionsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			AlertConfigVersionsFieldAlertConfigID:   alertConfigVersionsTestID,
+			AlertConfigVersionsFieldAlertConfigType: "invalid",
+		})
+
+		diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "unsupported alert config type invalid")
+	})
+}
@@ -41,6 +41,10 @@ func (ds *alertingChannelDataSource) convertSchemaMap(schemaMap map[string]*sche
 	result := make(map[string]*schema.Schema)
 
 	for k, v := range schemaMap {
+		if k == AlertingChannelFieldVerifyOnApply || k == AlertingChannelFieldVerificationFailureSeverity {
+			//the verification of alerting channels is only applicable to the resource
+			continue
+		}
 		if k == AlertingChannelFieldName {
 			//for the key we assume a simple type. Here we copy the schema including all configuration and make sure
 			//the field is required
@@ -55,6 +59,7 @@ func (ds *alertingChannelDataSource) convertSchemaMap(schemaMap map[string]*sche
 			s := &schema.Schema{}
 			s.Description = v.Description
 			s.Deprecated = v.Deprecated
+			s.Sensitive = v.Sensitive
 			s.Type = v.Type
 			s.Required = false
 			s.Optional = false
@@ -152,6 +157,15 @@ func (ds *alertingChannelDataSource) mapChannelToState(channel *restapi.Alerting
 	if channel.Kind == restapi.GoogleChatChannelType {
 		return ds.mapGoogleChatChannelToState(channel), nil
 	}
+	if channel.Kind == restapi.ServiceNowChannelType {
+		return ds.mapServiceNowChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.PrometheusWebhookChannelType {
+		return ds.mapPrometheusWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.WatsonAIOpsWebhookChannelType {
+		return ds.mapWatsonAIOpsWebhookChannelToState(channel), nil
+	}
 	return nil, fmt.Errorf("received unsupported alerting channel of type %s", channel.Kind)
 }
 
@@ -284,3 +298,41 @@ func (ds *alertingChannelDataSource) mapGoogleChatChannelToState(channel *restap
 		},
 	}
 }
+
+func (ds *alertingChannelDataSource) mapServiceNowChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelServiceNow: []interface{}{
+			map[string]interface{}{
+				AlertingChannelServiceNowFieldServiceNowURL: channel.ServiceNowURL,
+				AlertingChannelServiceNowFieldUsername:      channel.Username,
+				AlertingChannelServiceNowFieldPassword:      channel.Password,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapPrometheusWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelPrometheusWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL:    channel.WebhookURL,
+				AlertingChannelPrometheusWebhookFieldReceiver: channel.Receiver,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapWatsonAIOpsWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	headers := ds.createHTTPHeaderMapFromList(channel.Headers)
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelWatsonAIOpsWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL: channel.WebhookURL,
+				AlertingChannelWebhookFieldHTTPHeaders:     headers,
+			},
+		},
+	}
+}
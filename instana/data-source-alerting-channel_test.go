@@ -25,6 +25,9 @@ func TestAlertingChannelDataSource(t *testing.T) {
 	t.Run("integration test read of webhook alerting channel", alertingChannelWebhookDataSourceIntegrationTest().testRead)
 	t.Run("integration test read of office 365 alerting channel", alertingChannelOffice365DataSourceIntegrationTest().testRead)
 	t.Run("integration test read of google chat alerting channel", alertingChannelGoogleChatDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of service now alerting channel", alertingChannelServiceNowDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of prometheus webhook alerting channel", alertingChannelPrometheusWebhookDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of watson aiops webhook alerting channel", alertingChannelWatsonAIOpsWebhookDataSourceIntegrationTest().testRead)
 	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
 	t.Run("schema version should be 0", unitTest.shouldHaveSchemaVersion0)
 	t.Run("should successfully read channel", unitTest.shouldSuccessfullyReadChannel)
@@ -138,6 +141,39 @@ func alertingChannelGoogleChatDataSourceIntegrationTest() *dataSourceAlertingCha
 	)
 }
 
+func alertingChannelServiceNowDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666670",
+		"my-service-now-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldServiceNowURL), "https://example.service-now.com"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldUsername), "user"),
+		},
+	)
+}
+
+func alertingChannelPrometheusWebhookDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666671",
+		"my-prometheus-webhook-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url-prometheus"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelPrometheusWebhookFieldReceiver), "receiver"),
+		},
+	)
+}
+
+func alertingChannelWatsonAIOpsWebhookDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666672",
+		"my-watson-aiops-webhook-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url-watson"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookFieldHTTPHeaders)+".key1", "value1"),
+		},
+	)
+}
+
 func newDataSourceAlertingChannelIntegrationTest(id, channelName string, additionalChecks []resource.TestCheckFunc) *dataSourceAlertingChannelIntegrationTest {
 	return &dataSourceAlertingChannelIntegrationTest{
 		id:               id,
@@ -206,6 +242,25 @@ func (r *dataSourceAlertingChannelIntegrationTest) testRead(t *testing.T) {
 	"name"   	 : "my-google-chat-channel",
 	"kind"   	 : "GOOGLE_CHAT",
 	"webhookUrl" : "webhook-url-google-chat"
+},{
+	"id": "666670",
+	"name": "my-service-now-channel",
+	"kind": "SERVICE_NOW_WEBHOOK",
+	"serviceNowUrl": "https://example.service-now.com",
+	"username": "user",
+	"password": "secret"
+},{
+	"id": "666671",
+	"name": "my-prometheus-webhook-channel",
+	"kind": "PROMETHEUS_WEBHOOK",
+	"webhookUrl": "webhook-url-prometheus",
+	"receiver": "receiver"
+},{
+	"id": "666672",
+	"name": "my-watson-aiops-webhook-channel",
+	"kind": "WATSON_AIOPS_WEBHOOK",
+	"webhookUrl": "webhook-url-watson",
+	"headers": [ "key1: value1" ]
 }]
 `
 	httpServer := createMockHttpServerForDataSource(restapi.AlertingChannelsResourcePath, newStringContentResponseProvider(serverResponse))
@@ -243,7 +298,7 @@ func (r *dataSourceAlertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	schemaData := NewAlertingChannelDataSource().CreateResource().Schema
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
-	require.Len(t, schemaData, 10)
+	require.Len(t, schemaData, 13)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
 
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelEmail)
@@ -255,6 +310,9 @@ func (r *dataSourceAlertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelWebhook)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelOffice365)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelGoogleChat)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelServiceNow)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelPrometheusWebhook)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelWatsonAIOpsWebhook)
 
 	r.validateEmailChannelSchema(t, schemaData[AlertingChannelFieldChannelEmail].Elem.(*schema.Resource).Schema)
 	r.validateOpsGenieChannelSchema(t, schemaData[AlertingChannelFieldChannelOpsGenie].Elem.(*schema.Resource).Schema)
@@ -265,6 +323,9 @@ func (r *dataSourceAlertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	r.validateWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWebhook].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelOffice365].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelGoogleChat].Elem.(*schema.Resource).Schema)
+	r.validateServiceNowChannelSchema(t, schemaData[AlertingChannelFieldChannelServiceNow].Elem.(*schema.Resource).Schema)
+	r.validatePrometheusWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelPrometheusWebhook].Elem.(*schema.Resource).Schema)
+	r.validateWatsonAIOpsWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWatsonAIOpsWebhook].Elem.(*schema.Resource).Schema)
 }
 
 func (r *dataSourceAlertingChannelUnitTest) validateEmailChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
@@ -322,6 +383,29 @@ func (r *dataSourceAlertingChannelUnitTest) validateWebhookBasedChannelSchema(t
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
 }
 
+func (r *dataSourceAlertingChannelUnitTest) validateServiceNowChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 3)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelServiceNowFieldServiceNowURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelServiceNowFieldUsername)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelServiceNowFieldPassword)
+	require.True(t, channelSchema[AlertingChannelServiceNowFieldPassword].Sensitive)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) validatePrometheusWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelPrometheusWebhookFieldReceiver)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) validateWatsonAIOpsWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeMapOfStrings(AlertingChannelWebhookFieldHTTPHeaders)
+}
+
 func (r *dataSourceAlertingChannelUnitTest) shouldHaveSchemaVersion0(t *testing.T) {
 	require.Equal(t, 0, NewAlertingChannelResourceHandle().MetaData().SchemaVersion)
 }
@@ -336,7 +420,7 @@ func (r *dataSourceAlertingChannelUnitTest) shouldSuccessfullyReadChannel(t *tes
 			Emails: []string{"email1", "email2"},
 		}
 
-		AlertingChannelAPI := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		AlertingChannelAPI := mocks.NewMockAlertingChannelRestResource(ctrl)
 		AlertingChannelAPI.EXPECT().GetAll().Times(1).Return(&[]*restapi.AlertingChannel{&data}, nil)
 		mockInstanaApi.EXPECT().AlertingChannels().Return(AlertingChannelAPI).Times(1)
 
@@ -369,7 +453,7 @@ func (r *dataSourceAlertingChannelUnitTest) shouldFailToReadChannelWhenApiCallFa
 	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
 		expectedError := errors.New("test")
 
-		AlertingChannelAPI := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		AlertingChannelAPI := mocks.NewMockAlertingChannelRestResource(ctrl)
 		AlertingChannelAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
 		mockInstanaApi.EXPECT().AlertingChannels().Return(AlertingChannelAPI).Times(1)
 
@@ -396,7 +480,7 @@ func (r *dataSourceAlertingChannelUnitTest) shouldFailToReadChannelWhenNoChannel
 			Emails: []string{"email1", "email2"},
 		}
 
-		AlertingChannelAPI := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		AlertingChannelAPI := mocks.NewMockAlertingChannelRestResource(ctrl)
 		AlertingChannelAPI.EXPECT().GetAll().Times(1).Return(&[]*restapi.AlertingChannel{&data}, nil)
 		mockInstanaApi.EXPECT().AlertingChannels().Return(AlertingChannelAPI).Times(1)
 
@@ -422,7 +506,7 @@ func (r *dataSourceAlertingChannelUnitTest) shouldFailToReadChannelWhenChannelTy
 			Kind: restapi.AlertingChannelType("invalid"),
 		}
 
-		AlertingChannelAPI := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		AlertingChannelAPI := mocks.NewMockAlertingChannelRestResource(ctrl)
 		AlertingChannelAPI.EXPECT().GetAll().Times(1).Return(&[]*restapi.AlertingChannel{&data}, nil)
 		mockInstanaApi.EXPECT().AlertingChannels().Return(AlertingChannelAPI).Times(1)
 
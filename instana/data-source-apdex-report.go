@@ -0,0 +1,142 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewApdexReportDataSource creates a new DataSource for apdex reports
+func NewApdexReportDataSource() DataSource {
+	return &apdexReportDataSource{}
+}
+
+const (
+	//ApdexReportFieldApdexID constant value for the schema field apdex_id
+	ApdexReportFieldApdexID = "apdex_id"
+	//ApdexReportFieldFrom constant value for the schema field from
+	ApdexReportFieldFrom = "from"
+	//ApdexReportFieldTo constant value for the schema field to
+	ApdexReportFieldTo = "to"
+	//ApdexReportFieldScores constant value for the computed schema field scores
+	ApdexReportFieldScores = "scores"
+	//ApdexReportFieldScoreTimestamp constant value for the computed schema field scores.timestamp
+	ApdexReportFieldScoreTimestamp = "timestamp"
+	//ApdexReportFieldScoreValue constant value for the computed schema field scores.value
+	ApdexReportFieldScoreValue = "value"
+	//ApdexReportFieldAverageScore constant value for the computed schema field average_score
+	ApdexReportFieldAverageScore = "average_score"
+	//DataSourceApdexReport the name of the terraform-provider-instana data source for apdex reports
+	DataSourceApdexReport = "instana_apdex_report"
+)
+
+type apdexReportDataSource struct{}
+
+// CreateResource creates the resource handle for apdex reports
+func (ds *apdexReportDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			ApdexReportFieldApdexID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the apdex configuration",
+			},
+			ApdexReportFieldFrom: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The start of the time window as unix timestamp in milliseconds",
+			},
+			ApdexReportFieldTo: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The end of the time window as unix timestamp in milliseconds",
+			},
+			ApdexReportFieldScores: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The apdex scores calculated by Instana for the given time window",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ApdexReportFieldScoreTimestamp: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The unix timestamp in milliseconds of the apdex score",
+						},
+						ApdexReportFieldScoreValue: {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The apdex score",
+						},
+					},
+				},
+			},
+			ApdexReportFieldAverageScore: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The average of all apdex scores of the given time window",
+			},
+		},
+	}
+}
+
+func (ds *apdexReportDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	apdexID := d.Get(ApdexReportFieldApdexID).(string)
+	from := int64(d.Get(ApdexReportFieldFrom).(int))
+	to := int64(d.Get(ApdexReportFieldTo).(int))
+	if from >= to {
+		return diag.FromErr(fmt.Errorf("%s (%d) must be before %s (%d)", ApdexReportFieldFrom, from, ApdexReportFieldTo, to))
+	}
+
+	reports, err := instanaAPI.ApdexReports().GetReports(apdexID, from, to)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(reports) == 0 {
+		return diag.FromErr(fmt.Errorf("no apdex report found for apdex configuration %s", apdexID))
+	}
+
+	err = ds.updateState(d, apdexID, reports)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *apdexReportDataSource) updateState(d *schema.ResourceData, apdexID string, reports []*restapi.ApdexReport) error {
+	scores := make([]interface{}, 0)
+	sum := 0.0
+	for _, report := range reports {
+		for _, score := range report.ApdexScore {
+			if len(score) < 2 {
+				continue
+			}
+			scores = append(scores, map[string]interface{}{
+				ApdexReportFieldScoreTimestamp: int(score[0]),
+				ApdexReportFieldScoreValue:     score[1],
+			})
+			sum += score[1]
+		}
+	}
+
+	averageScore := 0.0
+	if len(scores) > 0 {
+		averageScore = sum / float64(len(scores))
+	}
+
+	d.SetId(apdexID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ApdexReportFieldScores:       scores,
+		ApdexReportFieldAverageScore: averageScore,
+	})
+}
@@ -0,0 +1,149 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestApdexReportDataSource(t *testing.T) {
+	unitTest := &dataSourceApdexReportUnitTest{}
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should successfully read apdex report", unitTest.shouldSuccessfullyReadApdexReport)
+	t.Run("should fail to read apdex report when api call fails", unitTest.shouldFailToReadApdexReportWhenApiCallFails)
+	t.Run("should fail to read apdex report when no report is returned", unitTest.shouldFailToReadApdexReportWhenNoReportIsReturned)
+	t.Run("should fail to read apdex report when from is not before to", unitTest.shouldFailToReadApdexReportWhenFromIsNotBeforeTo)
+}
+
+type dataSourceApdexReportUnitTest struct{}
+
+func (r *dataSourceApdexReportUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewApdexReportDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 5)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApdexReportFieldApdexID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(ApdexReportFieldFrom)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(ApdexReportFieldTo)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(ApdexReportFieldScores)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeFloat(ApdexReportFieldAverageScore)
+
+	scoreSchema := schemaData[ApdexReportFieldScores].Elem.(*schema.Resource).Schema
+	require.Len(t, scoreSchema, 2)
+	scoreSchemaAssert := testutils.NewTerraformSchemaAssert(scoreSchema, t)
+	scoreSchemaAssert.AssertSchemaIsComputedAndOfTypeInt(ApdexReportFieldScoreTimestamp)
+	scoreSchemaAssert.AssertSchemaIsComputedAndOfTypeFloat(ApdexReportFieldScoreValue)
+}
+
+func (r *dataSourceApdexReportUnitTest) shouldSuccessfullyReadApdexReport(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		reports := []*restapi.ApdexReport{
+			{
+				ApdexID:    "apdex-id",
+				ApdexScore: [][]float64{{1500, 1.0}, {1600, 0.5}},
+				From:       1000,
+				To:         2000,
+			},
+		}
+
+		apdexReportAPI := mocks.NewMockApdexReportResource(ctrl)
+		apdexReportAPI.EXPECT().GetReports("apdex-id", int64(1000), int64(2000)).Times(1).Return(reports, nil)
+		mockInstanaApi.EXPECT().ApdexReports().Return(apdexReportAPI).Times(1)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: "apdex-id",
+			ApdexReportFieldFrom:    1000,
+			ApdexReportFieldTo:      2000,
+		})
+
+		diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "apdex-id", resourceData.Id())
+		require.Equal(t, 0.75, resourceData.Get(ApdexReportFieldAverageScore))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{ApdexReportFieldScoreTimestamp: 1500, ApdexReportFieldScoreValue: 1.0},
+			map[string]interface{}{ApdexReportFieldScoreTimestamp: 1600, ApdexReportFieldScoreValue: 0.5},
+		}, resourceData.Get(ApdexReportFieldScores))
+	})
+}
+
+func (r *dataSourceApdexReportUnitTest) shouldFailToReadApdexReportWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+
+		apdexReportAPI := mocks.NewMockApdexReportResource(ctrl)
+		apdexReportAPI.EXPECT().GetReports("apdex-id", int64(1000), int64(2000)).Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().ApdexReports().Return(apdexReportAPI).Times(1)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: "apdex-id",
+			ApdexReportFieldFrom:    1000,
+			ApdexReportFieldTo:      2000,
+		})
+
+		diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, expectedError.Error())
+	})
+}
+
+func (r *dataSourceApdexReportUnitTest) shouldFailToReadApdexReportWhenNoReportIsReturned(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		apdexReportAPI := mocks.NewMockApdexReportResource(ctrl)
+		apdexReportAPI.EXPECT().GetReports("apdex-id", int64(1000), int64(2000)).Times(1).Return([]*restapi.ApdexReport{}, nil)
+		mockInstanaApi.EXPECT().ApdexReports().Return(apdexReportAPI).Times(1)
+
+		sut := NewApdexReportDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+			ApdexReportFieldApdexID: "apdex-id",
+			ApdexReportFieldFrom:    1000,
+			ApdexReportFieldTo:      2000,
+		})
+
+		diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+		require.Contains(t, diag[0].Summary, "no apdex report found")
+	})
+}
+
+func (r *dataSourceApdexReportUnitTest) shouldFailToReadApdexReportWhenFromIsNotBeforeTo(t *testing.T) {
+	for name, to := range map[string]int{"equal": 1000, "before": 500} {
+		t.Run(name, func(t *testing.T) {
+			testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+			testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+				mockInstanaApi.EXPECT().ApdexReports().Times(0)
+
+				sut := NewApdexReportDataSource().CreateResource()
+				resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+					ApdexReportFieldApdexID: "apdex-id",
+					ApdexReportFieldFrom:    1000,
+					ApdexReportFieldTo:      to,
+				})
+
+				diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+				require.NotNil(t, diag)
+				require.True(t, diag.HasError())
+				require.Contains(t, diag[0].Summary, "from (1000) must be before to")
+			})
+		})
+	}
+}
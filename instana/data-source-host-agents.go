@@ -0,0 +1,142 @@
+package instana
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewHostAgentsDataSource creates a new DataSource for the host agents of the Instana tenant
+func NewHostAgentsDataSource() DataSource {
+	return &hostAgentsDataSource{}
+}
+
+const (
+	//HostAgentsFieldQuery constant value for the schema field query
+	HostAgentsFieldQuery = "query"
+	//HostAgentsFieldOffline constant value for the schema field offline
+	HostAgentsFieldOffline = "offline"
+	//HostAgentsFieldHostAgents constant value for the computed schema field host_agents
+	HostAgentsFieldHostAgents = "host_agents"
+	//HostAgentsFieldHostAgentSnapshotID constant value for the computed schema field host_agents.snapshot_id
+	HostAgentsFieldHostAgentSnapshotID = "snapshot_id"
+	//HostAgentsFieldHostAgentHostID constant value for the computed schema field host_agents.host_id
+	HostAgentsFieldHostAgentHostID = "host_id"
+	//HostAgentsFieldHostAgentLabel constant value for the computed schema field host_agents.label
+	HostAgentsFieldHostAgentLabel = "label"
+	//HostAgentsFieldHostAgentPlugin constant value for the computed schema field host_agents.plugin
+	HostAgentsFieldHostAgentPlugin = "plugin"
+	//HostAgentsFieldHostAgentTags constant value for the computed schema field host_agents.tags
+	HostAgentsFieldHostAgentTags = "tags"
+	//DataSourceHostAgents the name of the terraform-provider-instana data source for host agents
+	DataSourceHostAgents = "instana_host_agents"
+)
+
+type hostAgentsDataSource struct{}
+
+// CreateResource creates the resource handle for host agents
+func (ds *hostAgentsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			HostAgentsFieldQuery: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The dynamic focus query to filter the host agents. All host agents are returned when no query is provided",
+			},
+			HostAgentsFieldOffline: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag indicating whether offline host agents are included",
+			},
+			HostAgentsFieldHostAgents: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The host agents matching the given query sorted by host id",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						HostAgentsFieldHostAgentSnapshotID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the snapshot of the host agent",
+						},
+						HostAgentsFieldHostAgentHostID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the host of the host agent. The ID can be used as host_id of instana_host_agent_configuration",
+						},
+						HostAgentsFieldHostAgentLabel: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The label of the host agent",
+						},
+						HostAgentsFieldHostAgentPlugin: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The plugin of the snapshot of the host agent",
+						},
+						HostAgentsFieldHostAgentTags: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The tags of the host agent",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *hostAgentsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	query := d.Get(HostAgentsFieldQuery).(string)
+	offline := d.Get(HostAgentsFieldOffline).(bool)
+
+	hostAgents, err := instanaAPI.HostAgents().GetHostAgents(query, offline)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, hostAgents)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *hostAgentsDataSource) updateState(d *schema.ResourceData, hostAgents []*restapi.HostAgent) error {
+	sort.SliceStable(hostAgents, func(i, j int) bool {
+		return hostAgents[i].Host < hostAgents[j].Host
+	})
+	snapshotIDs := make([]string, len(hostAgents))
+	hostAgentsState := make([]interface{}, len(hostAgents))
+	for i, hostAgent := range hostAgents {
+		snapshotIDs[i] = hostAgent.SnapshotID
+		tags := hostAgent.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		hostAgentsState[i] = map[string]interface{}{
+			HostAgentsFieldHostAgentSnapshotID: hostAgent.SnapshotID,
+			HostAgentsFieldHostAgentHostID:     hostAgent.Host,
+			HostAgentsFieldHostAgentLabel:      hostAgent.Label,
+			HostAgentsFieldHostAgentPlugin:     hostAgent.Plugin,
+			HostAgentsFieldHostAgentTags:       tags,
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(snapshotIDs, ","))))
+	return tfutils.UpdateState(d, map[string]interface{}{
+		HostAgentsFieldHostAgents: hostAgentsState,
+	})
+}
@@ -0,0 +1,119 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const hostAgentsTestQuery = "entity.zone:production"
+
+func TestHostAgentsDataSource(t *testing.T) {
+	unitTest := &dataSourceHostAgentsUnitTest{}
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should return host agents sorted by host id", unitTest.shouldReturnHostAgentsSortedByHostID)
+	t.Run("should pass query and offline flag to the api", unitTest.shouldPassQueryAndOfflineFlagToTheApi)
+	t.Run("should fail to read host agents when api call fails", unitTest.shouldFailToReadHostAgentsWhenApiCallFails)
+}
+
+type dataSourceHostAgentsUnitTest struct{}
+
+func (r *dataSourceHostAgentsUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewHostAgentsDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 3)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(HostAgentsFieldQuery)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(HostAgentsFieldOffline, false)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(HostAgentsFieldHostAgents)
+
+	hostAgentSchema := schemaData[HostAgentsFieldHostAgents].Elem.(*schema.Resource).Schema
+	require.Len(t, hostAgentSchema, 5)
+	hostAgentSchemaAssert := testutils.NewTerraformSchemaAssert(hostAgentSchema, t)
+	hostAgentSchemaAssert.AssertSchemaIsComputedAndOfTypeString(HostAgentsFieldHostAgentSnapshotID)
+	hostAgentSchemaAssert.AssertSchemaIsComputedAndOfTypeString(HostAgentsFieldHostAgentHostID)
+	hostAgentSchemaAssert.AssertSchemaIsComputedAndOfTypeString(HostAgentsFieldHostAgentLabel)
+	hostAgentSchemaAssert.AssertSchemaIsComputedAndOfTypeString(HostAgentsFieldHostAgentPlugin)
+	hostAgentSchemaAssert.AssertSchemaIsComputedAndOfTypeListOfStrings(HostAgentsFieldHostAgentTags)
+}
+
+func (r *dataSourceHostAgentsUnitTest) createHostAgents() []*restapi.HostAgent {
+	return []*restapi.HostAgent{
+		{SnapshotID: "snapshot-2", Host: "host-2", Label: "agent-2", Plugin: "com.instana.plugin.host", Tags: []string{"tag1", "tag2"}},
+		{SnapshotID: "snapshot-1", Host: "host-1", Label: "agent-1", Plugin: "com.instana.plugin.host"},
+	}
+}
+
+func (r *dataSourceHostAgentsUnitTest) read(t *testing.T, config map[string]interface{}, query string, offline bool) *schema.ResourceData {
+	var resourceData *schema.ResourceData
+	testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		hostAgentsAPI := mocks.NewMockHostAgentResource(ctrl)
+		hostAgentsAPI.EXPECT().GetHostAgents(query, offline).Times(1).Return(r.createHostAgents(), nil)
+		mockInstanaApi.EXPECT().HostAgents().Return(hostAgentsAPI).Times(1)
+
+		sut := NewHostAgentsDataSource().CreateResource()
+		resourceData = schema.TestResourceDataRaw(t, sut.Schema, config)
+
+		diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+		require.Nil(t, diag)
+		require.NotEmpty(t, resourceData.Id())
+	})
+	return resourceData
+}
+
+func (r *dataSourceHostAgentsUnitTest) shouldReturnHostAgentsSortedByHostID(t *testing.T) {
+	resourceData := r.read(t, map[string]interface{}{}, "", false)
+
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			HostAgentsFieldHostAgentSnapshotID: "snapshot-1",
+			HostAgentsFieldHostAgentHostID:     "host-1",
+			HostAgentsFieldHostAgentLabel:      "agent-1",
+			HostAgentsFieldHostAgentPlugin:     "com.instana.plugin.host",
+			HostAgentsFieldHostAgentTags:       []interface{}{},
+		},
+		map[string]interface{}{
+			HostAgentsFieldHostAgentSnapshotID: "snapshot-2",
+			HostAgentsFieldHostAgentHostID:     "host-2",
+			HostAgentsFieldHostAgentLabel:      "agent-2",
+			HostAgentsFieldHostAgentPlugin:     "com.instana.plugin.host",
+			HostAgentsFieldHostAgentTags:       []interface{}{"tag1", "tag2"},
+		},
+	}, resourceData.Get(HostAgentsFieldHostAgents))
+}
+
+func (r *dataSourceHostAgentsUnitTest) shouldPassQueryAndOfflineFlagToTheApi(t *testing.T) {
+	resourceData := r.read(t, map[string]interface{}{
+		HostAgentsFieldQuery:   hostAgentsTestQuery,
+		HostAgentsFieldOffline: true,
+	}, hostAgentsTestQuery, true)
+
+	require.Len(t, resourceData.Get(HostAgentsFieldHostAgents), 2)
+}
+
+func (r *dataSourceHostAgentsUnitTest) shouldFailToReadHostAgentsWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		hostAgentsAPI := mocks.NewMockHostAgentResource(ctrl)
+		hostAgentsAPI.EXPECT().GetHostAgents("", false).Times(1).Return(nil, errors.New("test"))
+		mockInstanaApi.EXPECT().HostAgents().Return(hostAgentsAPI).Times(1)
+
+		sut := NewHostAgentsDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+	})
+}
@@ -0,0 +1,200 @@
+package instana
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewUsersDataSource creates a new DataSource for the users of the Instana tenant
+func NewUsersDataSource() DataSource {
+	return &usersDataSource{}
+}
+
+const (
+	//UsersFieldEmailRegex constant value for the schema field email_regex
+	UsersFieldEmailRegex = "email_regex"
+	//UsersFieldLastLoggedInAfter constant value for the schema field last_logged_in_after
+	UsersFieldLastLoggedInAfter = "last_logged_in_after"
+	//UsersFieldLastLoggedInBefore constant value for the schema field last_logged_in_before
+	UsersFieldLastLoggedInBefore = "last_logged_in_before"
+	//UsersFieldUsers constant value for the computed schema field users
+	UsersFieldUsers = "users"
+	//UsersFieldUserID constant value for the computed schema field users.id
+	UsersFieldUserID = "id"
+	//UsersFieldUserEmail constant value for the computed schema field users.email
+	UsersFieldUserEmail = "email"
+	//UsersFieldUserFullName constant value for the computed schema field users.full_name
+	UsersFieldUserFullName = "full_name"
+	//UsersFieldUserLastLoggedIn constant value for the computed schema field users.last_logged_in
+	UsersFieldUserLastLoggedIn = "last_logged_in"
+	//UsersFieldUserGroupCount constant value for the computed schema field users.group_count
+	UsersFieldUserGroupCount = "group_count"
+	//UsersFieldUserTfaEnabled constant value for the computed schema field users.tfa_enabled
+	UsersFieldUserTfaEnabled = "tfa_enabled"
+	//DataSourceUsers the name of the terraform-provider-instana data source for users
+	DataSourceUsers = "instana_users"
+)
+
+type usersDataSource struct{}
+
+// CreateResource creates the resource handle for users
+func (ds *usersDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			UsersFieldEmailRegex: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression to filter users by their email address. The match is case insensitive",
+			},
+			UsersFieldLastLoggedInAfter: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Only users which logged in after the given unix timestamp in milliseconds are returned",
+			},
+			UsersFieldLastLoggedInBefore: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Only users which did not log in since the given unix timestamp in milliseconds are returned. Users which never logged in are included",
+			},
+			UsersFieldUsers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The users matching the given filters sorted by email address",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						UsersFieldUserID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the user",
+						},
+						UsersFieldUserEmail: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address of the user",
+						},
+						UsersFieldUserFullName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The full name of the user",
+						},
+						UsersFieldUserLastLoggedIn: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The unix timestamp in milliseconds of the last login of the user; 0 when the user never logged in",
+						},
+						UsersFieldUserGroupCount: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of groups the user is member of",
+						},
+						UsersFieldUserTfaEnabled: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag indicating whether two factor authentication is enabled for the user",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *usersDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	users, err := instanaAPI.Users().GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filtered, err := ds.filterUsers(d, users)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, filtered)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *usersDataSource) filterUsers(d *schema.ResourceData, users *[]*restapi.User) ([]*restapi.User, error) {
+	var emailRegex *regexp.Regexp
+	if v, ok := d.GetOk(UsersFieldEmailRegex); ok {
+		r, err := regexp.Compile("(?i)" + v.(string))
+		if err != nil {
+			return nil, err
+		}
+		emailRegex = r
+	}
+	after, hasAfter := d.GetOk(UsersFieldLastLoggedInAfter)
+	before, hasBefore := d.GetOk(UsersFieldLastLoggedInBefore)
+
+	result := make([]*restapi.User, 0)
+	for _, user := range *users {
+		lastLoggedIn := int64(0)
+		if user.LastLoggedIn != nil {
+			lastLoggedIn = *user.LastLoggedIn
+		}
+		if emailRegex != nil && !emailRegex.MatchString(user.Email) {
+			continue
+		}
+		if hasAfter && lastLoggedIn <= int64(after.(int)) {
+			continue
+		}
+		if hasBefore && lastLoggedIn >= int64(before.(int)) {
+			continue
+		}
+		result = append(result, user)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Email < result[j].Email
+	})
+	return result, nil
+}
+
+func (ds *usersDataSource) updateState(d *schema.ResourceData, users []*restapi.User) error {
+	userIDs := make([]string, len(users))
+	usersState := make([]interface{}, len(users))
+	for i, user := range users {
+		userIDs[i] = user.ID
+		userState := map[string]interface{}{
+			UsersFieldUserID:           user.ID,
+			UsersFieldUserEmail:        user.Email,
+			UsersFieldUserFullName:     user.FullName,
+			UsersFieldUserLastLoggedIn: 0,
+			UsersFieldUserGroupCount:   0,
+			UsersFieldUserTfaEnabled:   false,
+		}
+		if user.LastLoggedIn != nil {
+			userState[UsersFieldUserLastLoggedIn] = int(*user.LastLoggedIn)
+		}
+		if user.GroupCount != nil {
+			userState[UsersFieldUserGroupCount] = int(*user.GroupCount)
+		}
+		if user.TfaEnabled != nil {
+			userState[UsersFieldUserTfaEnabled] = *user.TfaEnabled
+		}
+		usersState[i] = userState
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(userIDs, ","))))
+	return tfutils.UpdateState(d, map[string]interface{}{
+		UsersFieldUsers: usersState,
+	})
+}
@@ -0,0 +1,145 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsersDataSource(t *testing.T) {
+	unitTest := &dataSourceUsersUnitTest{}
+	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
+	t.Run("should return all users sorted by email when no filter is provided", unitTest.shouldReturnAllUsersSortedByEmailWhenNoFilterIsProvided)
+	t.Run("should filter users by email regex", unitTest.shouldFilterUsersByEmailRegex)
+	t.Run("should filter users by last login", unitTest.shouldFilterUsersByLastLogin)
+	t.Run("should fail to read users when api call fails", unitTest.shouldFailToReadUsersWhenApiCallFails)
+}
+
+type dataSourceUsersUnitTest struct{}
+
+func (r *dataSourceUsersUnitTest) schemaShouldBeValid(t *testing.T) {
+	schemaData := NewUsersDataSource().CreateResource().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+	require.Len(t, schemaData, 4)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(UsersFieldEmailRegex)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(UsersFieldLastLoggedInAfter)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(UsersFieldLastLoggedInBefore)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(UsersFieldUsers)
+
+	userSchema := schemaData[UsersFieldUsers].Elem.(*schema.Resource).Schema
+	require.Len(t, userSchema, 6)
+	userSchemaAssert := testutils.NewTerraformSchemaAssert(userSchema, t)
+	userSchemaAssert.AssertSchemaIsComputedAndOfTypeString(UsersFieldUserID)
+	userSchemaAssert.AssertSchemaIsComputedAndOfTypeString(UsersFieldUserEmail)
+	userSchemaAssert.AssertSchemaIsComputedAndOfTypeString(UsersFieldUserFullName)
+	userSchemaAssert.AssertSchemaIsComputedAndOfTypeInt(UsersFieldUserLastLoggedIn)
+	userSchemaAssert.AssertSchemaIsComputedAndOfTypeInt(UsersFieldUserGroupCount)
+	userSchemaAssert.AssertSchemaIsComputedAndOfTypeBool(UsersFieldUserTfaEnabled)
+}
+
+func (r *dataSourceUsersUnitTest) createUsers() *[]*restapi.User {
+	lastLogin1 := int64(1000)
+	lastLogin2 := int64(2000)
+	groupCount := int64(2)
+	tfaEnabled := true
+	return &[]*restapi.User{
+		{ID: "id-2", Email: "jane@example.com", FullName: "Jane", LastLoggedIn: &lastLogin2, GroupCount: &groupCount, TfaEnabled: &tfaEnabled},
+		{ID: "id-1", Email: "bob@example.com", FullName: "Bob", LastLoggedIn: &lastLogin1},
+		{ID: "id-3", Email: "admin@other.org", FullName: "Admin"},
+	}
+}
+
+func (r *dataSourceUsersUnitTest) read(t *testing.T, config map[string]interface{}) *schema.ResourceData {
+	var resourceData *schema.ResourceData
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll().Times(1).Return(r.createUsers(), nil)
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+
+		sut := NewUsersDataSource().CreateResource()
+		resourceData = schema.TestResourceDataRaw(t, sut.Schema, config)
+
+		diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+		require.Nil(t, diag)
+		require.NotEmpty(t, resourceData.Id())
+	})
+	return resourceData
+}
+
+func (r *dataSourceUsersUnitTest) userIDs(resourceData *schema.ResourceData) []string {
+	result := make([]string, 0)
+	for _, u := range resourceData.Get(UsersFieldUsers).([]interface{}) {
+		result = append(result, u.(map[string]interface{})[UsersFieldUserID].(string))
+	}
+	return result
+}
+
+func (r *dataSourceUsersUnitTest) shouldReturnAllUsersSortedByEmailWhenNoFilterIsProvided(t *testing.T) {
+	resourceData := r.read(t, map[string]interface{}{})
+
+	require.Equal(t, []string{"id-3", "id-1", "id-2"}, r.userIDs(resourceData))
+	require.Equal(t, map[string]interface{}{
+		UsersFieldUserID:           "id-2",
+		UsersFieldUserEmail:        "jane@example.com",
+		UsersFieldUserFullName:     "Jane",
+		UsersFieldUserLastLoggedIn: 2000,
+		UsersFieldUserGroupCount:   2,
+		UsersFieldUserTfaEnabled:   true,
+	}, resourceData.Get(UsersFieldUsers).([]interface{})[2])
+	require.Equal(t, map[string]interface{}{
+		UsersFieldUserID:           "id-3",
+		UsersFieldUserEmail:        "admin@other.org",
+		UsersFieldUserFullName:     "Admin",
+		UsersFieldUserLastLoggedIn: 0,
+		UsersFieldUserGroupCount:   0,
+		UsersFieldUserTfaEnabled:   false,
+	}, resourceData.Get(UsersFieldUsers).([]interface{})[0])
+}
+
+func (r *dataSourceUsersUnitTest) shouldFilterUsersByEmailRegex(t *testing.T) {
+	resourceData := r.read(t, map[string]interface{}{UsersFieldEmailRegex: "@EXAMPLE\\.com$"})
+
+	require.Equal(t, []string{"id-1", "id-2"}, r.userIDs(resourceData))
+}
+
+func (r *dataSourceUsersUnitTest) shouldFilterUsersByLastLogin(t *testing.T) {
+	t.Run("after", func(t *testing.T) {
+		resourceData := r.read(t, map[string]interface{}{UsersFieldLastLoggedInAfter: 1500})
+
+		require.Equal(t, []string{"id-2"}, r.userIDs(resourceData))
+	})
+	t.Run("before", func(t *testing.T) {
+		resourceData := r.read(t, map[string]interface{}{UsersFieldLastLoggedInBefore: 1500})
+
+		require.Equal(t, []string{"id-3", "id-1"}, r.userIDs(resourceData))
+	})
+}
+
+func (r *dataSourceUsersUnitTest) shouldFailToReadUsersWhenApiCallFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.User](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaApi *mocks.MockInstanaAPI) {
+		expectedError := errors.New("test")
+		usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+		usersAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+		mockInstanaApi.EXPECT().Users().Return(usersAPI).Times(1)
+
+		sut := NewUsersDataSource().CreateResource()
+		resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{})
+
+		diag := sut.ReadContext(context.TODO(), resourceData, meta)
+
+		require.NotNil(t, diag)
+		require.True(t, diag.HasError())
+	})
+}
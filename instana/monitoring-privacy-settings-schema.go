@@ -0,0 +1,151 @@
+package instana
+
+import (
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	//MonitoringPrivacySettingsFieldGeoLocation constant value for the schema field geo_location
+	MonitoringPrivacySettingsFieldGeoLocation = "geo_location"
+	//MonitoringPrivacySettingsFieldGeoDetailRemoval constant value for the schema field geo_location.geo_detail_removal
+	MonitoringPrivacySettingsFieldGeoDetailRemoval = "geo_detail_removal"
+	//MonitoringPrivacySettingsFieldIPMasking constant value for the schema field ip_masking
+	MonitoringPrivacySettingsFieldIPMasking = "ip_masking"
+	//MonitoringPrivacySettingsFieldIPMaskingMode constant value for the schema field ip_masking.mode
+	MonitoringPrivacySettingsFieldIPMaskingMode = "mode"
+	//MonitoringPrivacySettingsFieldGeoMappingRules constant value for the schema field geo_mapping_rules
+	MonitoringPrivacySettingsFieldGeoMappingRules = "geo_mapping_rules"
+	//MonitoringPrivacySettingsFieldGeoMappingRulesCSV constant value for the schema field geo_mapping_rules.csv
+	MonitoringPrivacySettingsFieldGeoMappingRulesCSV = "csv"
+)
+
+var (
+	monitoringPrivacySettingsSchemaGeoLocation = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    0,
+		MaxItems:    1,
+		Optional:    true,
+		Computed:    true,
+		Description: "The geo location configuration. When not configured the current settings at Instana are kept. Removing the block does not reset the settings at Instana",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				MonitoringPrivacySettingsFieldGeoDetailRemoval: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(restapi.SupportedGeoDetailRemovals.ToStringSlice(), false),
+					Description:  "The level of geo location details which are removed from the beacons",
+				},
+			},
+		},
+	}
+	monitoringPrivacySettingsSchemaIPMasking = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    0,
+		MaxItems:    1,
+		Optional:    true,
+		Computed:    true,
+		Description: "The IP masking configuration. When not configured the current settings at Instana are kept. Removing the block does not reset the settings at Instana",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				MonitoringPrivacySettingsFieldIPMaskingMode: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(restapi.SupportedIPMaskings.ToStringSlice(), false),
+					Description:  "The strategy how IP addresses of the beacons are masked",
+				},
+			},
+		},
+	}
+	monitoringPrivacySettingsSchemaGeoMappingRules = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    0,
+		MaxItems:    1,
+		Optional:    true,
+		Computed:    true,
+		Description: "The custom geo mapping rules. When not configured the current settings at Instana are kept. Removing the block does not reset the settings at Instana",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				MonitoringPrivacySettingsFieldGeoMappingRulesCSV: {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressGeoMappingRulesFormattingDiff,
+					Description:      "The custom geo mapping rules in CSV format. An empty string removes all custom rules",
+				},
+			},
+		},
+	}
+)
+
+func suppressGeoMappingRulesFormattingDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeGeoMappingRules(old) == normalizeGeoMappingRules(new)
+}
+
+func normalizeGeoMappingRules(csv string) string {
+	return strings.TrimSpace(strings.ReplaceAll(csv, "\r\n", "\n"))
+}
+
+func mapMonitoringPrivacySettingsToState(settings restapi.MonitoringPrivacySettings) map[string]interface{} {
+	result := map[string]interface{}{
+		MonitoringPrivacySettingsFieldGeoLocation:     []interface{}{},
+		MonitoringPrivacySettingsFieldIPMasking:       []interface{}{},
+		MonitoringPrivacySettingsFieldGeoMappingRules: []interface{}{},
+	}
+	if settings.GeoLocation != nil {
+		result[MonitoringPrivacySettingsFieldGeoLocation] = []interface{}{
+			map[string]interface{}{
+				MonitoringPrivacySettingsFieldGeoDetailRemoval: string(settings.GeoLocation.GeoDetailRemoval),
+			},
+		}
+	}
+	if settings.IPMasking != nil {
+		result[MonitoringPrivacySettingsFieldIPMasking] = []interface{}{
+			map[string]interface{}{
+				MonitoringPrivacySettingsFieldIPMaskingMode: string(settings.IPMasking.IPMasking),
+			},
+		}
+	}
+	if settings.GeoMappingRules != nil {
+		result[MonitoringPrivacySettingsFieldGeoMappingRules] = []interface{}{
+			map[string]interface{}{
+				MonitoringPrivacySettingsFieldGeoMappingRulesCSV: normalizeGeoMappingRules(*settings.GeoMappingRules),
+			},
+		}
+	}
+	return result
+}
+
+func mapMonitoringPrivacySettingsFromState(d *schema.ResourceData) restapi.MonitoringPrivacySettings {
+	result := restapi.MonitoringPrivacySettings{}
+	if values, ok := d.GetOk(MonitoringPrivacySettingsFieldGeoLocation); ok && len(values.([]interface{})) > 0 {
+		geoLocation := values.([]interface{})[0].(map[string]interface{})
+		result.GeoLocation = &restapi.GeoLocationConfiguration{
+			GeoDetailRemoval: restapi.GeoDetailRemoval(geoLocation[MonitoringPrivacySettingsFieldGeoDetailRemoval].(string)),
+		}
+	}
+	if values, ok := d.GetOk(MonitoringPrivacySettingsFieldIPMasking); ok && len(values.([]interface{})) > 0 {
+		ipMasking := values.([]interface{})[0].(map[string]interface{})
+		result.IPMasking = &restapi.IPMaskingConfiguration{
+			IPMasking: restapi.IPMasking(ipMasking[MonitoringPrivacySettingsFieldIPMaskingMode].(string)),
+		}
+	}
+	if values, ok := d.GetOk(MonitoringPrivacySettingsFieldGeoMappingRules); ok && len(values.([]interface{})) > 0 {
+		csv := ""
+		if values.([]interface{})[0] != nil {
+			csv = values.([]interface{})[0].(map[string]interface{})[MonitoringPrivacySettingsFieldGeoMappingRulesCSV].(string)
+		}
+		result.GeoMappingRules = &csv
+	}
+	return result
+}
+
+func selectMonitoringPrivacySettingsFromState(d *schema.ResourceData) restapi.MonitoringPrivacySettingsSelection {
+	return restapi.MonitoringPrivacySettingsSelection{
+		GeoLocation:     len(d.Get(MonitoringPrivacySettingsFieldGeoLocation).([]interface{})) > 0,
+		IPMasking:       len(d.Get(MonitoringPrivacySettingsFieldIPMasking).([]interface{})) > 0,
+		GeoMappingRules: len(d.Get(MonitoringPrivacySettingsFieldGeoMappingRules).([]interface{})) > 0,
+	}
+}
@@ -72,6 +72,29 @@ func providerResources() map[string]*schema.Resource {
 	bindResourceHandle(resources, NewGroupResourceHandle())
 	bindResourceHandle(resources, NewCustomDashboardResourceHandle())
 	bindResourceHandle(resources, NewSyntheticTestResourceHandle())
+	bindResourceHandle(resources, NewInfraAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewMobileAppAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewGlobalSyntheticAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewSyntheticCredentialResourceHandle())
+	bindResourceHandle(resources, NewMaintenanceWindowResourceHandle())
+	bindResourceHandle(resources, NewReleaseResourceHandle())
+	bindResourceHandle(resources, NewApdexConfigResourceHandle())
+	bindResourceHandle(resources, NewServiceConfigResourceHandle())
+	bindResourceHandle(resources, NewServiceConfigOrderResourceHandle())
+	bindResourceHandle(resources, NewManualServiceResourceHandle())
+	bindResourceHandle(resources, NewHttpEndpointConfigResourceHandle())
+	bindResourceHandle(resources, NewMobileAppConfigResourceHandle())
+	bindResourceHandle(resources, NewWebsiteSourceMapConfigResourceHandle())
+	bindResourceHandle(resources, NewGlobalCustomPayloadConfigurationResourceHandle())
+	bindResourceHandle(resources, NewIdpMappingResourceHandle())
+	bindResourceHandle(resources, NewIdpSettingsResourceHandle())
+	bindResourceHandle(resources, NewGroupMembershipResourceHandle())
+	bindResourceHandle(resources, NewGroupPermissionSetResourceHandle())
+	bindResourceHandle(resources, NewUserInvitationResourceHandle())
+	bindResourceHandle(resources, NewSessionSettingsResourceHandle())
+	bindResourceHandle(resources, NewSyntheticCallsSettingsResourceHandle())
+	bindResourceHandle(resources, NewBuiltinEventStateResourceHandle())
+	bindResourceHandle(resources, NewHostAgentConfigurationResourceHandle())
 	return resources
 }
 
@@ -94,5 +117,9 @@ func providerDataSources() map[string]*schema.Resource {
 	dataSources[DataSourceBuiltinEvent] = NewBuiltinEventDataSource().CreateResource()
 	dataSources[DataSourceSyntheticLocation] = NewSyntheticLocationDataSource().CreateResource()
 	dataSources[DataSourceAlertingChannel] = NewAlertingChannelDataSource().CreateResource()
+	dataSources[DataSourceApdexReport] = NewApdexReportDataSource().CreateResource()
+	dataSources[DataSourceUsers] = NewUsersDataSource().CreateResource()
+	dataSources[DataSourceAlertConfigVersions] = NewAlertConfigVersionsDataSource().CreateResource()
+	dataSources[DataSourceHostAgents] = NewHostAgentsDataSource().CreateResource()
 	return dataSources
 }
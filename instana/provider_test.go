@@ -29,7 +29,7 @@ func TestProviderShouldContainValidSchemaDefinition(t *testing.T) {
 func TestProviderShouldContainValidResourceDefinitions(t *testing.T) {
 	config := Provider()
 
-	assert.Equal(t, 13, len(config.ResourcesMap))
+	assert.Equal(t, 36, len(config.ResourcesMap))
 
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAPIToken])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationConfig])
@@ -44,15 +44,42 @@ func TestProviderShouldContainValidResourceDefinitions(t *testing.T) {
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaCustomEventSpecification])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAlertingChannel])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAlertingConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaInfraAlertConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppAlertConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGlobalSyntheticAlertConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSyntheticCredential])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMaintenanceWindow])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaRelease])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApdexConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaServiceConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaServiceConfigOrder])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaManualService])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaHttpEndpointConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteSourceMapConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGlobalCustomPayloadConfiguration])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaIdpMapping])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaIdpSettings])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroupMembership])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroupPermissionSet])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaUserInvitation])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSessionSettings])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSyntheticCallsSettings])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaBuiltinEventState])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaHostAgentConfiguration])
 }
 
 func TestProviderShouldContainValidDataSourceDefinitions(t *testing.T) {
 	config := Provider()
 
-	assert.Equal(t, 3, len(config.DataSourcesMap))
+	assert.Equal(t, 7, len(config.DataSourcesMap))
 
 	assert.NotNil(t, config.DataSourcesMap[DataSourceBuiltinEvent])
 	assert.NotNil(t, config.DataSourcesMap[DataSourceSyntheticLocation])
 	assert.NotNil(t, config.DataSourcesMap[DataSourceAlertingChannel])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceApdexReport])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceUsers])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceAlertConfigVersions])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceHostAgents])
 
 }
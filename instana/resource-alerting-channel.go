@@ -3,6 +3,7 @@ package instana
 import (
 	"fmt"
 	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strings"
 
@@ -72,8 +73,38 @@ const (
 	AlertingChannelFieldChannelGoogleChat = "google_chat"
 	//AlertingChannelWebhookBasedFieldWebhookURL const for the webhookUrl field of the alerting channel
 	AlertingChannelWebhookBasedFieldWebhookURL = "webhook_url"
+
+	//AlertingChannelFieldChannelServiceNow const for schema field of the ServiceNow channel
+	AlertingChannelFieldChannelServiceNow = "service_now"
+	//AlertingChannelServiceNowFieldServiceNowURL const for the serviceNowUrl field of the ServiceNow alerting channel
+	AlertingChannelServiceNowFieldServiceNowURL = "service_now_url"
+	//AlertingChannelServiceNowFieldUsername const for the username field of the ServiceNow alerting channel
+	AlertingChannelServiceNowFieldUsername = "username"
+	//AlertingChannelServiceNowFieldPassword const for the password field of the ServiceNow alerting channel
+	AlertingChannelServiceNowFieldPassword = "password"
+
+	//AlertingChannelFieldChannelPrometheusWebhook const for schema field of the Prometheus Webhook channel
+	AlertingChannelFieldChannelPrometheusWebhook = "prometheus_webhook"
+	//AlertingChannelPrometheusWebhookFieldReceiver const for the receiver field of the Prometheus Webhook alerting channel
+	AlertingChannelPrometheusWebhookFieldReceiver = "receiver"
+
+	//AlertingChannelFieldChannelWatsonAIOpsWebhook const for schema field of the Watson AIOps Webhook channel
+	AlertingChannelFieldChannelWatsonAIOpsWebhook = "watson_aiops_webhook"
+
+	//AlertingChannelFieldVerifyOnApply const for the schema field verify_on_apply of the alerting channel
+	AlertingChannelFieldVerifyOnApply = "verify_on_apply"
+	//AlertingChannelFieldVerificationFailureSeverity const for the schema field verification_failure_severity of the alerting channel
+	AlertingChannelFieldVerificationFailureSeverity = "verification_failure_severity"
+
+	//AlertingChannelVerificationFailureSeverityError const for the verification failure severity error
+	AlertingChannelVerificationFailureSeverityError = "error"
+	//AlertingChannelVerificationFailureSeverityWarning const for the verification failure severity warning
+	AlertingChannelVerificationFailureSeverityWarning = "warning"
 )
 
+// AlertingChannelVerificationFailureSeverities the list of supported severities of failed verifications of alerting channels
+var AlertingChannelVerificationFailureSeverities = []string{AlertingChannelVerificationFailureSeverityError, AlertingChannelVerificationFailureSeverityWarning}
+
 var AlertingChannelTypeFields = []string{
 	AlertingChannelFieldChannelEmail,
 	AlertingChannelFieldChannelOpsGenie,
@@ -84,6 +115,9 @@ var AlertingChannelTypeFields = []string{
 	AlertingChannelFieldChannelWebhook,
 	AlertingChannelFieldChannelOffice365,
 	AlertingChannelFieldChannelGoogleChat,
+	AlertingChannelFieldChannelServiceNow,
+	AlertingChannelFieldChannelPrometheusWebhook,
+	AlertingChannelFieldChannelWatsonAIOpsWebhook,
 }
 
 // NewAlertingChannelResourceHandle creates the resource handle for Alerting Channels
@@ -98,6 +132,19 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 					Required:    true,
 					Description: "Configures the name of the alerting channel",
 				},
+				AlertingChannelFieldVerifyOnApply: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Optional flag to indicate whether a test notification is sent via the alerting channel after it has been created or updated. The default is false",
+				},
+				AlertingChannelFieldVerificationFailureSeverity: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      AlertingChannelVerificationFailureSeverityError,
+					ValidateFunc: validation.StringInSlice(AlertingChannelVerificationFailureSeverities, false),
+					Description:  fmt.Sprintf("The severity (%s) of the diagnostic reported when the test notification of verify_on_apply fails. The default is error", strings.Join(AlertingChannelVerificationFailureSeverities, ", ")),
+				},
 				AlertingChannelFieldChannelEmail: {
 					Type:         schema.TypeList,
 					Optional:     true,
@@ -302,6 +349,81 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 						},
 					},
 				},
+				AlertingChannelFieldChannelServiceNow: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the ServiceNow channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelServiceNowFieldServiceNowURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The URL of the ServiceNow instance of the ServiceNow alerting channel",
+							},
+							AlertingChannelServiceNowFieldUsername: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The username used to authenticate against the ServiceNow instance of the ServiceNow alerting channel",
+							},
+							AlertingChannelServiceNowFieldPassword: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								Description: "The password used to authenticate against the ServiceNow instance of the ServiceNow alerting channel",
+							},
+						},
+					},
+				},
+				AlertingChannelFieldChannelPrometheusWebhook: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the Prometheus Webhook channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelWebhookBasedFieldWebhookURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The webhook URL of the Prometheus Webhook alerting channel",
+							},
+							AlertingChannelPrometheusWebhookFieldReceiver: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The optional receiver of the Prometheus Webhook alerting channel",
+							},
+						},
+					},
+				},
+				AlertingChannelFieldChannelWatsonAIOpsWebhook: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the Watson AIOps Webhook channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelWebhookBasedFieldWebhookURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The webhook URL of the Watson AIOps Webhook alerting channel",
+							},
+							AlertingChannelWebhookFieldHTTPHeaders: {
+								Type: schema.TypeMap,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+								Optional:    true,
+								Description: "The optional map of HTTP headers of the Watson AIOps Webhook alerting channel",
+							},
+						},
+					},
+				},
 			},
 			SchemaVersion: 0,
 		},
@@ -328,6 +450,28 @@ func (r *alertingChannelResource) SetComputedFields(_ *schema.ResourceData) erro
 	return nil
 }
 
+// PostApply implementation of the interface PostApplyHook. Sends a test notification via the alerting channel when verify_on_apply is enabled and reports a failure as error or warning depending on the configured severity
+func (r *alertingChannelResource) PostApply(d *schema.ResourceData, api restapi.InstanaAPI, channel *restapi.AlertingChannel) diag.Diagnostics {
+	if !d.Get(AlertingChannelFieldVerifyOnApply).(bool) {
+		return nil
+	}
+	err := api.AlertingChannels().SendTestNotification(channel)
+	if err == nil {
+		return nil
+	}
+	severity := diag.Error
+	if d.Get(AlertingChannelFieldVerificationFailureSeverity).(string) == AlertingChannelVerificationFailureSeverityWarning {
+		severity = diag.Warning
+	}
+	return diag.Diagnostics{
+		{
+			Severity: severity,
+			Summary:  fmt.Sprintf("verification of alerting channel %s failed", channel.Name),
+			Detail:   fmt.Sprintf("the test notification of alerting channel %s could not be sent; %s", channel.Name, err.Error()),
+		},
+	}
+}
+
 func (r *alertingChannelResource) UpdateState(d *schema.ResourceData, alertingChannel *restapi.AlertingChannel) error {
 	data, err := r.mapChannelToState(alertingChannel)
 	if err != nil {
@@ -366,6 +510,15 @@ func (r *alertingChannelResource) mapChannelToState(channel *restapi.AlertingCha
 	if channel.Kind == restapi.GoogleChatChannelType {
 		return r.mapGoogleChatChannelToState(channel), nil
 	}
+	if channel.Kind == restapi.ServiceNowChannelType {
+		return r.mapServiceNowChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.PrometheusWebhookChannelType {
+		return r.mapPrometheusWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.WatsonAIOpsWebhookChannelType {
+		return r.mapWatsonAIOpsWebhookChannelToState(channel), nil
+	}
 	return nil, fmt.Errorf("received unsupported alerting channel of type %s", channel.Kind)
 }
 
@@ -499,6 +652,44 @@ func (r *alertingChannelResource) mapGoogleChatChannelToState(channel *restapi.A
 	}
 }
 
+func (r *alertingChannelResource) mapServiceNowChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelServiceNow: []interface{}{
+			map[string]interface{}{
+				AlertingChannelServiceNowFieldServiceNowURL: channel.ServiceNowURL,
+				AlertingChannelServiceNowFieldUsername:      channel.Username,
+				AlertingChannelServiceNowFieldPassword:      channel.Password,
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapPrometheusWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelPrometheusWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL:    channel.WebhookURL,
+				AlertingChannelPrometheusWebhookFieldReceiver: channel.Receiver,
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapWatsonAIOpsWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	headers := r.createHTTPHeaderMapFromList(channel.Headers)
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelWatsonAIOpsWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL: channel.WebhookURL,
+				AlertingChannelWebhookFieldHTTPHeaders:     headers,
+			},
+		},
+	}
+}
+
 func (r *alertingChannelResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.AlertingChannel, error) {
 	if channel, ok := d.GetOk(AlertingChannelFieldChannelEmail); ok && len(channel.([]interface{})) == 1 {
 		return r.mapStateToEmailObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
@@ -527,6 +718,15 @@ func (r *alertingChannelResource) MapStateToDataObject(d *schema.ResourceData) (
 	if channel, ok := d.GetOk(AlertingChannelFieldChannelGoogleChat); ok && len(channel.([]interface{})) == 1 {
 		return r.mapStateToWebhookBasedObject(restapi.GoogleChatChannelType, d, channel.([]interface{})[0].(map[string]interface{})), nil
 	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelServiceNow); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToServiceNowObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelPrometheusWebhook); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToPrometheusWebhookObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelWatsonAIOpsWebhook); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToWatsonAIOpsWebhookObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
 	return nil, fmt.Errorf("no supported alerting channel defined")
 }
 
@@ -638,3 +838,45 @@ func (r *alertingChannelResource) mapStateToWebhookBasedObject(channelType resta
 		WebhookURL: &webhookURL,
 	}
 }
+
+func (r *alertingChannelResource) mapStateToServiceNowObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	serviceNowURL := channelState[AlertingChannelServiceNowFieldServiceNowURL].(string)
+	username := channelState[AlertingChannelServiceNowFieldUsername].(string)
+	password := channelState[AlertingChannelServiceNowFieldPassword].(string)
+	return &restapi.AlertingChannel{
+		ID:            d.Id(),
+		Name:          d.Get(AlertingChannelFieldName).(string),
+		Kind:          restapi.ServiceNowChannelType,
+		ServiceNowURL: &serviceNowURL,
+		Username:      &username,
+		Password:      &password,
+	}
+}
+
+func (r *alertingChannelResource) mapStateToPrometheusWebhookObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	webhookURL := channelState[AlertingChannelWebhookBasedFieldWebhookURL].(string)
+	var receiver *string
+	if value, ok := channelState[AlertingChannelPrometheusWebhookFieldReceiver]; ok && len(value.(string)) > 0 {
+		receiverValue := value.(string)
+		receiver = &receiverValue
+	}
+	return &restapi.AlertingChannel{
+		ID:         d.Id(),
+		Name:       d.Get(AlertingChannelFieldName).(string),
+		Kind:       restapi.PrometheusWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Receiver:   receiver,
+	}
+}
+
+func (r *alertingChannelResource) mapStateToWatsonAIOpsWebhookObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	webhookURL := channelState[AlertingChannelWebhookBasedFieldWebhookURL].(string)
+	headers := r.createHTTPHeaderListFromMap(channelState)
+	return &restapi.AlertingChannel{
+		ID:         d.Id(),
+		Name:       d.Get(AlertingChannelFieldName).(string),
+		Kind:       restapi.WatsonAIOpsWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Headers:    headers,
+	}
+}
@@ -1,9 +1,13 @@
 package instana_test
 
 import (
+	"errors"
 	"fmt"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"go.uber.org/mock/gomock"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +28,9 @@ func TestAlertingChannelResource(t *testing.T) {
 	t.Run("CRUD integration test of with Webhook Channel", alertingChannelWebhookIntegrationTest().testCrud)
 	t.Run("CRUD integration test of with Office 365 Channel", alertingChannelOffice365IntegrationTest().testCrud)
 	t.Run("CRUD integration test of with Google Chat Channel", alertingChannelGoogleChatIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with ServiceNow Channel", alertingChannelServiceNowIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with Prometheus Webhook Channel", alertingChannelPrometheusWebhookIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with Watson AIOps Webhook Channel", alertingChannelWatsonAIOpsWebhookIntegrationTest().testCrud)
 	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
 	t.Run("should have schema version 0", unitTest.shouldHaveSchemaVersion0)
 	t.Run("should have no state upgrader", unitTest.shouldHaveNoStateUpgraders)
@@ -37,6 +44,9 @@ func TestAlertingChannelResource(t *testing.T) {
 	t.Run("should map Webhook channel to state", unitTest.shouldMapWebhookChannelToState)
 	t.Run("should map Office 365 channel to state", unitTest.shouldMapOffice365ChannelToState)
 	t.Run("should map Google Chat channel to state", unitTest.shouldMapGoogleChatChannelToState)
+	t.Run("should map ServiceNow channel to state", unitTest.shouldMapServiceNowChannelToState)
+	t.Run("should map Prometheus Webhook channel to state", unitTest.shouldMapPrometheusWebhookChannelToState)
+	t.Run("should map Watson AIOps Webhook channel to state", unitTest.shouldMapWatsonAIOpsWebhookChannelToState)
 	t.Run("should fail to map when channel type is not valid", unitTest.shouldFailToMapChannelWhenTypeIsNotValid)
 	t.Run("should map state of Email channel to data model", unitTest.shouldMapStateOfEmailChannelToDataModel)
 	t.Run("should map state of OpsGenie channel to data model", unitTest.shouldMapStateOfOpsGenieChannelToDataModel)
@@ -48,7 +58,15 @@ func TestAlertingChannelResource(t *testing.T) {
 	t.Run("should map state of Webhook channel with headers to data model", unitTest.shouldMapStateOfWebhookChannelWithHeadersToDataModel)
 	t.Run("should map state of Office 365 channel to data model", unitTest.shouldMapStateOfOffice365ChannelToDataModel)
 	t.Run("should map state of Google Chat channel to data model", unitTest.shouldMapStateOfGoogleChatChannelToDataModel)
+	t.Run("should map state of ServiceNow channel to data model", unitTest.shouldMapStateOfServiceNowChannelToDataModel)
+	t.Run("should map state of Prometheus Webhook channel to data model", unitTest.shouldMapStateOfPrometheusWebhookChannelToDataModel)
+	t.Run("should map state of Prometheus Webhook channel without receiver to data model", unitTest.shouldMapStateOfPrometheusWebhookChannelWithoutReceiverToDataModel)
+	t.Run("should map state of Watson AIOps Webhook channel to data model", unitTest.shouldMapStateOfWatsonAIOpsWebhookChannelToDataModel)
 	t.Run("should fail to map state when no channel is provided", unitTest.shouldFailToMapStateWhenNoChannelIsProvided)
+	t.Run("should not send test notification when verify on apply is disabled", unitTest.shouldNotSendTestNotificationWhenVerifyOnApplyIsDisabled)
+	t.Run("should send test notification when verify on apply is enabled", unitTest.shouldSendTestNotificationWhenVerifyOnApplyIsEnabled)
+	t.Run("should report error when test notification fails", unitTest.shouldReportErrorWhenTestNotificationFails)
+	t.Run("should report warning when test notification fails and severity is warning", unitTest.shouldReportWarningWhenTestNotificationFailsAndSeverityIsWarning)
 }
 
 const (
@@ -325,6 +343,101 @@ resource "instana_alerting_channel" "example" {
 	)
 }
 
+func alertingChannelServiceNowIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  service_now {
+    service_now_url      = "https://example.service-now.com"
+    username             = "user"
+    password             = "secret"
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "SERVICE_NOW_WEBHOOK",
+	"serviceNowUrl": "https://example.service-now.com",
+	"username": "user",
+	"password": "secret"
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldServiceNowURL), "https://example.service-now.com"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldUsername), "user"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldPassword), "secret"),
+		},
+	)
+}
+
+func alertingChannelPrometheusWebhookIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  prometheus_webhook {
+    webhook_url = "webhook-url"
+    receiver    = "receiver"
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "PROMETHEUS_WEBHOOK",
+	"webhookUrl": "webhook-url",
+	"receiver": "receiver"
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelPrometheusWebhookFieldReceiver), "receiver"),
+		},
+	)
+}
+
+func alertingChannelWatsonAIOpsWebhookIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  watson_aiops_webhook {
+    webhook_url = "webhook-url"
+    http_headers = {
+      key1 = "value1"
+    }
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "WATSON_AIOPS_WEBHOOK",
+	"webhookUrl": "webhook-url",
+	"headers": [ "key1: value1" ]
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookFieldHTTPHeaders)+".key1", "value1"),
+		},
+	)
+}
+
 func newAlertingChannelIntegrationTest(resourceTemplate string, resourceName string, serverResponseTemplate string, useCaseSpecificChecks []resource.TestCheckFunc) *alertingChannelIntegrationTest {
 	return &alertingChannelIntegrationTest{
 		resourceTemplate:       resourceTemplate,
@@ -378,8 +491,10 @@ func (r *alertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	schemaData := NewAlertingChannelResourceHandle().MetaData().Schema
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
-	require.Len(t, schemaData, 10)
+	require.Len(t, schemaData, 15)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(AlertingChannelFieldVerifyOnApply, false)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(AlertingChannelFieldVerificationFailureSeverity, AlertingChannelVerificationFailureSeverityError)
 
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelEmail)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelOpsGenie)
@@ -390,6 +505,9 @@ func (r *alertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelWebhook)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelOffice365)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelGoogleChat)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelServiceNow)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelPrometheusWebhook)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelWatsonAIOpsWebhook)
 
 	r.validateEmailChannelSchema(t, schemaData[AlertingChannelFieldChannelEmail].Elem.(*schema.Resource).Schema)
 	r.validateOpsGenieChannelSchema(t, schemaData[AlertingChannelFieldChannelOpsGenie].Elem.(*schema.Resource).Schema)
@@ -400,6 +518,9 @@ func (r *alertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	r.validateWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWebhook].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelOffice365].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelGoogleChat].Elem.(*schema.Resource).Schema)
+	r.validateServiceNowChannelSchema(t, schemaData[AlertingChannelFieldChannelServiceNow].Elem.(*schema.Resource).Schema)
+	r.validatePrometheusWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelPrometheusWebhook].Elem.(*schema.Resource).Schema)
+	r.validateWatsonAIOpsWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWatsonAIOpsWebhook].Elem.(*schema.Resource).Schema)
 }
 
 func (r *alertingChannelUnitTest) validateEmailChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
@@ -457,6 +578,29 @@ func (r *alertingChannelUnitTest) validateWebhookBasedChannelSchema(t *testing.T
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
 }
 
+func (r *alertingChannelUnitTest) validateServiceNowChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 3)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelServiceNowFieldServiceNowURL)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelServiceNowFieldUsername)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelServiceNowFieldPassword)
+	require.True(t, channelSchema[AlertingChannelServiceNowFieldPassword].Sensitive)
+}
+
+func (r *alertingChannelUnitTest) validatePrometheusWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelPrometheusWebhookFieldReceiver)
+}
+
+func (r *alertingChannelUnitTest) validateWatsonAIOpsWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeMapOfStrings(AlertingChannelWebhookFieldHTTPHeaders)
+}
+
 func (r *alertingChannelUnitTest) shouldHaveSchemaVersion0(t *testing.T) {
 	require.Equal(t, 0, NewAlertingChannelResourceHandle().MetaData().SchemaVersion)
 }
@@ -1158,3 +1302,226 @@ func (r *alertingChannelUnitTest) shouldFailToMapStateWhenNoChannelIsProvided(t
 	require.Error(t, err)
 	require.ErrorContains(t, err, "no supported alerting channel defined")
 }
+
+func (r *alertingChannelUnitTest) createEmailChannel() *restapi.AlertingChannel {
+	return &restapi.AlertingChannel{
+		ID:     "id",
+		Name:   resourceName,
+		Kind:   restapi.EmailChannelType,
+		Emails: []string{"email1"},
+	}
+}
+
+func (r *alertingChannelUnitTest) shouldNotSendTestNotificationWhenVerifyOnApplyIsDisabled(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewAlertingChannelResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+		sut := resourceHandle.(PostApplyHook[*restapi.AlertingChannel])
+		result := sut.PostApply(resourceData, providerMeta.InstanaAPI, r.createEmailChannel())
+
+		require.Nil(t, result)
+	})
+}
+
+func (r *alertingChannelUnitTest) shouldSendTestNotificationWhenVerifyOnApplyIsEnabled(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewAlertingChannelResourceHandle()
+		resourceData := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{
+			AlertingChannelFieldVerifyOnApply: true,
+		})
+		channel := r.createEmailChannel()
+		mockRestResource := mocks.NewMockAlertingChannelRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().SendTestNotification(channel).Return(nil).Times(1)
+
+		sut := resourceHandle.(PostApplyHook[*restapi.AlertingChannel])
+		result := sut.PostApply(resourceData, providerMeta.InstanaAPI, channel)
+
+		require.Nil(t, result)
+	})
+}
+
+func (r *alertingChannelUnitTest) shouldReportErrorWhenTestNotificationFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewAlertingChannelResourceHandle()
+		resourceData := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{
+			AlertingChannelFieldVerifyOnApply: true,
+		})
+		channel := r.createEmailChannel()
+		mockRestResource := mocks.NewMockAlertingChannelRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().SendTestNotification(channel).Return(errors.New("test")).Times(1)
+
+		sut := resourceHandle.(PostApplyHook[*restapi.AlertingChannel])
+		result := sut.PostApply(resourceData, providerMeta.InstanaAPI, channel)
+
+		require.Len(t, result, 1)
+		require.True(t, result.HasError())
+		require.Equal(t, diag.Error, result[0].Severity)
+		require.Contains(t, result[0].Summary, resourceName)
+		require.Contains(t, result[0].Detail, "test")
+	})
+}
+
+func (r *alertingChannelUnitTest) shouldReportWarningWhenTestNotificationFailsAndSeverityIsWarning(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewAlertingChannelResourceHandle()
+		resourceData := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{
+			AlertingChannelFieldVerifyOnApply:               true,
+			AlertingChannelFieldVerificationFailureSeverity: AlertingChannelVerificationFailureSeverityWarning,
+		})
+		channel := r.createEmailChannel()
+		mockRestResource := mocks.NewMockAlertingChannelRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().SendTestNotification(channel).Return(errors.New("test")).Times(1)
+
+		sut := resourceHandle.(PostApplyHook[*restapi.AlertingChannel])
+		result := sut.PostApply(resourceData, providerMeta.InstanaAPI, channel)
+
+		require.Len(t, result, 1)
+		require.False(t, result.HasError())
+		require.Equal(t, diag.Warning, result[0].Severity)
+	})
+}
+
+func (r *alertingChannelUnitTest) updateStateAndGetChannel(t *testing.T, data *restapi.AlertingChannel, channelField string) map[string]interface{} {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	sut := NewAlertingChannelResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, data)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(AlertingChannelFieldName))
+	r.verifyChannelIsMappedToResource(t, resourceData, channelField)
+	return resourceData.Get(channelField).([]interface{})[0].(map[string]interface{})
+}
+
+func (r *alertingChannelUnitTest) shouldMapServiceNowChannelToState(t *testing.T) {
+	serviceNowURL := "https://example.service-now.com"
+	username := "user"
+	password := "secret"
+	data := &restapi.AlertingChannel{
+		ID:            "id",
+		Name:          resourceName,
+		Kind:          restapi.ServiceNowChannelType,
+		ServiceNowURL: &serviceNowURL,
+		Username:      &username,
+		Password:      &password,
+	}
+
+	channel := r.updateStateAndGetChannel(t, data, AlertingChannelFieldChannelServiceNow)
+
+	require.Equal(t, map[string]interface{}{
+		AlertingChannelServiceNowFieldServiceNowURL: serviceNowURL,
+		AlertingChannelServiceNowFieldUsername:      username,
+		AlertingChannelServiceNowFieldPassword:      password,
+	}, channel)
+}
+
+func (r *alertingChannelUnitTest) shouldMapPrometheusWebhookChannelToState(t *testing.T) {
+	webhookURL := "webhook-url"
+	receiver := "receiver"
+	data := &restapi.AlertingChannel{
+		ID:         "id",
+		Name:       resourceName,
+		Kind:       restapi.PrometheusWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Receiver:   &receiver,
+	}
+
+	channel := r.updateStateAndGetChannel(t, data, AlertingChannelFieldChannelPrometheusWebhook)
+
+	require.Equal(t, map[string]interface{}{
+		AlertingChannelWebhookBasedFieldWebhookURL:    webhookURL,
+		AlertingChannelPrometheusWebhookFieldReceiver: receiver,
+	}, channel)
+}
+
+func (r *alertingChannelUnitTest) shouldMapWatsonAIOpsWebhookChannelToState(t *testing.T) {
+	webhookURL := "webhook-url"
+	data := &restapi.AlertingChannel{
+		ID:         "id",
+		Name:       resourceName,
+		Kind:       restapi.WatsonAIOpsWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Headers:    []string{"key1: value1", "key2"},
+	}
+
+	channel := r.updateStateAndGetChannel(t, data, AlertingChannelFieldChannelWatsonAIOpsWebhook)
+
+	require.Equal(t, map[string]interface{}{
+		AlertingChannelWebhookBasedFieldWebhookURL: webhookURL,
+		AlertingChannelWebhookFieldHTTPHeaders:     map[string]interface{}{"key1": "value1", "key2": ""},
+	}, channel)
+}
+
+func (r *alertingChannelUnitTest) mapStateToDataModel(t *testing.T, channelField string, channelState map[string]interface{}) *restapi.AlertingChannel {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, channelField, []interface{}{channelState})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", result.GetIDForResourcePath())
+	require.Equal(t, resourceName, result.Name)
+	return result
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfServiceNowChannelToDataModel(t *testing.T) {
+	result := r.mapStateToDataModel(t, AlertingChannelFieldChannelServiceNow, map[string]interface{}{
+		AlertingChannelServiceNowFieldServiceNowURL: "https://example.service-now.com",
+		AlertingChannelServiceNowFieldUsername:      "user",
+		AlertingChannelServiceNowFieldPassword:      "secret",
+	})
+
+	require.Equal(t, restapi.ServiceNowChannelType, result.Kind)
+	require.Equal(t, "https://example.service-now.com", *result.ServiceNowURL)
+	require.Equal(t, "user", *result.Username)
+	require.Equal(t, "secret", *result.Password)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfPrometheusWebhookChannelToDataModel(t *testing.T) {
+	result := r.mapStateToDataModel(t, AlertingChannelFieldChannelPrometheusWebhook, map[string]interface{}{
+		AlertingChannelWebhookBasedFieldWebhookURL:    "webhook-url",
+		AlertingChannelPrometheusWebhookFieldReceiver: "receiver",
+	})
+
+	require.Equal(t, restapi.PrometheusWebhookChannelType, result.Kind)
+	require.Equal(t, "webhook-url", *result.WebhookURL)
+	require.Equal(t, "receiver", *result.Receiver)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfPrometheusWebhookChannelWithoutReceiverToDataModel(t *testing.T) {
+	result := r.mapStateToDataModel(t, AlertingChannelFieldChannelPrometheusWebhook, map[string]interface{}{
+		AlertingChannelWebhookBasedFieldWebhookURL: "webhook-url",
+	})
+
+	require.Equal(t, restapi.PrometheusWebhookChannelType, result.Kind)
+	require.Equal(t, "webhook-url", *result.WebhookURL)
+	require.Nil(t, result.Receiver)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfWatsonAIOpsWebhookChannelToDataModel(t *testing.T) {
+	result := r.mapStateToDataModel(t, AlertingChannelFieldChannelWatsonAIOpsWebhook, map[string]interface{}{
+		AlertingChannelWebhookBasedFieldWebhookURL: "webhook-url",
+		AlertingChannelWebhookFieldHTTPHeaders:     map[string]interface{}{"key1": "value1"},
+	})
+
+	require.Equal(t, restapi.WatsonAIOpsWebhookChannelType, result.Kind)
+	require.Equal(t, "webhook-url", *result.WebhookURL)
+	require.Equal(t, []string{"key1: value1"}, result.Headers)
+}
@@ -0,0 +1,213 @@
+package instana
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaApdexConfig the name of the terraform-provider-instana resource to manage apdex configurations
+const ResourceInstanaApdexConfig = "instana_apdex_config"
+
+const (
+	//ApdexConfigFieldName constant value for the schema field name
+	ApdexConfigFieldName = "name"
+	//ApdexConfigFieldEntityType constant value for the schema field entity_type
+	ApdexConfigFieldEntityType = "entity_type"
+	//ApdexConfigFieldEntityID constant value for the schema field entity_id
+	ApdexConfigFieldEntityID = "entity_id"
+	//ApdexConfigFieldThreshold constant value for the schema field threshold
+	ApdexConfigFieldThreshold = "threshold"
+	//ApdexConfigFieldTagFilter constant value for the schema field tag_filter
+	ApdexConfigFieldTagFilter = "tag_filter"
+	//ApdexConfigFieldBoundaryScope constant value for the schema field boundary_scope
+	ApdexConfigFieldBoundaryScope = "boundary_scope"
+	//ApdexConfigFieldIncludeInternal constant value for the schema field include_internal
+	ApdexConfigFieldIncludeInternal = "include_internal"
+	//ApdexConfigFieldIncludeSynthetic constant value for the schema field include_synthetic
+	ApdexConfigFieldIncludeSynthetic = "include_synthetic"
+	//ApdexConfigFieldBeaconType constant value for the schema field beacon_type
+	ApdexConfigFieldBeaconType = "beacon_type"
+)
+
+// NewApdexConfigResourceHandle creates the resource handle for apdex configurations
+func NewApdexConfigResourceHandle() ResourceHandle[*restapi.ApdexConfig] {
+	return &apdexConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaApdexConfig,
+			Schema: map[string]*schema.Schema{
+				ApdexConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(0, 256),
+					Description:  "The name of the apdex configuration",
+				},
+				ApdexConfigFieldEntityType: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(restapi.SupportedApdexEntityTypes.ToStringSlice(), false),
+					Description:  "The type of the entity the apdex is calculated for (application, website)",
+				},
+				ApdexConfigFieldEntityID: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The ID of the application perspective or website the apdex is calculated for",
+				},
+				ApdexConfigFieldThreshold: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The threshold in milliseconds which is used to classify calls or beacons as satisfied",
+				},
+				ApdexConfigFieldTagFilter: {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The tag filter expression used to restrict the calls or beacons taken into account",
+					DiffSuppressFunc: tagFilterDiffSuppressFunc,
+					StateFunc:        tagFilterStateFunc,
+					ValidateFunc:     tagFilterValidateFunc,
+				},
+				ApdexConfigFieldBoundaryScope: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(restapi.SupportedApplicationAlertConfigBoundaryScopes.ToStringSlice(), false),
+					Description:  "The boundary scope of the application perspective (ALL, INBOUND). Required when entity_type is application",
+				},
+				ApdexConfigFieldIncludeInternal: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     false,
+					Description: "Optional flag to indicate whether also internal calls are included. Only applicable when entity_type is application",
+				},
+				ApdexConfigFieldIncludeSynthetic: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     false,
+					Description: "Optional flag to indicate whether also synthetic calls are included. Only applicable when entity_type is application",
+				},
+				ApdexConfigFieldBeaconType: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice([]string{"pageLoad", "resourceLoad", "httpRequest", "error", "custom", "pageChange"}, false),
+					Description:  "The beacon type of the website (pageLoad, resourceLoad, httpRequest, error, custom, pageChange). Required when entity_type is website",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type apdexConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *apdexConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *apdexConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *apdexConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApdexConfig] {
+	return api.ApdexConfigs()
+}
+
+func (r *apdexConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *apdexConfigResource) UpdateState(d *schema.ResourceData, config *restapi.ApdexConfig) error {
+	var normalizedTagFilterString *string
+	var err error
+	if config.Entity.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.Entity.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	data := map[string]interface{}{
+		ApdexConfigFieldName:             config.Name,
+		ApdexConfigFieldEntityType:       string(config.Entity.Type),
+		ApdexConfigFieldEntityID:         config.Entity.EntityID,
+		ApdexConfigFieldThreshold:        int(config.Entity.Threshold),
+		ApdexConfigFieldTagFilter:        normalizedTagFilterString,
+		ApdexConfigFieldBoundaryScope:    config.Entity.BoundaryScope,
+		ApdexConfigFieldIncludeInternal:  config.Entity.IncludeInternal != nil && *config.Entity.IncludeInternal,
+		ApdexConfigFieldIncludeSynthetic: config.Entity.IncludeSynthetic != nil && *config.Entity.IncludeSynthetic,
+		ApdexConfigFieldBeaconType:       config.Entity.BeaconType,
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *apdexConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ApdexConfig, error) {
+	tagFilter, err := r.mapTagFilterFromState(d)
+	if err != nil {
+		return nil, err
+	}
+
+	entity := restapi.ApdexEntity{
+		Type:                restapi.ApdexEntityType(d.Get(ApdexConfigFieldEntityType).(string)),
+		EntityID:            d.Get(ApdexConfigFieldEntityID).(string),
+		TagFilterExpression: tagFilter,
+		Threshold:           int32(d.Get(ApdexConfigFieldThreshold).(int)),
+	}
+
+	switch entity.Type {
+	case restapi.ApdexEntityTypeApplication:
+		boundaryScope, ok := d.GetOk(ApdexConfigFieldBoundaryScope)
+		if !ok {
+			return nil, fmt.Errorf("%s is required when %s is %s", ApdexConfigFieldBoundaryScope, ApdexConfigFieldEntityType, entity.Type)
+		}
+		scope := restapi.BoundaryScope(boundaryScope.(string))
+		includeInternal := d.Get(ApdexConfigFieldIncludeInternal).(bool)
+		includeSynthetic := d.Get(ApdexConfigFieldIncludeSynthetic).(bool)
+		entity.BoundaryScope = &scope
+		entity.IncludeInternal = &includeInternal
+		entity.IncludeSynthetic = &includeSynthetic
+	case restapi.ApdexEntityTypeWebsite:
+		beaconType, ok := d.GetOk(ApdexConfigFieldBeaconType)
+		if !ok {
+			return nil, fmt.Errorf("%s is required when %s is %s", ApdexConfigFieldBeaconType, ApdexConfigFieldEntityType, entity.Type)
+		}
+		beaconTypeString := beaconType.(string)
+		entity.BeaconType = &beaconTypeString
+	default:
+		return nil, fmt.Errorf("unsupported apdex entity type %s", entity.Type)
+	}
+
+	return &restapi.ApdexConfig{
+		ID:     d.Id(),
+		Name:   d.Get(ApdexConfigFieldName).(string),
+		Entity: entity,
+	}, nil
+}
+
+func (r *apdexConfigResource) mapTagFilterFromState(d *schema.ResourceData) (*restapi.TagFilter, error) {
+	tagFilterString, ok := d.GetOk(ApdexConfigFieldTagFilter)
+	if !ok {
+		return restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{}), nil
+	}
+	expr, err := tagfilter.NewParser().Parse(tagFilterString.(string))
+	if err != nil {
+		return nil, err
+	}
+	return tagfilter.NewMapper().ToAPIModel(expr), nil
+}
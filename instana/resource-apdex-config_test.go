@@ -0,0 +1,346 @@
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestApdexConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaApdexConfig + ".example"
+	inst := &apdexConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewApdexConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type apdexConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.ApdexConfig]
+}
+
+var apdexConfigTerraformTemplate = `
+resource "instana_apdex_config" "example" {
+	name              = "name %d"
+	entity_type       = "application"
+	entity_id         = "application-id"
+	threshold         = 200
+	tag_filter        = "call.type@na EQUALS 'HTTP'"
+	boundary_scope    = "INBOUND"
+	include_internal  = true
+	include_synthetic = false
+}
+`
+
+var apdexConfigServerResponseTemplate = `
+{
+	"id": "%s",
+	"apdexName": "name %d",
+	"apdexEntity": {
+		"apdexType": "application",
+		"entityId": "application-id",
+		"threshold": 200,
+		"boundaryScope": "INBOUND",
+		"includeInternal": true,
+		"includeSynthetic": false,
+		"tagFilterExpression": {
+			"type": "TAG_FILTER",
+			"name": "call.type",
+			"stringValue": "HTTP",
+			"operator": "EQUALS",
+			"entity": "NOT_APPLICABLE"
+		}
+	}
+}
+`
+
+func (test *apdexConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaApdexConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaApdexConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaApdexConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaApdexConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should force new resource on any change", ResourceInstanaApdexConfig), test.createTestResourceShouldForceNewResourceOnAnyChange())
+	t.Run(fmt.Sprintf("%s should update terraform state from application model", ResourceInstanaApdexConfig), test.createTestShouldUpdateTerraformResourceStateFromApplicationModel())
+	t.Run(fmt.Sprintf("%s should update terraform state from website model", ResourceInstanaApdexConfig), test.createTestShouldUpdateTerraformResourceStateFromWebsiteModel())
+	t.Run(fmt.Sprintf("%s should map terraform state of application to model", ResourceInstanaApdexConfig), test.createTestShouldMapTerraformResourceStateOfApplicationToModel())
+	t.Run(fmt.Sprintf("%s should map terraform state of website without tag filter to model", ResourceInstanaApdexConfig), test.createTestShouldMapTerraformResourceStateOfWebsiteWithoutTagFilterToModel())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state of application when boundary scope is missing", ResourceInstanaApdexConfig), test.createTestShouldFailToMapTerraformResourceStateOfApplicationWhenBoundaryScopeIsMissing())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state of website when beacon type is missing", ResourceInstanaApdexConfig), test.createTestShouldFailToMapTerraformResourceStateOfWebsiteWhenBeaconTypeIsMissing())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state when tag filter is invalid", ResourceInstanaApdexConfig), test.createTestShouldFailToMapTerraformResourceStateWhenTagFilterIsInvalid())
+}
+
+func (test *apdexConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.ApdexConfigResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		responseHandler := func(w http.ResponseWriter, r *http.Request) {
+			callCount := getZeroBasedCallCount(httpServer, http.MethodPost, resourceRestAPIPath)
+			jsonData := fmt.Sprintf(apdexConfigServerResponseTemplate, id, callCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		}
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, responseHandler)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, responseHandler)
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+				{
+					Config:      appendProviderConfig(fmt.Sprintf(apdexConfigTerraformTemplate, 1), httpServer.GetPort()),
+					ExpectError: regexp.MustCompile("update operations not supported for instana_apdex_config resources"),
+				},
+			},
+		})
+	}
+}
+
+func (test *apdexConfigTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(apdexConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", id),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldEntityType, "application"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldEntityID, "application-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldThreshold, "200"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldTagFilter, "call.type@na EQUALS 'HTTP'"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldBoundaryScope, "INBOUND"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldIncludeInternal, trueAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ApdexConfigFieldIncludeSynthetic, falseAsString),
+		),
+	}
+}
+
+func (test *apdexConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *apdexConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *apdexConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_apdex_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *apdexConfigTest) createTestResourceShouldForceNewResourceOnAnyChange() func(t *testing.T) {
+	return func(t *testing.T) {
+		for name, s := range test.resourceHandle.MetaData().Schema {
+			require.Truef(t, s.ForceNew, "field %s should force a new resource", name)
+		}
+		require.Nil(t, NewTerraformResource(test.resourceHandle).ToSchemaResource().UpdateContext)
+		require.True(t, test.resourceHandle.MetaData().SkipIDGeneration)
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldUpdateTerraformResourceStateFromApplicationModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		boundaryScope := restapi.BoundaryScopeInbound
+		includeInternal := true
+		includeSynthetic := false
+		tagFilter := restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "call.type", restapi.EqualsOperator, "HTTP")
+		config := &restapi.ApdexConfig{
+			ID:   "apdex-id",
+			Name: "apdex-name",
+			Entity: restapi.ApdexEntity{
+				Type:                restapi.ApdexEntityTypeApplication,
+				EntityID:            "application-id",
+				TagFilterExpression: tagFilter,
+				Threshold:           200,
+				BoundaryScope:       &boundaryScope,
+				IncludeInternal:     &includeInternal,
+				IncludeSynthetic:    &includeSynthetic,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "apdex-id", resourceData.Id())
+		require.Equal(t, "apdex-name", resourceData.Get(ApdexConfigFieldName))
+		require.Equal(t, "application", resourceData.Get(ApdexConfigFieldEntityType))
+		require.Equal(t, "application-id", resourceData.Get(ApdexConfigFieldEntityID))
+		require.Equal(t, 200, resourceData.Get(ApdexConfigFieldThreshold))
+		require.Equal(t, "call.type@na EQUALS 'HTTP'", resourceData.Get(ApdexConfigFieldTagFilter))
+		require.Equal(t, "INBOUND", resourceData.Get(ApdexConfigFieldBoundaryScope))
+		require.True(t, resourceData.Get(ApdexConfigFieldIncludeInternal).(bool))
+		require.False(t, resourceData.Get(ApdexConfigFieldIncludeSynthetic).(bool))
+		require.Empty(t, resourceData.Get(ApdexConfigFieldBeaconType))
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldUpdateTerraformResourceStateFromWebsiteModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		beaconType := "pageLoad"
+		config := &restapi.ApdexConfig{
+			ID:   "apdex-id",
+			Name: "apdex-name",
+			Entity: restapi.ApdexEntity{
+				Type:                restapi.ApdexEntityTypeWebsite,
+				EntityID:            "website-id",
+				TagFilterExpression: restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{}),
+				Threshold:           400,
+				BeaconType:          &beaconType,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "apdex-id", resourceData.Id())
+		require.Equal(t, "website", resourceData.Get(ApdexConfigFieldEntityType))
+		require.Equal(t, "website-id", resourceData.Get(ApdexConfigFieldEntityID))
+		require.Equal(t, 400, resourceData.Get(ApdexConfigFieldThreshold))
+		require.Empty(t, resourceData.Get(ApdexConfigFieldTagFilter))
+		require.Empty(t, resourceData.Get(ApdexConfigFieldBoundaryScope))
+		require.False(t, resourceData.Get(ApdexConfigFieldIncludeInternal).(bool))
+		require.False(t, resourceData.Get(ApdexConfigFieldIncludeSynthetic).(bool))
+		require.Equal(t, "pageLoad", resourceData.Get(ApdexConfigFieldBeaconType))
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldMapTerraformResourceStateOfApplicationToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("apdex-id")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldName, "apdex-name")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityType, "application")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityID, "application-id")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldThreshold, 200)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldTagFilter, "call.type@na EQUALS 'HTTP'")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldBoundaryScope, "INBOUND")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldIncludeInternal, true)
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		boundaryScope := restapi.BoundaryScopeInbound
+		includeInternal := true
+		includeSynthetic := false
+		require.Equal(t, &restapi.ApdexConfig{
+			ID:   "apdex-id",
+			Name: "apdex-name",
+			Entity: restapi.ApdexEntity{
+				Type:                restapi.ApdexEntityTypeApplication,
+				EntityID:            "application-id",
+				TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "call.type", restapi.EqualsOperator, "HTTP"),
+				Threshold:           200,
+				BoundaryScope:       &boundaryScope,
+				IncludeInternal:     &includeInternal,
+				IncludeSynthetic:    &includeSynthetic,
+			},
+		}, result)
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldMapTerraformResourceStateOfWebsiteWithoutTagFilterToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("apdex-id")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldName, "apdex-name")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityType, "website")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityID, "website-id")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldThreshold, 400)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldBeaconType, "pageLoad")
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		beaconType := "pageLoad"
+		require.Equal(t, &restapi.ApdexConfig{
+			ID:   "apdex-id",
+			Name: "apdex-name",
+			Entity: restapi.ApdexEntity{
+				Type:                restapi.ApdexEntityTypeWebsite,
+				EntityID:            "website-id",
+				TagFilterExpression: restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{}),
+				Threshold:           400,
+				BeaconType:          &beaconType,
+			},
+		}, result)
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldFailToMapTerraformResourceStateOfApplicationWhenBoundaryScopeIsMissing() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldName, "apdex-name")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityType, "application")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityID, "application-id")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldThreshold, 200)
+
+		_, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), ApdexConfigFieldBoundaryScope)
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldFailToMapTerraformResourceStateOfWebsiteWhenBeaconTypeIsMissing() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldName, "apdex-name")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityType, "website")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityID, "website-id")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldThreshold, 200)
+
+		_, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), ApdexConfigFieldBeaconType)
+	}
+}
+
+func (test *apdexConfigTest) createTestShouldFailToMapTerraformResourceStateWhenTagFilterIsInvalid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldName, "apdex-name")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityType, "website")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityID, "website-id")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldThreshold, 200)
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldBeaconType, "pageLoad")
+		setValueOnResourceData(t, resourceData, ApdexConfigFieldTagFilter, "invalid invalid invalid")
+
+		_, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
 	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -37,6 +39,8 @@ const (
 	ApplicationAlertConfigFieldBoundaryScope = "boundary_scope"
 	//ApplicationAlertConfigFieldDescription constant value for field description of resource instana_application_alert_config
 	ApplicationAlertConfigFieldDescription = "description"
+	//ApplicationAlertConfigFieldEnabled constant value for field enabled of resource instana_application_alert_config
+	ApplicationAlertConfigFieldEnabled = "enabled"
 	//ApplicationAlertConfigFieldEvaluationType constant value for field evaluation_type of resource instana_application_alert_config
 	ApplicationAlertConfigFieldEvaluationType = "evaluation_type"
 	//ApplicationAlertConfigFieldGranularity constant value for field granularity of resource instana_application_alert_config
@@ -475,6 +479,12 @@ var (
 		Default:     false,
 		Description: "Optional flag to indicate whether also an Incident is triggered or not. The default is false",
 	}
+	applicationAlertConfigSchemaEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Optional flag to indicate whether the application alert config is enabled or not. Changes of this flag are applied via the enable/disable endpoints without rewriting the full configuration. The default is true",
+	}
 )
 
 var applicationAlertConfigResourceSchema = map[string]*schema.Schema{
@@ -483,6 +493,7 @@ var applicationAlertConfigResourceSchema = map[string]*schema.Schema{
 	ApplicationAlertConfigFieldBoundaryScope:    applicationAlertConfigSchemaBoundaryScope,
 	DefaultCustomPayloadFieldsName:              buildCustomPayloadFields(),
 	ApplicationAlertConfigFieldDescription:      applicationAlertConfigSchemaDescription,
+	ApplicationAlertConfigFieldEnabled:          applicationAlertConfigSchemaEnabled,
 	ApplicationAlertConfigFieldEvaluationType:   applicationAlertConfigSchemaEvaluationType,
 	ApplicationAlertConfigFieldGranularity:      applicationAlertConfigSchemaGranularity,
 	ApplicationAlertConfigFieldIncludeInternal:  applicationAlertConfigSchemaIncludeInternal,
@@ -491,6 +502,7 @@ var applicationAlertConfigResourceSchema = map[string]*schema.Schema{
 	ApplicationAlertConfigFieldRule:             applicationAlertConfigSchemaRule,
 	ApplicationAlertConfigFieldSeverity:         applicationAlertConfigSchemaSeverity,
 	ApplicationAlertConfigFieldTagFilter:        applicationAlertConfigSchemaTagFilter,
+	ResourceFieldRestoreVersion:                 restoreVersionSchema,
 	ResourceFieldThreshold:                      thresholdSchema,
 	ApplicationAlertConfigFieldTimeThreshold:    applicationAlertConfigSchemaTimeThreshold,
 	ApplicationAlertConfigFieldTriggering:       applicationAlertConfigSchemaTriggering,
@@ -498,15 +510,18 @@ var applicationAlertConfigResourceSchema = map[string]*schema.Schema{
 
 // NewApplicationAlertConfigResourceHandle creates a new instance of the ResourceHandle for application alert configs
 func NewApplicationAlertConfigResourceHandle() ResourceHandle[*restapi.ApplicationAlertConfig] {
-	return &applicationAlertConfigResource{
-		metaData: ResourceMetaData{
-			ResourceName:     ResourceInstanaApplicationAlertConfig,
-			Schema:           applicationAlertConfigResourceSchema,
-			SkipIDGeneration: true,
-			SchemaVersion:    1,
-		},
-		resourceProvider: func(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApplicationAlertConfig] {
-			return api.ApplicationAlertConfigs()
+	return &baselineAwareApplicationAlertConfigResource{
+		applicationAlertConfigResource: applicationAlertConfigResource{
+			metaData: ResourceMetaData{
+				ResourceName:     ResourceInstanaApplicationAlertConfig,
+				Schema:           withBaselineRefreshTriggerSchema(applicationAlertConfigResourceSchema),
+				SkipIDGeneration: true,
+				SchemaVersion:    1,
+				CustomizeDiff:    validateRestoreVersionChange,
+			},
+			resourceProvider: func(api restapi.InstanaAPI) restapi.AlertConfigRestResource[*restapi.ApplicationAlertConfig] {
+				return api.ApplicationAlertConfigs()
+			},
 		},
 	}
 }
@@ -518,8 +533,9 @@ func NewGlobalApplicationAlertConfigResourceHandle() ResourceHandle[*restapi.App
 			ResourceName:  ResourceInstanaGlobalApplicationAlertConfig,
 			Schema:        applicationAlertConfigResourceSchema,
 			SchemaVersion: 1,
+			CustomizeDiff: validateRestoreVersionChange,
 		},
-		resourceProvider: func(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApplicationAlertConfig] {
+		resourceProvider: func(api restapi.InstanaAPI) restapi.AlertConfigRestResource[*restapi.ApplicationAlertConfig] {
 			return api.GlobalApplicationAlertConfigs()
 		},
 	}
@@ -527,7 +543,17 @@ func NewGlobalApplicationAlertConfigResourceHandle() ResourceHandle[*restapi.App
 
 type applicationAlertConfigResource struct {
 	metaData         ResourceMetaData
-	resourceProvider func(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApplicationAlertConfig]
+	resourceProvider func(api restapi.InstanaAPI) restapi.AlertConfigRestResource[*restapi.ApplicationAlertConfig]
+}
+
+// baselineAwareApplicationAlertConfigResource extends the application alert config resource by the recalculation of the historic baseline. Global application alert configs do not support the recalculation of the baseline
+type baselineAwareApplicationAlertConfigResource struct {
+	applicationAlertConfigResource
+}
+
+// PostUpdate triggers the recalculation of the historic baseline when the baseline refresh trigger has changed and returns the refreshed alert configuration
+func (r *baselineAwareApplicationAlertConfigResource) PostUpdate(d *schema.ResourceData, api restapi.InstanaAPI, config *restapi.ApplicationAlertConfig) (*restapi.ApplicationAlertConfig, error) {
+	return refreshBaselineOnTriggerChange(d, config, api.ApplicationAlertConfigs)
 }
 
 func (r *applicationAlertConfigResource) MetaData() *ResourceMetaData {
@@ -552,6 +578,28 @@ func (r *applicationAlertConfigResource) SetComputedFields(_ *schema.ResourceDat
 	return nil
 }
 
+// Update implementation of the interface UpdateHandler. When the restore version has changed the referenced version is restored instead of rewriting the configuration. When only the enabled flag and/or the baseline refresh trigger have changed the full configuration is not rewritten. Changes of the enabled flag are applied via the dedicated enable/disable endpoints
+func (r *applicationAlertConfigResource) Update(d *schema.ResourceData, api restapi.InstanaAPI, config *restapi.ApplicationAlertConfig) (*restapi.ApplicationAlertConfig, error) {
+	restResourceProvider := func() restapi.AlertConfigRestResource[*restapi.ApplicationAlertConfig] {
+		return r.resourceProvider(api)
+	}
+	if restored, ok, err := restoreVersionOnChange(d, config, restResourceProvider); ok {
+		return restored, err
+	}
+	if d.HasChangesExcept(ApplicationAlertConfigFieldEnabled, ResourceFieldBaselineRefreshTrigger, ResourceFieldRestoreVersion) {
+		return r.resourceProvider(api).Update(config)
+	}
+	if d.HasChange(ApplicationAlertConfigFieldEnabled) {
+		return r.resourceProvider(api).UpdateEnabledState(config)
+	}
+	return config, nil
+}
+
+// PostApply implementation of the interface PostApplyHook. Reports a warning when a version of the alert configuration has been restored
+func (r *applicationAlertConfigResource) PostApply(d *schema.ResourceData, _ restapi.InstanaAPI, _ *restapi.ApplicationAlertConfig) diag.Diagnostics {
+	return restoreVersionWarning(d)
+}
+
 func (r *applicationAlertConfigResource) UpdateState(d *schema.ResourceData, config *restapi.ApplicationAlertConfig) error {
 	severity, err := ConvertSeverityFromInstanaAPIToTerraformRepresentation(config.Severity)
 	if err != nil {
@@ -572,6 +620,7 @@ func (r *applicationAlertConfigResource) UpdateState(d *schema.ResourceData, con
 		ApplicationAlertConfigFieldBoundaryScope:    config.BoundaryScope,
 		DefaultCustomPayloadFieldsName:              mapCustomPayloadFieldsToSchema(config),
 		ApplicationAlertConfigFieldDescription:      config.Description,
+		ApplicationAlertConfigFieldEnabled:          config.Enabled == nil || *config.Enabled,
 		ApplicationAlertConfigFieldEvaluationType:   config.EvaluationType,
 		ApplicationAlertConfigFieldGranularity:      config.Granularity,
 		ApplicationAlertConfigFieldIncludeInternal:  config.IncludeInternal,
@@ -733,6 +782,7 @@ func (r *applicationAlertConfigResource) MapStateToDataObject(d *schema.Resource
 		BoundaryScope:         restapi.BoundaryScope(d.Get(ApplicationAlertConfigFieldBoundaryScope).(string)),
 		CustomerPayloadFields: customPayloadFields,
 		Description:           d.Get(ApplicationAlertConfigFieldDescription).(string),
+		Enabled:               utils.BoolPtr(d.Get(ApplicationAlertConfigFieldEnabled).(bool)),
 		EvaluationType:        restapi.ApplicationAlertEvaluationType(d.Get(ApplicationAlertConfigFieldEvaluationType).(string)),
 		Granularity:           restapi.Granularity(d.Get(ApplicationAlertConfigFieldGranularity).(int)),
 		IncludeInternal:       d.Get(ApplicationAlertConfigFieldIncludeInternal).(bool),
@@ -2,12 +2,20 @@ package instana_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"net/http"
 	"testing"
 )
@@ -15,6 +23,151 @@ import (
 func TestApplicationAlertConfig(t *testing.T) {
 	commonTests := createApplicationAlertConfigTestFor("instana_application_alert_config", restapi.ApplicationAlertConfigsResourcePath, NewApplicationAlertConfigResourceHandle())
 	commonTests.run(t)
+	t.Run("Should only enable or disable application alert config when only enabled flag changed", shouldOnlyEnableOrDisableApplicationAlertConfigWhenOnlyEnabledFlagChanged)
+	t.Run("Should execute full update of application alert config when other fields changed", shouldExecuteFullUpdateOfApplicationAlertConfigWhenOtherFieldsChanged)
+	t.Run("Should not update application alert config when only baseline refresh trigger changed", shouldNotUpdateApplicationAlertConfigWhenOnlyBaselineRefreshTriggerChanged)
+	t.Run("Should update baseline of application alert config after update when baseline refresh trigger changed", shouldUpdateBaselineOfApplicationAlertConfigWhenBaselineRefreshTriggerChanged)
+	t.Run("Should not update baseline of application alert config after update when baseline refresh trigger did not change", shouldNotUpdateBaselineOfApplicationAlertConfigWhenBaselineRefreshTriggerDidNotChange)
+	t.Run("Should return error when baseline update of application alert config fails", shouldReturnErrorWhenBaselineUpdateOfApplicationAlertConfigFails)
+	t.Run("Should restore version of application alert config when restore version changed", shouldRestoreVersionOfApplicationAlertConfigWhenRestoreVersionChanged)
+	t.Run("Should return error when restore of version of application alert config fails", shouldReturnErrorWhenRestoreOfVersionOfApplicationAlertConfigFails)
+	t.Run("Should reject change of restore version together with other attributes of application alert config", shouldRejectChangeOfRestoreVersionTogetherWithOtherAttributesOfApplicationAlertConfig)
+	t.Run("Should allow change of restore version of application alert config", shouldAllowChangeOfRestoreVersionOfApplicationAlertConfig)
+	t.Run("Should report warning after restore of version of application alert config", shouldReportWarningAfterRestoreOfVersionOfApplicationAlertConfig)
+	t.Run("Should not report warning after update of application alert config without restore", shouldNotReportWarningAfterUpdateOfApplicationAlertConfigWithoutRestore)
+}
+
+func shouldOnlyEnableOrDisableApplicationAlertConfigWhenOnlyEnabledFlagChanged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewApplicationAlertConfigResourceHandle()
+		resourceData := createResourceDataWithChangesForResourceHandle(t, resourceHandle, "test-id", map[string]interface{}{
+			ApplicationAlertConfigFieldName:    "name",
+			ApplicationAlertConfigFieldEnabled: true,
+		}, map[string]interface{}{
+			ApplicationAlertConfigFieldName:    "name",
+			ApplicationAlertConfigFieldEnabled: false,
+		})
+		config := &restapi.ApplicationAlertConfig{ID: "test-id", Enabled: utils.BoolPtr(false)}
+		refreshedConfig := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "refreshed", Enabled: utils.BoolPtr(false)}
+		mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.ApplicationAlertConfig](ctrl)
+
+		mockInstanaAPI.EXPECT().ApplicationAlertConfigs().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().UpdateEnabledState(config).Return(refreshedConfig, nil).Times(1)
+
+		sut := resourceHandle.(UpdateHandler[*restapi.ApplicationAlertConfig])
+		result, err := sut.Update(resourceData, providerMeta.InstanaAPI, config)
+
+		require.NoError(t, err)
+		require.Equal(t, refreshedConfig, result)
+	})
+}
+
+func shouldExecuteFullUpdateOfApplicationAlertConfigWhenOtherFieldsChanged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewApplicationAlertConfigResourceHandle()
+		resourceData := createResourceDataWithChangesForResourceHandle(t, resourceHandle, "test-id", map[string]interface{}{
+			ApplicationAlertConfigFieldName:    "name",
+			ApplicationAlertConfigFieldEnabled: true,
+		}, map[string]interface{}{
+			ApplicationAlertConfigFieldName:    "updated",
+			ApplicationAlertConfigFieldEnabled: false,
+		})
+		config := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "name", Enabled: utils.BoolPtr(false)}
+		updatedConfig := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "updated", Enabled: utils.BoolPtr(false)}
+		mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.ApplicationAlertConfig](ctrl)
+
+		mockInstanaAPI.EXPECT().ApplicationAlertConfigs().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().Update(config).Return(updatedConfig, nil).Times(1)
+
+		sut := resourceHandle.(UpdateHandler[*restapi.ApplicationAlertConfig])
+		result, err := sut.Update(resourceData, providerMeta.InstanaAPI, config)
+
+		require.NoError(t, err)
+		require.Equal(t, updatedConfig, result)
+	})
+}
+
+func shouldNotUpdateApplicationAlertConfigWhenOnlyBaselineRefreshTriggerChanged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewApplicationAlertConfigResourceHandle()
+		resourceData := createResourceDataWithChangesForResourceHandle(t, resourceHandle, "test-id", map[string]interface{}{
+			ApplicationAlertConfigFieldName:     "name",
+			ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "value"},
+		}, map[string]interface{}{
+			ApplicationAlertConfigFieldName:     "name",
+			ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "other"},
+		})
+		config := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "name", Enabled: utils.BoolPtr(true)}
+
+		sut := resourceHandle.(UpdateHandler[*restapi.ApplicationAlertConfig])
+		result, err := sut.Update(resourceData, providerMeta.InstanaAPI, config)
+
+		require.NoError(t, err)
+		require.Equal(t, config, result)
+	})
+}
+
+func shouldUpdateBaselineOfApplicationAlertConfigWhenBaselineRefreshTriggerChanged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewApplicationAlertConfigResourceHandle()
+		resourceData := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{
+			ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "value"},
+		})
+		updatedConfig := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "updated"}
+		refreshedConfig := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "refreshed"}
+		mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.ApplicationAlertConfig](ctrl)
+
+		mockInstanaAPI.EXPECT().ApplicationAlertConfigs().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().UpdateBaseline("test-id").Return(nil).Times(1)
+		mockRestResource.EXPECT().GetOne("test-id").Return(refreshedConfig, nil).Times(1)
+
+		sut := resourceHandle.(PostUpdateHook[*restapi.ApplicationAlertConfig])
+		result, err := sut.PostUpdate(resourceData, providerMeta.InstanaAPI, updatedConfig)
+
+		require.NoError(t, err)
+		require.Equal(t, refreshedConfig, result)
+	})
+}
+
+func shouldNotUpdateBaselineOfApplicationAlertConfigWhenBaselineRefreshTriggerDidNotChange(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewApplicationAlertConfigResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		updatedConfig := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "updated"}
+
+		sut := resourceHandle.(PostUpdateHook[*restapi.ApplicationAlertConfig])
+		result, err := sut.PostUpdate(resourceData, providerMeta.InstanaAPI, updatedConfig)
+
+		require.NoError(t, err)
+		require.Equal(t, updatedConfig, result)
+	})
+}
+
+func shouldReturnErrorWhenBaselineUpdateOfApplicationAlertConfigFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewApplicationAlertConfigResourceHandle()
+		resourceData := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{
+			ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "value"},
+		})
+		updatedConfig := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "updated"}
+		expectedError := errors.New("test")
+		mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.ApplicationAlertConfig](ctrl)
+
+		mockInstanaAPI.EXPECT().ApplicationAlertConfigs().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().UpdateBaseline("test-id").Return(expectedError).Times(1)
+
+		sut := resourceHandle.(PostUpdateHook[*restapi.ApplicationAlertConfig])
+		_, err := sut.PostUpdate(resourceData, providerMeta.InstanaAPI, updatedConfig)
+
+		require.Error(t, err)
+		require.Equal(t, expectedError, err)
+	})
 }
 
 const issue141Template = `
@@ -200,3 +353,116 @@ func createIssue141TestStep(terraformResourceInstanceName string, httpPort int,
 		),
 	}
 }
+
+func shouldRestoreVersionOfApplicationAlertConfigWhenRestoreVersionChanged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewApplicationAlertConfigResourceHandle()
+		resourceData := createResourceDataWithChangesForResourceHandle(t, resourceHandle, "test-id", map[string]interface{}{
+			ApplicationAlertConfigFieldName: "name",
+		}, map[string]interface{}{
+			ApplicationAlertConfigFieldName: "name",
+			ResourceFieldRestoreVersion:     1700000001000,
+		})
+		config := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "name", Enabled: utils.BoolPtr(true)}
+		restoredConfig := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "restored", Enabled: utils.BoolPtr(true)}
+		mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.ApplicationAlertConfig](ctrl)
+
+		mockInstanaAPI.EXPECT().ApplicationAlertConfigs().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().RestoreVersion("test-id", int64(1700000001000)).Return(nil).Times(1)
+		mockRestResource.EXPECT().GetOne("test-id").Return(restoredConfig, nil).Times(1)
+
+		sut := resourceHandle.(UpdateHandler[*restapi.ApplicationAlertConfig])
+		result, err := sut.Update(resourceData, providerMeta.InstanaAPI, config)
+
+		require.NoError(t, err)
+		require.Equal(t, restoredConfig, result)
+	})
+}
+
+func shouldReturnErrorWhenRestoreOfVersionOfApplicationAlertConfigFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewGlobalApplicationAlertConfigResourceHandle()
+		resourceData := createResourceDataWithChangesForResourceHandle(t, resourceHandle, "test-id", map[string]interface{}{
+			ApplicationAlertConfigFieldName: "name",
+		}, map[string]interface{}{
+			ApplicationAlertConfigFieldName: "name",
+			ResourceFieldRestoreVersion:     1700000001000,
+		})
+		config := &restapi.ApplicationAlertConfig{ID: "test-id", Name: "name", Enabled: utils.BoolPtr(true)}
+		expectedError := errors.New("test")
+		mockRestResource := mocks.NewMockAlertConfigRestResource[*restapi.ApplicationAlertConfig](ctrl)
+
+		mockInstanaAPI.EXPECT().GlobalApplicationAlertConfigs().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().RestoreVersion("test-id", int64(1700000001000)).Return(expectedError).Times(1)
+
+		sut := resourceHandle.(UpdateHandler[*restapi.ApplicationAlertConfig])
+		_, err := sut.Update(resourceData, providerMeta.InstanaAPI, config)
+
+		require.Error(t, err)
+		require.Equal(t, expectedError, err)
+	})
+}
+
+func shouldRejectChangeOfRestoreVersionTogetherWithOtherAttributesOfApplicationAlertConfig(t *testing.T) {
+	_, err := calculateApplicationAlertConfigDiffWithRestoreVersion(t, "updated")
+
+	require.ErrorContains(t, err, "restore_version cannot be changed together with other attributes")
+}
+
+func shouldAllowChangeOfRestoreVersionOfApplicationAlertConfig(t *testing.T) {
+	diff, err := calculateApplicationAlertConfigDiffWithRestoreVersion(t, "name")
+
+	require.NoError(t, err)
+	require.Equal(t, "1700000001000", diff.Attributes[ResourceFieldRestoreVersion].New)
+}
+
+func shouldReportWarningAfterRestoreOfVersionOfApplicationAlertConfig(t *testing.T) {
+	resourceHandle := NewApplicationAlertConfigResourceHandle()
+	resourceData := createResourceDataWithChangesForResourceHandle(t, resourceHandle, "test-id", map[string]interface{}{
+		ApplicationAlertConfigFieldName: "name",
+	}, map[string]interface{}{
+		ApplicationAlertConfigFieldName: "name",
+		ResourceFieldRestoreVersion:     1700000001000,
+	})
+
+	sut := resourceHandle.(PostApplyHook[*restapi.ApplicationAlertConfig])
+	result := sut.PostApply(resourceData, nil, &restapi.ApplicationAlertConfig{ID: "test-id"})
+
+	require.Len(t, result, 1)
+	require.Equal(t, diag.Warning, result[0].Severity)
+	require.Equal(t, "alert configuration test-id has been restored to version 1700000001000", result[0].Summary)
+	require.Contains(t, result[0].Detail, "otherwise the next apply overwrites the restored version")
+}
+
+func shouldNotReportWarningAfterUpdateOfApplicationAlertConfigWithoutRestore(t *testing.T) {
+	resourceHandle := NewApplicationAlertConfigResourceHandle()
+	resourceData := createResourceDataWithChangesForResourceHandle(t, resourceHandle, "test-id", map[string]interface{}{
+		ApplicationAlertConfigFieldName: "name",
+		ResourceFieldRestoreVersion:     1700000001000,
+	}, map[string]interface{}{
+		ApplicationAlertConfigFieldName: "updated",
+		ResourceFieldRestoreVersion:     1700000001000,
+	})
+
+	sut := resourceHandle.(PostApplyHook[*restapi.ApplicationAlertConfig])
+	result := sut.PostApply(resourceData, nil, &restapi.ApplicationAlertConfig{ID: "test-id"})
+
+	require.Empty(t, result)
+}
+
+func calculateApplicationAlertConfigDiffWithRestoreVersion(t *testing.T, name string) (*terraform.InstanceDiff, error) {
+	resourceHandle := NewApplicationAlertConfigResourceHandle()
+	schemaResource := NewTerraformResource(resourceHandle).ToSchemaResource()
+	resourceData := NewTestHelper[*restapi.ApplicationAlertConfig](t).CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{
+		ApplicationAlertConfigFieldName: "name",
+	})
+	resourceData.SetId("test-id")
+	state := resourceData.State()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		ApplicationAlertConfigFieldName: name,
+		ResourceFieldRestoreVersion:     1700000001000,
+	})
+	return schemaResource.Diff(context.Background(), state, config, nil)
+}
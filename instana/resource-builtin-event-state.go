@@ -0,0 +1,96 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaBuiltinEventState the name of the terraform-provider-instana resource to manage the enabled state of builtin event specifications
+const ResourceInstanaBuiltinEventState = "instana_builtin_event_state"
+
+const (
+	//BuiltinEventStateFieldBuiltinEventID constant value for the schema field builtin_event_id
+	BuiltinEventStateFieldBuiltinEventID = "builtin_event_id"
+	//BuiltinEventStateFieldEnabled constant value for the schema field enabled
+	BuiltinEventStateFieldEnabled = "enabled"
+	//BuiltinEventStateFieldPreviousEnabled constant value for the computed schema field previous_enabled
+	BuiltinEventStateFieldPreviousEnabled = "previous_enabled"
+)
+
+// NewBuiltinEventStateResourceHandle creates the resource handle for the enabled state of builtin event specifications
+func NewBuiltinEventStateResourceHandle() ResourceHandle[*restapi.BuiltinEventState] {
+	return &builtinEventStateResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaBuiltinEventState,
+			Schema: map[string]*schema.Schema{
+				BuiltinEventStateFieldBuiltinEventID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the builtin event specification",
+				},
+				BuiltinEventStateFieldEnabled: {
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "Flag to enable or disable the builtin event specification",
+				},
+				BuiltinEventStateFieldPreviousEnabled: {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "The enabled state of the builtin event specification before it was managed by terraform. The state is restored when the resource is destroyed",
+				},
+			},
+			SchemaVersion:      0,
+			SkipIDGeneration:   true,
+			DeleteByDataObject: true,
+		},
+	}
+}
+
+type builtinEventStateResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *builtinEventStateResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *builtinEventStateResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *builtinEventStateResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.BuiltinEventState] {
+	return api.BuiltinEventStates()
+}
+
+func (r *builtinEventStateResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *builtinEventStateResource) UpdateState(d *schema.ResourceData, state *restapi.BuiltinEventState) error {
+	data := map[string]interface{}{
+		BuiltinEventStateFieldBuiltinEventID: state.ID,
+		BuiltinEventStateFieldEnabled:        state.Enabled,
+	}
+	if state.PreviousEnabled != nil {
+		data[BuiltinEventStateFieldPreviousEnabled] = *state.PreviousEnabled
+	} else if len(d.Get(BuiltinEventStateFieldBuiltinEventID).(string)) == 0 {
+		//imported resource; the state at the time of the import is restored on destroy
+		data[BuiltinEventStateFieldPreviousEnabled] = state.Enabled
+	}
+
+	d.SetId(state.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *builtinEventStateResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.BuiltinEventState, error) {
+	previousEnabled := d.Get(BuiltinEventStateFieldPreviousEnabled).(bool)
+	return &restapi.BuiltinEventState{
+		ID:              d.Get(BuiltinEventStateFieldBuiltinEventID).(string),
+		Enabled:         d.Get(BuiltinEventStateFieldEnabled).(bool),
+		PreviousEnabled: &previousEnabled,
+	}, nil
+}
@@ -0,0 +1,200 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+func TestBuiltinEventState(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaBuiltinEventState + ".example"
+	inst := &builtinEventStateTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewBuiltinEventStateResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type builtinEventStateTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.BuiltinEventState]
+}
+
+var builtinEventStateTerraformTemplate = `
+resource "instana_builtin_event_state" "example" {
+	builtin_event_id = "builtin-id"
+	enabled          = %t
+}
+`
+
+const builtinEventStateID = "builtin-id"
+
+func (test *builtinEventStateTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaBuiltinEventState), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaBuiltinEventState), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaBuiltinEventState), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaBuiltinEventState), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should be deleted by data object", ResourceInstanaBuiltinEventState), test.createTestResourceShouldBeDeletedByDataObject())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaBuiltinEventState), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should keep previous state when model does not provide it", ResourceInstanaBuiltinEventState), test.createTestShouldKeepPreviousStateWhenModelDoesNotProvideIt())
+	t.Run(fmt.Sprintf("%s should use current state as previous state on import", ResourceInstanaBuiltinEventState), test.createTestShouldUseCurrentStateAsPreviousStateOnImport())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaBuiltinEventState), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *builtinEventStateTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		spec := &restapi.BuiltinEventSpecification{ID: builtinEventStateID, ShortPluginID: "host", Name: "name", Enabled: true}
+
+		writeSpec := func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(spec)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+		onToggle := func(enabled bool) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				if mux.Vars(r)["id"] != builtinEventStateID {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				spec.Enabled = enabled
+				writeSpec(w, r)
+			}
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.BuiltinEventSpecificationResourcePath+"/{id}/enable", onToggle(true))
+		httpServer.AddRoute(http.MethodPost, restapi.BuiltinEventSpecificationResourcePath+"/{id}/disable", onToggle(false))
+		httpServer.AddRoute(http.MethodGet, restapi.BuiltinEventSpecificationResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			writeSpec(w, r)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), false),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, builtinEventStateID, BuiltinEventStateFieldPreviousEnabled),
+				test.createIntegrationTestStep(httpServer.GetPort(), true),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, builtinEventStateID),
+			},
+		})
+
+		require.True(t, spec.Enabled)
+		require.Equal(t, 1, httpServer.GetCallCount(http.MethodPost, restapi.BuiltinEventSpecificationResourcePath+"/"+builtinEventStateID+"/disable"))
+	}
+}
+
+func (test *builtinEventStateTest) createIntegrationTestStep(httpPort int, enabled bool) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(builtinEventStateTerraformTemplate, enabled), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", builtinEventStateID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, BuiltinEventStateFieldBuiltinEventID, builtinEventStateID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, BuiltinEventStateFieldEnabled, fmt.Sprintf("%t", enabled)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, BuiltinEventStateFieldPreviousEnabled, "true"),
+		),
+	}
+}
+
+func (test *builtinEventStateTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *builtinEventStateTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *builtinEventStateTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_builtin_event_state", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *builtinEventStateTest) createTestResourceShouldBeDeletedByDataObject() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.True(t, test.resourceHandle.MetaData().DeleteByDataObject)
+	}
+}
+
+func (test *builtinEventStateTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.BuiltinEventState](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, &restapi.BuiltinEventState{ID: builtinEventStateID, Enabled: false, PreviousEnabled: utils.BoolPtr(true)})
+
+		require.NoError(t, err)
+		require.Equal(t, builtinEventStateID, resourceData.Id())
+		require.Equal(t, builtinEventStateID, resourceData.Get(BuiltinEventStateFieldBuiltinEventID))
+		require.False(t, resourceData.Get(BuiltinEventStateFieldEnabled).(bool))
+		require.True(t, resourceData.Get(BuiltinEventStateFieldPreviousEnabled).(bool))
+	}
+}
+
+func (test *builtinEventStateTest) createTestShouldKeepPreviousStateWhenModelDoesNotProvideIt() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.BuiltinEventState](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldBuiltinEventID, builtinEventStateID)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldPreviousEnabled, false)
+
+		err := test.resourceHandle.UpdateState(resourceData, &restapi.BuiltinEventState{ID: builtinEventStateID, Enabled: true})
+
+		require.NoError(t, err)
+		require.True(t, resourceData.Get(BuiltinEventStateFieldEnabled).(bool))
+		require.False(t, resourceData.Get(BuiltinEventStateFieldPreviousEnabled).(bool))
+	}
+}
+
+func (test *builtinEventStateTest) createTestShouldUseCurrentStateAsPreviousStateOnImport() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.BuiltinEventState](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId(builtinEventStateID)
+
+		err := test.resourceHandle.UpdateState(resourceData, &restapi.BuiltinEventState{ID: builtinEventStateID, Enabled: true})
+
+		require.NoError(t, err)
+		require.Equal(t, builtinEventStateID, resourceData.Get(BuiltinEventStateFieldBuiltinEventID))
+		require.True(t, resourceData.Get(BuiltinEventStateFieldPreviousEnabled).(bool))
+	}
+}
+
+func (test *builtinEventStateTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.BuiltinEventState](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldBuiltinEventID, builtinEventStateID)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldEnabled, false)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldPreviousEnabled, true)
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.BuiltinEventState{ID: builtinEventStateID, Enabled: false, PreviousEnabled: utils.BoolPtr(true)}, result)
+	}
+}
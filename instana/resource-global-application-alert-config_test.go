@@ -3,10 +3,20 @@ package instana_test
 import (
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
 func TestGlobalApplicationAlertConfig(t *testing.T) {
 	commonTests := createApplicationAlertConfigTestFor("instana_global_application_alert_config", restapi.GlobalApplicationAlertConfigsResourcePath, NewGlobalApplicationAlertConfigResourceHandle())
 	commonTests.run(t)
+	t.Run("Should not support baseline refresh trigger for global application alert config", shouldNotSupportBaselineRefreshTriggerForGlobalApplicationAlertConfig)
+}
+
+func shouldNotSupportBaselineRefreshTriggerForGlobalApplicationAlertConfig(t *testing.T) {
+	resourceHandle := NewGlobalApplicationAlertConfigResourceHandle()
+
+	require.NotContains(t, resourceHandle.MetaData().Schema, ResourceFieldBaselineRefreshTrigger)
+	_, ok := resourceHandle.(PostUpdateHook[*restapi.ApplicationAlertConfig])
+	require.False(t, ok)
 }
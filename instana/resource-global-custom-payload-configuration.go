@@ -0,0 +1,101 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInstanaGlobalCustomPayloadConfiguration the name of the terraform-provider-instana resource to manage the tenant wide custom payload configuration
+const ResourceInstanaGlobalCustomPayloadConfiguration = "instana_global_custom_payload_configuration"
+
+// NewGlobalCustomPayloadConfigurationResourceHandle creates the resource handle for the tenant wide custom payload configuration
+func NewGlobalCustomPayloadConfigurationResourceHandle() ResourceHandle[*restapi.CustomPayloadConfiguration] {
+	return &globalCustomPayloadConfigurationResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGlobalCustomPayloadConfiguration,
+			Schema: map[string]*schema.Schema{
+				DefaultCustomPayloadFieldsName: buildCustomPayloadFields(),
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			CustomizeDiff:    validateDynamicCustomPayloadFieldTagNames,
+		},
+	}
+}
+
+type globalCustomPayloadConfigurationResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *globalCustomPayloadConfigurationResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *globalCustomPayloadConfigurationResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *globalCustomPayloadConfigurationResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.CustomPayloadConfiguration] {
+	return api.CustomPayloadConfiguration()
+}
+
+func (r *globalCustomPayloadConfigurationResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *globalCustomPayloadConfigurationResource) UpdateState(d *schema.ResourceData, config *restapi.CustomPayloadConfiguration) error {
+	d.SetId(restapi.CustomPayloadConfigurationID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		DefaultCustomPayloadFieldsName: mapCustomPayloadFieldsToSchema(config),
+	})
+}
+
+func (r *globalCustomPayloadConfigurationResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.CustomPayloadConfiguration, error) {
+	customPayloadFields, err := mapDefaultCustomPayloadFieldsFromSchema(d)
+	if err != nil {
+		return nil, err
+	}
+	return &restapi.CustomPayloadConfiguration{
+		Fields: customPayloadFields,
+	}, nil
+}
+
+// validateDynamicCustomPayloadFieldTagNames verifies that the tag names of all dynamic custom payload fields are
+// available in the tag catalog of the Instana API. The catalog is only requested when dynamic fields are configured
+func validateDynamicCustomPayloadFieldTagNames(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(DefaultCustomPayloadFieldsName) {
+		return nil
+	}
+	tagNames := make([]string, 0)
+	if fields, ok := d.Get(DefaultCustomPayloadFieldsName).(*schema.Set); ok {
+		for _, v := range fields.List() {
+			field, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if dynamicValue, ok := extractDynamicCustomPayloadFieldValue(field); ok && dynamicValue != nil {
+				if tagName, ok := dynamicValue[CustomPayloadFieldsFieldDynamicTagName].(string); ok && len(tagName) > 0 {
+					tagNames = append(tagNames, tagName)
+				}
+			}
+		}
+	}
+	if len(tagNames) == 0 {
+		return nil
+	}
+
+	catalog, err := meta.(*ProviderMeta).InstanaAPI.CustomPayloadConfiguration().GetTagCatalog()
+	if err != nil {
+		return fmt.Errorf("failed to read tag catalog of custom payload configuration; %w", err)
+	}
+	for _, tagName := range tagNames {
+		if !catalog.ContainsTag(tagName) {
+			return fmt.Errorf("tag name %s of dynamic custom payload field is not supported; the tag does not exist in the tag catalog of Instana", tagName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package instana_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestGlobalCustomPayloadConfiguration(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaGlobalCustomPayloadConfiguration + ".example"
+	inst := &globalCustomPayloadConfigurationTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewGlobalCustomPayloadConfigurationResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type globalCustomPayloadConfigurationTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.CustomPayloadConfiguration]
+}
+
+var globalCustomPayloadConfigurationTerraformTemplate = `
+resource "instana_global_custom_payload_configuration" "example" {
+	custom_payload_field {
+		key   = "static-key"
+		value = "static-value-%d"
+	}
+
+	custom_payload_field {
+		key = "dynamic-key"
+		dynamic_value {
+			key      = "dynamic-value-key"
+			tag_name = "host.name"
+		}
+	}
+}
+`
+
+var globalCustomPayloadConfigurationWithUnknownTagTerraformTemplate = `
+resource "instana_global_custom_payload_configuration" "example" {
+	custom_payload_field {
+		key = "dynamic-key"
+		dynamic_value {
+			tag_name = "unknown.tag"
+		}
+	}
+}
+`
+
+const globalCustomPayloadConfigurationTagCatalog = `{"tagTree":[],"tags":[{"name":"host.name","type":"STRING","label":"Host Name"},{"name":"service.name","type":"STRING","label":"Service Name"}]}`
+
+func (test *globalCustomPayloadConfigurationTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaGlobalCustomPayloadConfiguration), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaGlobalCustomPayloadConfiguration), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaGlobalCustomPayloadConfiguration), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaGlobalCustomPayloadConfiguration), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaGlobalCustomPayloadConfiguration), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaGlobalCustomPayloadConfiguration), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state to model when custom payload field has no value", ResourceInstanaGlobalCustomPayloadConfiguration), test.createTestShouldFailToMapTerraformResourceStateToModelWhenCustomPayloadFieldHasNoValue())
+	t.Run(fmt.Sprintf("%s should not read tag catalog when no dynamic custom payload fields are configured", ResourceInstanaGlobalCustomPayloadConfiguration), test.createTestShouldNotReadTagCatalogWhenNoDynamicFieldsAreConfigured())
+}
+
+func (test *globalCustomPayloadConfigurationTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		currentConfig := []byte(`{"fields":[]}`)
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, restapi.CustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			config := make(map[string]interface{})
+			err := json.NewDecoder(r.Body).Decode(&config)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			config["lastUpdated"] = 1234
+			data, _ := json.Marshal(config)
+			mutex.Lock()
+			currentConfig = data
+			mutex.Unlock()
+			test.writeJSON(w, fmt.Sprintf("[%s]", data))
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.CustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			data := string(currentConfig)
+			mutex.Unlock()
+			test.writeJSON(w, data)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.CustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			currentConfig = []byte(`{"fields":[]}`)
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.CustomPayloadConfigurationTagCatalogResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			test.writeJSON(w, globalCustomPayloadConfigurationTagCatalog)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.CustomPayloadConfigurationID),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.CustomPayloadConfigurationID),
+				{
+					Config:      appendProviderConfig(globalCustomPayloadConfigurationWithUnknownTagTerraformTemplate, httpServer.GetPort()),
+					ExpectError: regexp.MustCompile("tag name unknown.tag of dynamic custom payload field is not supported"),
+				},
+			},
+		})
+	}
+}
+
+func (test *globalCustomPayloadConfigurationTest) writeJSON(w http.ResponseWriter, data string) {
+	w.Header().Set(contentType, "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write([]byte(data))
+	if err != nil {
+		fmt.Printf("failed to write response; %s\n", err)
+	}
+}
+
+func (test *globalCustomPayloadConfigurationTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	staticValue := fmt.Sprintf("%s.%d.%s", DefaultCustomPayloadFieldsName, schema.HashString("static-key"), CustomPayloadFieldsFieldStaticStringValue)
+	dynamicTagName := fmt.Sprintf("%s.%d.%s.0.%s", DefaultCustomPayloadFieldsName, schema.HashString("dynamic-key"), CustomPayloadFieldsFieldDynamicValue, CustomPayloadFieldsFieldDynamicTagName)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(globalCustomPayloadConfigurationTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", restapi.CustomPayloadConfigurationID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, DefaultCustomPayloadFieldsName+".#", "2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, staticValue, fmt.Sprintf("static-value-%d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, dynamicTagName, "host.name"),
+		),
+	}
+}
+
+func (test *globalCustomPayloadConfigurationTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *globalCustomPayloadConfigurationTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *globalCustomPayloadConfigurationTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_global_custom_payload_configuration", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *globalCustomPayloadConfigurationTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		dynamicKey := "dynamic-value-key"
+		config := &restapi.CustomPayloadConfiguration{
+			Fields: []restapi.CustomPayloadField[any]{
+				{Type: restapi.StaticStringCustomPayloadType, Key: "static-key", Value: restapi.StaticStringCustomPayloadFieldValue("static-value")},
+				{Type: restapi.DynamicCustomPayloadType, Key: "dynamic-key", Value: restapi.DynamicCustomPayloadFieldValue{TagName: "host.name", Key: &dynamicKey}},
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.CustomPayloadConfiguration](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, restapi.CustomPayloadConfigurationID, resourceData.Id())
+		require.Equal(t, 2, resourceData.Get(DefaultCustomPayloadFieldsName).(*schema.Set).Len())
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+		require.NoError(t, err)
+		require.ElementsMatch(t, config.Fields, result.Fields)
+	}
+}
+
+func (test *globalCustomPayloadConfigurationTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.CustomPayloadConfiguration](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId(restapi.CustomPayloadConfigurationID)
+		setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+			},
+		})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.CustomPayloadConfiguration{
+			Fields: []restapi.CustomPayloadField[any]{
+				{Type: restapi.StaticStringCustomPayloadType, Key: "static-key", Value: restapi.StaticStringCustomPayloadFieldValue("static-value")},
+			},
+		}, result)
+	}
+}
+
+func (test *globalCustomPayloadConfigurationTest) createTestShouldFailToMapTerraformResourceStateToModelWhenCustomPayloadFieldHasNoValue() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.CustomPayloadConfiguration](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey: "static-key",
+			},
+		})
+
+		_, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+	}
+}
+
+func (test *globalCustomPayloadConfigurationTest) createTestShouldNotReadTagCatalogWhenNoDynamicFieldsAreConfigured() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.CustomPayloadConfiguration](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, meta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			mockInstanaAPI.EXPECT().CustomPayloadConfiguration().Times(0)
+
+			_, err := test.calculateDiff(meta, []interface{}{
+				map[string]interface{}{
+					CustomPayloadFieldsFieldKey:               "static-key",
+					CustomPayloadFieldsFieldStaticStringValue: "static-value",
+				},
+			})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func (test *globalCustomPayloadConfigurationTest) calculateDiff(meta *ProviderMeta, customPayloadFields []interface{}) (*terraform.InstanceDiff, error) {
+	schemaResource := NewTerraformResource(test.resourceHandle).ToSchemaResource()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		DefaultCustomPayloadFieldsName: customPayloadFields,
+	})
+	return schemaResource.Diff(context.Background(), &terraform.InstanceState{}, config, meta)
+}
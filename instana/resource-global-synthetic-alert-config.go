@@ -0,0 +1,321 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaGlobalSyntheticAlertConfig the name of the terraform-provider-instana resource to manage global synthetic alert configs
+const ResourceInstanaGlobalSyntheticAlertConfig = "instana_global_synthetic_alert_config"
+
+const (
+	//GlobalSyntheticAlertConfigFieldAlertChannelIDs constant value for field alert_channel_ids of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldAlertChannelIDs = "alert_channel_ids"
+	//GlobalSyntheticAlertConfigFieldDescription constant value for field description of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldDescription = "description"
+	//GlobalSyntheticAlertConfigFieldName constant value for field name of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldName = "name"
+	//GlobalSyntheticAlertConfigFieldSeverity constant value for field severity of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldSeverity = "severity"
+	//GlobalSyntheticAlertConfigFieldSyntheticTestIDs constant value for field synthetic_test_ids of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldSyntheticTestIDs = "synthetic_test_ids"
+	//GlobalSyntheticAlertConfigFieldTagFilter constant value for field tag_filter of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldTagFilter = "tag_filter"
+
+	//GlobalSyntheticAlertConfigFieldRule constant value for field rule of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldRule = "rule"
+	//GlobalSyntheticAlertConfigFieldRuleFailure constant value for field rule.failure of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldRuleFailure = "failure"
+	//GlobalSyntheticAlertConfigFieldRuleMetricName constant value for field rule.failure.metric_name of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldRuleMetricName = "metric_name"
+	//GlobalSyntheticAlertConfigFieldRuleAggregation constant value for field rule.failure.aggregation of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldRuleAggregation = "aggregation"
+
+	//GlobalSyntheticAlertConfigFieldTimeThreshold constant value for field time_threshold of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldTimeThreshold = "time_threshold"
+	//GlobalSyntheticAlertConfigFieldTimeThresholdViolationsInSequence constant value for field time_threshold.violations_in_sequence of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldTimeThresholdViolationsInSequence = "violations_in_sequence"
+	//GlobalSyntheticAlertConfigFieldTimeThresholdViolationsCount constant value for field time_threshold.violations_in_sequence.violations_count of resource instana_global_synthetic_alert_config
+	GlobalSyntheticAlertConfigFieldTimeThresholdViolationsCount = "violations_count"
+)
+
+var globalSyntheticAlertConfigResourceSchema = map[string]*schema.Schema{
+	GlobalSyntheticAlertConfigFieldAlertChannelIDs: {
+		Type:     schema.TypeSet,
+		MinItems: 0,
+		MaxItems: 1024,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		Description: "List of IDs of alert channels defined in Instana.",
+	},
+	DefaultCustomPayloadFieldsName: buildCustomPayloadFields(),
+	GlobalSyntheticAlertConfigFieldDescription: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The description text of the synthetic alert config",
+		ValidateFunc: validation.StringLenBetween(0, 65536),
+	},
+	GlobalSyntheticAlertConfigFieldName: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Name for the synthetic alert configuration",
+		ValidateFunc: validation.StringLenBetween(0, 256),
+	},
+	GlobalSyntheticAlertConfigFieldRule: {
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of rule this alert configuration is about.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				GlobalSyntheticAlertConfigFieldRuleFailure: {
+					Type:        schema.TypeList,
+					MinItems:    1,
+					MaxItems:    1,
+					Required:    true,
+					Description: "Rule based on the failures of the synthetic tests",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							GlobalSyntheticAlertConfigFieldRuleMetricName: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The metric name of the synthetic alert rule",
+							},
+							GlobalSyntheticAlertConfigFieldRuleAggregation: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(restapi.SupportedAggregations.ToStringSlice(), true),
+								Description:  "The aggregation function of the synthetic alert rule",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	GlobalSyntheticAlertConfigFieldSeverity: {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(restapi.SupportedSeverities.TerraformRepresentations(), false),
+		Description:  "The severity of the alert when triggered",
+	},
+	GlobalSyntheticAlertConfigFieldSyntheticTestIDs: {
+		Type:     schema.TypeSet,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Required:    true,
+		Description: "List of IDs of the synthetic tests this alert configuration applies to",
+	},
+	GlobalSyntheticAlertConfigFieldTagFilter: OptionalTagFilterExpressionSchema,
+	GlobalSyntheticAlertConfigFieldTimeThreshold: {
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of violation of the defined threshold.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				GlobalSyntheticAlertConfigFieldTimeThresholdViolationsInSequence: {
+					Type:        schema.TypeList,
+					MinItems:    1,
+					MaxItems:    1,
+					Required:    true,
+					Description: "Time threshold base on violations in sequence",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							GlobalSyntheticAlertConfigFieldTimeThresholdViolationsCount: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(1, 12),
+								Description:  "The number of consecutive failures of the synthetic tests which trigger the alert",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// NewGlobalSyntheticAlertConfigResourceHandle creates the resource handle for Global Synthetic Alert Configs
+func NewGlobalSyntheticAlertConfigResourceHandle() ResourceHandle[*restapi.SyntheticAlertConfig] {
+	return &globalSyntheticAlertConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName:     ResourceInstanaGlobalSyntheticAlertConfig,
+			Schema:           globalSyntheticAlertConfigResourceSchema,
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type globalSyntheticAlertConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *globalSyntheticAlertConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *globalSyntheticAlertConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *globalSyntheticAlertConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.SyntheticAlertConfig] {
+	return api.GlobalSyntheticAlertConfigs()
+}
+
+func (r *globalSyntheticAlertConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *globalSyntheticAlertConfigResource) UpdateState(d *schema.ResourceData, config *restapi.SyntheticAlertConfig) error {
+	severity, err := ConvertSeverityFromInstanaAPIToTerraformRepresentation(config.Severity)
+	if err != nil {
+		return err
+	}
+	var normalizedTagFilterString *string
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		GlobalSyntheticAlertConfigFieldAlertChannelIDs:  config.AlertChannelIDs,
+		DefaultCustomPayloadFieldsName:                  mapCustomPayloadFieldsToSchema(config),
+		GlobalSyntheticAlertConfigFieldDescription:      config.Description,
+		GlobalSyntheticAlertConfigFieldName:             config.Name,
+		GlobalSyntheticAlertConfigFieldRule:             r.mapRuleToSchema(config),
+		GlobalSyntheticAlertConfigFieldSeverity:         severity,
+		GlobalSyntheticAlertConfigFieldSyntheticTestIDs: config.SyntheticTestIDs,
+		GlobalSyntheticAlertConfigFieldTagFilter:        normalizedTagFilterString,
+		GlobalSyntheticAlertConfigFieldTimeThreshold:    r.mapTimeThresholdToSchema(config),
+	})
+}
+
+func (r *globalSyntheticAlertConfigResource) mapRuleToSchema(config *restapi.SyntheticAlertConfig) []map[string]interface{} {
+	ruleAttribute := make(map[string]interface{})
+	ruleAttribute[GlobalSyntheticAlertConfigFieldRuleMetricName] = config.Rule.MetricName
+	if config.Rule.Aggregation != nil {
+		ruleAttribute[GlobalSyntheticAlertConfigFieldRuleAggregation] = string(*config.Rule.Aggregation)
+	}
+
+	rule := make(map[string]interface{})
+	rule[GlobalSyntheticAlertConfigFieldRuleFailure] = []interface{}{ruleAttribute}
+	result := make([]map[string]interface{}, 1)
+	result[0] = rule
+	return result
+}
+
+func (r *globalSyntheticAlertConfigResource) mapTimeThresholdToSchema(config *restapi.SyntheticAlertConfig) []map[string]interface{} {
+	timeThresholdConfig := make(map[string]interface{})
+	if config.TimeThreshold.ViolationsCount != nil {
+		timeThresholdConfig[GlobalSyntheticAlertConfigFieldTimeThresholdViolationsCount] = int(*config.TimeThreshold.ViolationsCount)
+	}
+
+	timeThreshold := make(map[string]interface{})
+	timeThreshold[GlobalSyntheticAlertConfigFieldTimeThresholdViolationsInSequence] = []interface{}{timeThresholdConfig}
+	result := make([]map[string]interface{}, 1)
+	result[0] = timeThreshold
+	return result
+}
+
+func (r *globalSyntheticAlertConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.SyntheticAlertConfig, error) {
+	severity, err := ConvertSeverityFromTerraformToInstanaAPIRepresentation(d.Get(GlobalSyntheticAlertConfigFieldSeverity).(string))
+	if err != nil {
+		return nil, err
+	}
+
+	var tagFilter *restapi.TagFilter
+	tagFilterStr, ok := d.GetOk(GlobalSyntheticAlertConfigFieldTagFilter)
+	if ok {
+		tagFilter, err = r.mapTagFilterExpressionFromSchema(tagFilterStr.(string))
+		if err != nil {
+			return &restapi.SyntheticAlertConfig{}, err
+		}
+	}
+	customPayloadFields, err := mapDefaultCustomPayloadFieldsFromSchema(d)
+	if err != nil {
+		return &restapi.SyntheticAlertConfig{}, err
+	}
+
+	return &restapi.SyntheticAlertConfig{
+		ID:                    d.Id(),
+		AlertChannelIDs:       ReadStringSetParameterFromResource(d, GlobalSyntheticAlertConfigFieldAlertChannelIDs),
+		CustomerPayloadFields: customPayloadFields,
+		Description:           d.Get(GlobalSyntheticAlertConfigFieldDescription).(string),
+		Name:                  d.Get(GlobalSyntheticAlertConfigFieldName).(string),
+		Rule:                  *r.mapRuleFromSchema(d),
+		Severity:              severity,
+		SyntheticTestIDs:      ReadStringSetParameterFromResource(d, GlobalSyntheticAlertConfigFieldSyntheticTestIDs),
+		TagFilterExpression:   tagFilter,
+		TimeThreshold:         *r.mapTimeThresholdFromSchema(d),
+	}, nil
+}
+
+func (r *globalSyntheticAlertConfigResource) mapTagFilterExpressionFromSchema(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
+
+func (r *globalSyntheticAlertConfigResource) mapRuleFromSchema(d *schema.ResourceData) *restapi.SyntheticAlertRule {
+	ruleSlice := d.Get(GlobalSyntheticAlertConfigFieldRule).([]interface{})
+	if len(ruleSlice) == 1 && ruleSlice[0] != nil {
+		rule := ruleSlice[0].(map[string]interface{})
+		configSlice := rule[GlobalSyntheticAlertConfigFieldRuleFailure].([]interface{})
+		if len(configSlice) == 1 && configSlice[0] != nil {
+			config := configSlice[0].(map[string]interface{})
+			var aggregationPtr *restapi.Aggregation
+			if v, ok := config[GlobalSyntheticAlertConfigFieldRuleAggregation]; ok && len(v.(string)) > 0 {
+				aggregation := restapi.Aggregation(v.(string))
+				aggregationPtr = &aggregation
+			}
+			return &restapi.SyntheticAlertRule{
+				AlertType:   "failure",
+				MetricName:  config[GlobalSyntheticAlertConfigFieldRuleMetricName].(string),
+				Aggregation: aggregationPtr,
+			}
+		}
+	}
+	return &restapi.SyntheticAlertRule{}
+}
+
+func (r *globalSyntheticAlertConfigResource) mapTimeThresholdFromSchema(d *schema.ResourceData) *restapi.SyntheticTimeThreshold {
+	timeThresholdSlice := d.Get(GlobalSyntheticAlertConfigFieldTimeThreshold).([]interface{})
+	if len(timeThresholdSlice) == 1 && timeThresholdSlice[0] != nil {
+		timeThreshold := timeThresholdSlice[0].(map[string]interface{})
+		configSlice := timeThreshold[GlobalSyntheticAlertConfigFieldTimeThresholdViolationsInSequence].([]interface{})
+		if len(configSlice) == 1 {
+			var violationsCountPtr *int32
+			if configSlice[0] != nil {
+				config := configSlice[0].(map[string]interface{})
+				if v, ok := config[GlobalSyntheticAlertConfigFieldTimeThresholdViolationsCount]; ok && v.(int) > 0 {
+					violationsCount := int32(v.(int))
+					violationsCountPtr = &violationsCount
+				}
+			}
+			return &restapi.SyntheticTimeThreshold{
+				Type:            "violationsInSequence",
+				ViolationsCount: violationsCountPtr,
+			}
+		}
+	}
+	return &restapi.SyntheticTimeThreshold{}
+}
@@ -0,0 +1,406 @@
+package instana_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestGlobalSyntheticAlertConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaGlobalSyntheticAlertConfig + ".example"
+	inst := &globalSyntheticAlertConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewGlobalSyntheticAlertConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type globalSyntheticAlertConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.SyntheticAlertConfig]
+}
+
+var globalSyntheticAlertConfigTerraformTemplate = `
+resource "instana_global_synthetic_alert_config" "example" {
+	name               = "name %d"
+	description        = "test-alert-description"
+	severity           = "warning"
+	synthetic_test_ids = [ "synthetic-test-id-1" ]
+	alert_channel_ids  = [ "alert-channel-id-1", "alert-channel-id-2" ]
+	tag_filter         = "synthetic.locationLabel@na EQUALS 'test'"
+
+	rule {
+		failure {
+			metric_name = "status"
+			aggregation = "SUM"
+		}
+	}
+
+	time_threshold {
+		violations_in_sequence {
+			violations_count = 2
+		}
+	}
+
+	custom_payload_field {
+		key    = "test1"
+		value  = "test123"
+	}
+}
+`
+
+var globalSyntheticAlertConfigServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"description": "test-alert-description",
+	"severity": 5,
+	"syntheticTestIds": [ "synthetic-test-id-1" ],
+	"tagFilterExpression": {
+		"type": "TAG_FILTER",
+		"name": "synthetic.locationLabel",
+		"stringValue": "test",
+		"numberValue": null,
+		"booleanValue": null,
+		"key": null,
+		"value": "test",
+		"operator": "EQUALS",
+		"entity": "NOT_APPLICABLE"
+	},
+	"rule": {
+		"alertType": "failure",
+		"metricName": "status",
+		"aggregation": "SUM"
+	},
+	"alertChannelIds": [ "alert-channel-id-1", "alert-channel-id-2" ],
+	"timeThreshold": {
+		"type": "violationsInSequence",
+		"violationsCount": 2
+	},
+	"customPayloadFields": [
+		{
+			"type": "staticString",
+			"key": "test1",
+			"value": "test123"
+		}
+	],
+	"created": 1647679325301,
+	"readOnly": false,
+	"enabled": true
+}
+`
+
+func (test *globalSyntheticAlertConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaGlobalSyntheticAlertConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should fail to update state from model when severity is invalid", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestShouldFailToUpdateTerraformResourceStateFromModelWhenSeverityIsNotValid())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when severity is invalid", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when tag filter expression is invalid", ResourceInstanaGlobalSyntheticAlertConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid())
+}
+
+func (test *globalSyntheticAlertConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.GlobalSyntheticAlertConfigsResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.SyntheticAlertConfig{}
+			err := json.NewDecoder(r.Body).Decode(config)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				err = r.Write(bytes.NewBufferString("Failed to get request"))
+				if err != nil {
+					fmt.Printf("failed to write response; %s\n", err)
+				}
+			} else {
+				config.ID = id
+				w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+				w.WriteHeader(http.StatusOK)
+				err = json.NewEncoder(w).Encode(config)
+				if err != nil {
+					fmt.Printf("failed to encode json; %s\n", err)
+				}
+			}
+		})
+		httpServer.AddRoute(http.MethodPost, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPost, resourceRestAPIPath+"/"+id)
+			jsonData := fmt.Sprintf(globalSyntheticAlertConfigServerResponseTemplate, id, modCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+			},
+		})
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	ruleMetricName := fmt.Sprintf("%s.0.%s.0.%s", GlobalSyntheticAlertConfigFieldRule, GlobalSyntheticAlertConfigFieldRuleFailure, GlobalSyntheticAlertConfigFieldRuleMetricName)
+	ruleAggregation := fmt.Sprintf("%s.0.%s.0.%s", GlobalSyntheticAlertConfigFieldRule, GlobalSyntheticAlertConfigFieldRuleFailure, GlobalSyntheticAlertConfigFieldRuleAggregation)
+	timeThresholdViolationsCount := fmt.Sprintf("%s.0.%s.0.%s", GlobalSyntheticAlertConfigFieldTimeThreshold, GlobalSyntheticAlertConfigFieldTimeThresholdViolationsInSequence, GlobalSyntheticAlertConfigFieldTimeThresholdViolationsCount)
+	customPayloadFieldStaticKey := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldKey)
+	customPayloadFieldStaticValue := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldStaticStringValue)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(globalSyntheticAlertConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", id),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GlobalSyntheticAlertConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GlobalSyntheticAlertConfigFieldDescription, "test-alert-description"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GlobalSyntheticAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GlobalSyntheticAlertConfigFieldSyntheticTestIDs+".0", "synthetic-test-id-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GlobalSyntheticAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GlobalSyntheticAlertConfigFieldAlertChannelIDs+".1", "alert-channel-id-2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GlobalSyntheticAlertConfigFieldTagFilter, "synthetic.locationLabel@na EQUALS 'test'"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleMetricName, "status"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleAggregation, "SUM"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, timeThresholdViolationsCount, "2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticKey, "test1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticValue, "test123"),
+		),
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_global_synthetic_alert_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		aggregation := restapi.SumAggregation
+		violationsCount := int32(2)
+		config := restapi.SyntheticAlertConfig{
+			ID:               "synthetic-alert-config-id",
+			Name:             "synthetic-alert-config-name",
+			Description:      "synthetic-alert-config-description",
+			Severity:         restapi.SeverityCritical.GetAPIRepresentation(),
+			SyntheticTestIDs: []string{"test-1"},
+			AlertChannelIDs:  []string{"channel-1", "channel-2"},
+			CustomerPayloadFields: []restapi.CustomPayloadField[any]{
+				{
+					Type:  restapi.StaticStringCustomPayloadType,
+					Key:   "static-key",
+					Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+				},
+			},
+			Rule: restapi.SyntheticAlertRule{
+				AlertType:   "failure",
+				MetricName:  "status",
+				Aggregation: &aggregation,
+			},
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "synthetic.locationLabel", restapi.EqualsOperator, "test"),
+			TimeThreshold: restapi.SyntheticTimeThreshold{
+				Type:            "violationsInSequence",
+				ViolationsCount: &violationsCount,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.NoError(t, err)
+		require.Equal(t, "synthetic-alert-config-id", resourceData.Id())
+		require.Equal(t, "synthetic-alert-config-name", resourceData.Get(GlobalSyntheticAlertConfigFieldName))
+		require.Equal(t, "synthetic-alert-config-description", resourceData.Get(GlobalSyntheticAlertConfigFieldDescription))
+		require.Equal(t, restapi.SeverityCritical.GetTerraformRepresentation(), resourceData.Get(GlobalSyntheticAlertConfigFieldSeverity))
+		require.Equal(t, []interface{}{"test-1"}, (resourceData.Get(GlobalSyntheticAlertConfigFieldSyntheticTestIDs).(*schema.Set)).List())
+		require.Equal(t, []interface{}{"channel-2", "channel-1"}, (resourceData.Get(GlobalSyntheticAlertConfigFieldAlertChannelIDs).(*schema.Set)).List())
+		require.Equal(t, "synthetic.locationLabel@na EQUALS 'test'", resourceData.Get(GlobalSyntheticAlertConfigFieldTagFilter))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				GlobalSyntheticAlertConfigFieldRuleFailure: []interface{}{
+					map[string]interface{}{
+						GlobalSyntheticAlertConfigFieldRuleMetricName:  "status",
+						GlobalSyntheticAlertConfigFieldRuleAggregation: string(aggregation),
+					},
+				},
+			},
+		}, resourceData.Get(GlobalSyntheticAlertConfigFieldRule))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				GlobalSyntheticAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+					map[string]interface{}{
+						GlobalSyntheticAlertConfigFieldTimeThresholdViolationsCount: int(violationsCount),
+					},
+				},
+			},
+		}, resourceData.Get(GlobalSyntheticAlertConfigFieldTimeThreshold))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+			},
+		}, resourceData.Get(DefaultCustomPayloadFieldsName).(*schema.Set).List())
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestShouldFailToUpdateTerraformResourceStateFromModelWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := restapi.SyntheticAlertConfig{
+			Name:     "test",
+			Severity: -1,
+		}
+
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.Error(t, err)
+		require.Equal(t, "-1 is not a valid severity", err.Error())
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		aggregation := restapi.SumAggregation
+		violationsCount := int32(2)
+		expected := &restapi.SyntheticAlertConfig{
+			ID:               "synthetic-alert-config-id",
+			Name:             "synthetic-alert-config-name",
+			Description:      "synthetic-alert-config-description",
+			Severity:         restapi.SeverityCritical.GetAPIRepresentation(),
+			SyntheticTestIDs: []string{"test-1"},
+			AlertChannelIDs:  []string{"channel-2", "channel-1"},
+			CustomerPayloadFields: []restapi.CustomPayloadField[any]{
+				{
+					Type:  restapi.StaticStringCustomPayloadType,
+					Key:   "static-key",
+					Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+				},
+			},
+			Rule: restapi.SyntheticAlertRule{
+				AlertType:   "failure",
+				MetricName:  "status",
+				Aggregation: &aggregation,
+			},
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "synthetic.locationLabel", restapi.EqualsOperator, "test"),
+			TimeThreshold: restapi.SyntheticTimeThreshold{
+				Type:            "violationsInSequence",
+				ViolationsCount: &violationsCount,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("synthetic-alert-config-id")
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldName, "synthetic-alert-config-name")
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldDescription, "synthetic-alert-config-description")
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldSeverity, restapi.SeverityCritical.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldSyntheticTestIDs, []interface{}{"test-1"})
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldAlertChannelIDs, []interface{}{"channel-2", "channel-1"})
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldTagFilter, "synthetic.locationLabel@na EQUALS 'test'")
+		setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+			},
+		})
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldRule, []interface{}{
+			map[string]interface{}{
+				GlobalSyntheticAlertConfigFieldRuleFailure: []interface{}{
+					map[string]interface{}{
+						GlobalSyntheticAlertConfigFieldRuleMetricName:  "status",
+						GlobalSyntheticAlertConfigFieldRuleAggregation: string(aggregation),
+					},
+				},
+			},
+		})
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldTimeThreshold, []interface{}{
+			map[string]interface{}{
+				GlobalSyntheticAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+					map[string]interface{}{
+						GlobalSyntheticAlertConfigFieldTimeThresholdViolationsCount: int(violationsCount),
+					},
+				},
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldName, "synthetic-alert-config-name")
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldSeverity, "invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Equal(t, "invalid is not a valid severity", err.Error())
+	}
+}
+
+func (test *globalSyntheticAlertConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldName, "synthetic-alert-config-name")
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, GlobalSyntheticAlertConfigFieldTagFilter, "invalid invalid invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unexpected token")
+	}
+}
@@ -24,6 +24,10 @@ const (
 	GroupFieldMemberEmail = "email"
 	//GroupFieldMemberUserID constant value for the schema field user_id
 	GroupFieldMemberUserID = "user_id"
+	//GroupFieldIgnoreMembers constant value for the schema field ignore_members
+	GroupFieldIgnoreMembers = "ignore_members"
+	//GroupFieldIgnorePermissionSet constant value for the schema field ignore_permission_set
+	GroupFieldIgnorePermissionSet = "ignore_permission_set"
 	//GroupFieldPermissionSet constant value for the schema field permission_set
 	GroupFieldPermissionSet = "permission_set"
 	//GroupFieldPermissionSetApplicationIDs constant value for the schema field application_ids
@@ -134,28 +138,46 @@ var groupSchemaFullName = &schema.Schema{
 	Description: "The full name of the group. The field is computed and contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level",
 }
 var groupSchemaMembers = &schema.Schema{
-	Type:        schema.TypeSet,
-	Optional:    true,
-	Description: "The members of the group",
-	MaxItems:    1024,
+	Type:          schema.TypeSet,
+	Optional:      true,
+	Description:   "The members of the group",
+	MaxItems:      1024,
+	ConflictsWith: []string{GroupFieldIgnoreMembers},
 	Elem: &schema.Resource{
 		Schema: groupMemberSchema,
 	},
 }
+var groupSchemaIgnoreMembers = &schema.Schema{
+	Type:          schema.TypeBool,
+	Optional:      true,
+	Default:       false,
+	ConflictsWith: []string{GroupFieldMembers},
+	Description:   "Flag to indicate that the members of the group are not managed by this resource. When set to true, the current members of the group are kept on updates. Use this option when memberships are managed via instana_rbac_group_membership resources. Conflicts with member",
+}
 var groupSchemaPermissionSet = &schema.Schema{
-	Type:        schema.TypeList,
-	Optional:    true,
-	MaxItems:    1,
-	Description: "The permission set of the group",
+	Type:          schema.TypeList,
+	Optional:      true,
+	MaxItems:      1,
+	ConflictsWith: []string{GroupFieldIgnorePermissionSet},
+	Description:   "The permission set of the group. Conflicts with ignore_permission_set",
 	Elem: &schema.Resource{
 		Schema: groupPermissionSet,
 	},
 }
+var groupSchemaIgnorePermissionSet = &schema.Schema{
+	Type:          schema.TypeBool,
+	Optional:      true,
+	Default:       false,
+	ConflictsWith: []string{GroupFieldPermissionSet},
+	Description:   "Flag to indicate that the permission set of the group is not managed by this resource. When set to true, the current permission set of the group is kept on updates. Use this option when the permissions are managed via an instana_rbac_group_permission_set resource. Conflicts with permission_set",
+}
 
 var groupSchema = map[string]*schema.Schema{
-	GroupFieldName:          groupSchemaName,
-	GroupFieldMembers:       groupSchemaMembers,
-	GroupFieldPermissionSet: groupSchemaPermissionSet,
+	GroupFieldName:                groupSchemaName,
+	GroupFieldMembers:             groupSchemaMembers,
+	GroupFieldIgnoreMembers:       groupSchemaIgnoreMembers,
+	GroupFieldPermissionSet:       groupSchemaPermissionSet,
+	GroupFieldIgnorePermissionSet: groupSchemaIgnorePermissionSet,
 }
 
 // NewGroupResourceHandle creates the resource handle for RBAC Groups
@@ -201,11 +223,13 @@ func (r *groupResource) UpdateState(d *schema.ResourceData, group *restapi.Group
 		GroupFieldName: group.Name,
 	}
 
-	members := r.convertGroupMembersToState(group)
-	if members != nil {
-		data[GroupFieldMembers] = members
+	if !d.Get(GroupFieldIgnoreMembers).(bool) {
+		members := r.convertGroupMembersToState(group)
+		if members != nil {
+			data[GroupFieldMembers] = members
+		}
 	}
-	if !group.PermissionSet.IsEmpty() {
+	if !d.Get(GroupFieldIgnorePermissionSet).(bool) && !group.PermissionSet.IsEmpty() {
 		permissions := r.convertPermissionSetToState(group)
 		data[GroupFieldPermissionSet] = permissions
 	}
@@ -258,13 +282,23 @@ func (r *groupResource) convertScopeBindingSliceToState(value []restapi.ScopeBin
 }
 
 func (r *groupResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.Group, error) {
-	members := r.convertStateToGroupMembers(d)
+	ignoreMembers := d.Get(GroupFieldIgnoreMembers).(bool)
+	members := []restapi.APIMember{}
+	if !ignoreMembers {
+		members = r.convertStateToGroupMembers(d)
+	}
+	ignorePermissionSet := d.Get(GroupFieldIgnorePermissionSet).(bool)
 	permissionSet := r.convertStateToPermissionSet(d)
+	if ignorePermissionSet {
+		permissionSet = r.createEmptyPermissionSet()
+	}
 	return &restapi.Group{
-		ID:            d.Id(),
-		Name:          d.Get(GroupFieldName).(string),
-		Members:       members,
-		PermissionSet: *permissionSet,
+		ID:                  d.Id(),
+		Name:                d.Get(GroupFieldName).(string),
+		Members:             members,
+		PermissionSet:       *permissionSet,
+		IgnoreMembers:       ignoreMembers,
+		IgnorePermissionSet: ignorePermissionSet,
 	}, nil
 }
 
@@ -308,6 +342,10 @@ func (r *groupResource) convertStateToPermissionSet(d *schema.ResourceData) *res
 		}
 		log.Println("WARN: permission_set state cannot be read")
 	}
+	return r.createEmptyPermissionSet()
+}
+
+func (r *groupResource) createEmptyPermissionSet() *restapi.APIPermissionSetWithRoles {
 	emptyScopeBinding := make([]restapi.ScopeBinding, 0)
 	return &restapi.APIPermissionSetWithRoles{
 		ApplicationIDs:          emptyScopeBinding,
@@ -269,7 +269,13 @@ func TestResourceGroupDefinition(t *testing.T) {
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupFieldName)
 	verifyGroupMemberSchema(t, schemaMap[GroupFieldMembers])
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(GroupFieldIgnoreMembers, false)
+	require.Equal(t, []string{GroupFieldMembers}, schemaMap[GroupFieldIgnoreMembers].ConflictsWith)
+	require.Equal(t, []string{GroupFieldIgnoreMembers}, schemaMap[GroupFieldMembers].ConflictsWith)
 	verifyGroupPermissionSetSchema(t, schemaMap[GroupFieldPermissionSet])
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(GroupFieldIgnorePermissionSet, false)
+	require.Equal(t, []string{GroupFieldPermissionSet}, schemaMap[GroupFieldIgnorePermissionSet].ConflictsWith)
+	require.Equal(t, []string{GroupFieldIgnorePermissionSet}, schemaMap[GroupFieldPermissionSet].ConflictsWith)
 }
 
 func verifyGroupMemberSchema(t *testing.T, groupMemberSchema *schema.Schema) {
@@ -477,3 +483,82 @@ func TestGroupResourceShouldReadModelFromState(t *testing.T) {
 	}
 	require.Equal(t, expectedMembers, result.Members)
 }
+
+func TestShouldNotUpdateMembersInStateWhenMembersOfGroupAreIgnored(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	resourceHandle := NewGroupResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, GroupFieldIgnoreMembers, true)
+
+	member1Email := defaultGroupMember1Email
+	group := restapi.Group{
+		ID:      defaultGroupID,
+		Name:    defaultGroupName,
+		Members: []restapi.APIMember{{UserID: defaultGroupMember1UserID, Email: &member1Email}},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &group)
+
+	require.NoError(t, err)
+	require.Equal(t, defaultGroupID, resourceData.Id())
+	require.Equal(t, defaultGroupName, resourceData.Get(GroupFieldName))
+	require.Empty(t, resourceData.Get(GroupFieldMembers).(*schema.Set).List())
+}
+
+func TestGroupResourceShouldReadModelWithoutMembersFromStateWhenMembersAreIgnored(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	resourceHandle := NewGroupResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	members := []interface{}{
+		map[string]interface{}{
+			GroupFieldMemberUserID: defaultGroupMember1UserID,
+			GroupFieldMemberEmail:  defaultGroupMember1Email,
+		},
+	}
+	resourceData.SetId(defaultGroupID)
+	setValueOnResourceData(t, resourceData, GroupFieldName, defaultGroupName)
+	setValueOnResourceData(t, resourceData, GroupFieldMembers, members)
+	setValueOnResourceData(t, resourceData, GroupFieldIgnoreMembers, true)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, defaultGroupID, result.ID)
+	require.True(t, result.IgnoreMembers)
+	require.Empty(t, result.Members)
+}
+
+func TestShouldNotUpdatePermissionSetInStateWhenPermissionSetOfGroupIsIgnored(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	resourceHandle := NewGroupResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, GroupFieldIgnorePermissionSet, true)
+
+	group := restapi.Group{
+		ID:            defaultGroupID,
+		Name:          defaultGroupName,
+		PermissionSet: restapi.APIPermissionSetWithRoles{Permissions: []restapi.InstanaPermission{restapi.PermissionCanViewLogs}},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &group)
+
+	require.NoError(t, err)
+	require.Equal(t, defaultGroupID, resourceData.Id())
+	require.Empty(t, resourceData.Get(GroupFieldPermissionSet).([]interface{}))
+}
+
+func TestGroupResourceShouldReadModelWithEmptyPermissionSetFromStateWhenPermissionSetIsIgnored(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	resourceHandle := NewGroupResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(defaultGroupID)
+	setValueOnResourceData(t, resourceData, GroupFieldName, defaultGroupName)
+	setValueOnResourceData(t, resourceData, GroupFieldIgnorePermissionSet, true)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, defaultGroupID, result.ID)
+	require.True(t, result.IgnorePermissionSet)
+	require.True(t, result.PermissionSet.IsEmpty())
+}
@@ -0,0 +1,145 @@
+package instana
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaHostAgentConfiguration the name of the terraform-provider-instana resource to push configuration documents to host agents
+const ResourceInstanaHostAgentConfiguration = "instana_host_agent_configuration"
+
+const (
+	//HostAgentConfigurationFieldHostID constant value for the schema field host_id
+	HostAgentConfigurationFieldHostID = "host_id"
+	//HostAgentConfigurationFieldQuery constant value for the schema field query
+	HostAgentConfigurationFieldQuery = "query"
+	//HostAgentConfigurationFieldConfiguration constant value for the schema field configuration
+	HostAgentConfigurationFieldConfiguration = "configuration"
+)
+
+// NewHostAgentConfigurationResourceHandle creates the resource handle for configuration documents of host agents
+func NewHostAgentConfigurationResourceHandle() ResourceHandle[*restapi.HostAgentConfiguration] {
+	return &hostAgentConfigurationResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaHostAgentConfiguration,
+			Schema: map[string]*schema.Schema{
+				HostAgentConfigurationFieldHostID: {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{HostAgentConfigurationFieldQuery},
+					Description:   "The ID of the host of the host agent the configuration is pushed to. When neither host_id nor query is provided, the configuration is pushed to all host agents of the tenant",
+				},
+				HostAgentConfigurationFieldQuery: {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{HostAgentConfigurationFieldHostID},
+					Description:   "The dynamic focus query to select the host agents the configuration is pushed to. When neither host_id nor query is provided, the configuration is pushed to all host agents of the tenant",
+				},
+				HostAgentConfigurationFieldConfiguration: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateHostAgentConfigurationYAML,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						return NormalizeYAMLString(old) == NormalizeYAMLString(new)
+					},
+					StateFunc: func(val interface{}) string {
+						return NormalizeYAMLString(val.(string))
+					},
+					Description: "The YAML document which is pushed to the host agents. The document must be a YAML mapping with the settings of the agent configuration update of the Instana API (e.g. remoteUri, remoteName and remoteBranch). It is converted to JSON and sent as request body to the Instana API. The document is stored in its canonical form so that formatting changes and comments do not cause differences",
+				},
+			},
+			SchemaVersion: 0,
+		},
+	}
+}
+
+func validateHostAgentConfigurationYAML(val interface{}, key string) ([]string, []error) {
+	if _, err := ParseYAMLObject(val.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s is not a valid configuration document; %s", key, err)}
+	}
+	return nil, nil
+}
+
+type hostAgentConfigurationResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *hostAgentConfigurationResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *hostAgentConfigurationResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *hostAgentConfigurationResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.HostAgentConfiguration] {
+	return api.HostAgentConfigurations()
+}
+
+func (r *hostAgentConfigurationResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *hostAgentConfigurationResource) UpdateState(d *schema.ResourceData, config *restapi.HostAgentConfiguration) error {
+	data := map[string]interface{}{}
+	//the Instana API does not provide the configuration of host agents. Scope and configuration are kept as defined in the state
+	if len(config.HostID) > 0 {
+		data[HostAgentConfigurationFieldHostID] = config.HostID
+	}
+	if len(config.Query) > 0 {
+		data[HostAgentConfigurationFieldQuery] = config.Query
+	}
+	if config.Configuration != nil {
+		configuration, err := MarshalYAMLObject(config.Configuration)
+		if err != nil {
+			return err
+		}
+		data[HostAgentConfigurationFieldConfiguration] = configuration
+	}
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *hostAgentConfigurationResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.HostAgentConfiguration, error) {
+	configuration, err := ParseYAMLObject(d.Get(HostAgentConfigurationFieldConfiguration).(string))
+	if err != nil {
+		return nil, err
+	}
+	return &restapi.HostAgentConfiguration{
+		ID:            d.Id(),
+		HostID:        d.Get(HostAgentConfigurationFieldHostID).(string),
+		Query:         d.Get(HostAgentConfigurationFieldQuery).(string),
+		Configuration: configuration,
+	}, nil
+}
+
+// PostRead keeps the scope and the configuration document as defined in the terraform state. The Instana API does not provide the
+// configuration of host agents and GetOne only returns the ID of the configuration
+func (r *hostAgentConfigurationResource) PostRead(d *schema.ResourceData, _ restapi.InstanaAPI, obj *restapi.HostAgentConfiguration) (*restapi.HostAgentConfiguration, error) {
+	if len(d.Get(HostAgentConfigurationFieldConfiguration).(string)) == 0 {
+		//e.g. on import, no configuration is available in the state yet
+		return obj, nil
+	}
+	return r.MapStateToDataObject(d)
+}
+
+// PostDelete reports a warning as the Instana API does not provide an endpoint to remove the configuration of host agents. The resource is only
+// removed from the terraform state
+func (r *hostAgentConfigurationResource) PostDelete(_ *schema.ResourceData, _ restapi.InstanaAPI, _ *restapi.HostAgentConfiguration) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "host agent configuration is not removed from the host agents",
+			Detail:   "The Instana API does not provide an endpoint to remove the configuration of host agents. The resource is only removed from the terraform state and the configuration of the host agents is not changed.",
+		},
+	}
+}
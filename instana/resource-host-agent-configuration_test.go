@@ -0,0 +1,297 @@
+package instana_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestHostAgentConfiguration(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaHostAgentConfiguration + ".example"
+	inst := &hostAgentConfigurationTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewHostAgentConfigurationResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type hostAgentConfigurationTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.HostAgentConfiguration]
+}
+
+var hostAgentConfigurationTerraformTemplate = `
+resource "instana_host_agent_configuration" "example" {
+	host_id       = "host-id"
+	configuration = <<EOT
+# git repository of the agent configuration
+remoteUri:    %s
+remoteBranch: main
+EOT
+}
+`
+
+const (
+	hostAgentConfigurationTestID            = "config-id"
+	hostAgentConfigurationTestHostID        = "host-id"
+	hostAgentConfigurationTestConfiguration = "remoteBranch: main\nremoteUri: https://git.example.com/agent-config.git\n"
+)
+
+func (test *hostAgentConfigurationTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaHostAgentConfiguration), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaHostAgentConfiguration), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaHostAgentConfiguration), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaHostAgentConfiguration), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should have valid schema", ResourceInstanaHostAgentConfiguration), test.createTestResourceShouldHaveValidSchema())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaHostAgentConfiguration), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should keep state when model does not provide it", ResourceInstanaHostAgentConfiguration), test.createTestShouldKeepStateWhenModelDoesNotProvideIt())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaHostAgentConfiguration), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state to model when configuration is not valid", ResourceInstanaHostAgentConfiguration), test.createTestShouldFailToMapTerraformResourceStateToModelWhenConfigurationIsNotValid())
+	t.Run(fmt.Sprintf("%s should validate configuration", ResourceInstanaHostAgentConfiguration), test.createTestShouldValidateConfiguration())
+	t.Run(fmt.Sprintf("%s should canonicalize configuration", ResourceInstanaHostAgentConfiguration), test.createTestShouldCanonicalizeConfiguration())
+	t.Run(fmt.Sprintf("%s should keep configured values on read", ResourceInstanaHostAgentConfiguration), test.createTestShouldKeepConfiguredValuesOnRead())
+	t.Run(fmt.Sprintf("%s should return read object when no configuration is available in state", ResourceInstanaHostAgentConfiguration), test.createTestShouldReturnReadObjectWhenNoConfigurationIsAvailableInState())
+	t.Run(fmt.Sprintf("%s should report warning after delete", ResourceInstanaHostAgentConfiguration), test.createTestShouldReportWarningAfterDelete())
+}
+
+func (test *hostAgentConfigurationTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		requestBodies := make([]string, 0)
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.HostAgentResourcePath+"/{hostId}/configuration", func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			requestBodies = append(requestBodies, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), "https://git.example.com/agent-config.git"),
+				test.createIntegrationTestStep(httpServer.GetPort(), "https://git.example.com/agent-config-v2.git"),
+			},
+		})
+
+		require.Len(t, requestBodies, 2)
+		require.JSONEq(t, `{"remoteBranch":"main","remoteUri":"https://git.example.com/agent-config.git"}`, requestBodies[0])
+		require.JSONEq(t, `{"remoteBranch":"main","remoteUri":"https://git.example.com/agent-config-v2.git"}`, requestBodies[1])
+	}
+}
+
+func (test *hostAgentConfigurationTest) createIntegrationTestStep(httpPort int, remoteURI string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(hostAgentConfigurationTerraformTemplate, remoteURI), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(test.terraformResourceInstanceName, "id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, HostAgentConfigurationFieldHostID, hostAgentConfigurationTestHostID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, HostAgentConfigurationFieldConfiguration, fmt.Sprintf("remoteBranch: main\nremoteUri: %s\n", remoteURI)),
+		),
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_host_agent_configuration", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestResourceShouldHaveValidSchema() func(t *testing.T) {
+	return func(t *testing.T) {
+		schemaData := test.resourceHandle.MetaData().Schema
+
+		schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
+		require.Len(t, schemaData, 3)
+		schemaAssert.AssertSchemaIsOptionalAndOfTypeString(HostAgentConfigurationFieldHostID)
+		schemaAssert.AssertSchemaIsOptionalAndOfTypeString(HostAgentConfigurationFieldQuery)
+		schemaAssert.AssertSchemaIsRequiredAndOfTypeString(HostAgentConfigurationFieldConfiguration)
+		require.True(t, schemaData[HostAgentConfigurationFieldHostID].ForceNew)
+		require.True(t, schemaData[HostAgentConfigurationFieldQuery].ForceNew)
+		require.Equal(t, []string{HostAgentConfigurationFieldQuery}, schemaData[HostAgentConfigurationFieldHostID].ConflictsWith)
+		require.Equal(t, []string{HostAgentConfigurationFieldHostID}, schemaData[HostAgentConfigurationFieldQuery].ConflictsWith)
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, &restapi.HostAgentConfiguration{
+			ID:     hostAgentConfigurationTestID,
+			HostID: hostAgentConfigurationTestHostID,
+			Configuration: map[string]interface{}{
+				"remoteUri":    "https://git.example.com/agent-config.git",
+				"remoteBranch": "main",
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, hostAgentConfigurationTestID, resourceData.Id())
+		require.Equal(t, hostAgentConfigurationTestHostID, resourceData.Get(HostAgentConfigurationFieldHostID))
+		require.Equal(t, "", resourceData.Get(HostAgentConfigurationFieldQuery))
+		require.Equal(t, hostAgentConfigurationTestConfiguration, resourceData.Get(HostAgentConfigurationFieldConfiguration))
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestShouldKeepStateWhenModelDoesNotProvideIt() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldQuery, "entity.zone:test")
+		setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldConfiguration, hostAgentConfigurationTestConfiguration)
+
+		err := test.resourceHandle.UpdateState(resourceData, &restapi.HostAgentConfiguration{ID: hostAgentConfigurationTestID})
+
+		require.NoError(t, err)
+		require.Equal(t, hostAgentConfigurationTestID, resourceData.Id())
+		require.Equal(t, "entity.zone:test", resourceData.Get(HostAgentConfigurationFieldQuery))
+		require.Equal(t, hostAgentConfigurationTestConfiguration, resourceData.Get(HostAgentConfigurationFieldConfiguration))
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId(hostAgentConfigurationTestID)
+		setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldHostID, hostAgentConfigurationTestHostID)
+		setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldConfiguration, "remoteUri: https://git.example.com/agent-config.git\nremoteName: origin\n")
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.HostAgentConfiguration{
+			ID:     hostAgentConfigurationTestID,
+			HostID: hostAgentConfigurationTestHostID,
+			Configuration: map[string]interface{}{
+				"remoteUri":  "https://git.example.com/agent-config.git",
+				"remoteName": "origin",
+			},
+		}, result)
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestShouldFailToMapTerraformResourceStateToModelWhenConfigurationIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldConfiguration, "remoteUri: [value")
+
+		_, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestShouldValidateConfiguration() func(t *testing.T) {
+	return func(t *testing.T) {
+		validateFunc := test.resourceHandle.MetaData().Schema[HostAgentConfigurationFieldConfiguration].ValidateFunc
+
+		_, errs := validateFunc(hostAgentConfigurationTestConfiguration, HostAgentConfigurationFieldConfiguration)
+		require.Empty(t, errs)
+
+		for _, invalid := range []string{"remoteUri: [value", "- value1\n- value2\n", "# only a comment\n", ""} {
+			_, errs = validateFunc(invalid, HostAgentConfigurationFieldConfiguration)
+			require.Len(t, errs, 1, "expected %s to be invalid", invalid)
+			require.Contains(t, errs[0].Error(), "configuration is not a valid configuration document")
+		}
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestShouldCanonicalizeConfiguration() func(t *testing.T) {
+	return func(t *testing.T) {
+		configurationSchema := test.resourceHandle.MetaData().Schema[HostAgentConfigurationFieldConfiguration]
+		formatted := "# comment\nremoteUri:    https://git.example.com/agent-config.git\nremoteBranch:   main\n"
+
+		require.Equal(t, hostAgentConfigurationTestConfiguration, configurationSchema.StateFunc(formatted))
+		require.True(t, configurationSchema.DiffSuppressFunc(HostAgentConfigurationFieldConfiguration, hostAgentConfigurationTestConfiguration, formatted, nil))
+		require.False(t, configurationSchema.DiffSuppressFunc(HostAgentConfigurationFieldConfiguration, hostAgentConfigurationTestConfiguration, "remoteBranch: other\nremoteUri: https://git.example.com/agent-config.git\n", nil))
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestShouldKeepConfiguredValuesOnRead() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId(hostAgentConfigurationTestID)
+		setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldHostID, hostAgentConfigurationTestHostID)
+		setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldConfiguration, hostAgentConfigurationTestConfiguration)
+
+		result, err := test.resourceHandle.(PostReadHook[*restapi.HostAgentConfiguration]).PostRead(resourceData, nil, &restapi.HostAgentConfiguration{ID: hostAgentConfigurationTestID})
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.HostAgentConfiguration{
+			ID:     hostAgentConfigurationTestID,
+			HostID: hostAgentConfigurationTestHostID,
+			Configuration: map[string]interface{}{
+				"remoteUri":    "https://git.example.com/agent-config.git",
+				"remoteBranch": "main",
+			},
+		}, result)
+
+		err = test.resourceHandle.UpdateState(resourceData, result)
+
+		require.NoError(t, err)
+		require.Equal(t, hostAgentConfigurationTestHostID, resourceData.Get(HostAgentConfigurationFieldHostID))
+		require.Equal(t, hostAgentConfigurationTestConfiguration, resourceData.Get(HostAgentConfigurationFieldConfiguration))
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestShouldReturnReadObjectWhenNoConfigurationIsAvailableInState() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId(hostAgentConfigurationTestID)
+		readObject := &restapi.HostAgentConfiguration{ID: hostAgentConfigurationTestID}
+
+		result, err := test.resourceHandle.(PostReadHook[*restapi.HostAgentConfiguration]).PostRead(resourceData, nil, readObject)
+
+		require.NoError(t, err)
+		require.Same(t, readObject, result)
+	}
+}
+
+func (test *hostAgentConfigurationTest) createTestShouldReportWarningAfterDelete() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		result := test.resourceHandle.(PostDeleteHook[*restapi.HostAgentConfiguration]).PostDelete(resourceData, nil, &restapi.HostAgentConfiguration{ID: hostAgentConfigurationTestID})
+
+		require.Len(t, result, 1)
+		require.Equal(t, diag.Warning, result[0].Severity)
+		require.Contains(t, result[0].Detail, "The Instana API does not provide an endpoint to remove the configuration of host agents")
+	}
+}
@@ -0,0 +1,257 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaHttpEndpointConfig the name of the terraform-provider-instana resource to manage HTTP endpoint configurations
+const ResourceInstanaHttpEndpointConfig = "instana_http_endpoint_config"
+
+const (
+	//HttpEndpointConfigFieldServiceID constant value for the schema field service_id
+	HttpEndpointConfigFieldServiceID = "service_id"
+	//HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled constant value for the schema field endpoint_name_by_first_path_segment_rule_enabled
+	HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled = "endpoint_name_by_first_path_segment_rule_enabled"
+	//HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled constant value for the schema field endpoint_name_by_collected_path_template_rule_enabled
+	HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled = "endpoint_name_by_collected_path_template_rule_enabled"
+	//HttpEndpointConfigFieldRule constant value for the schema field rule
+	HttpEndpointConfigFieldRule = "rule"
+	//HttpEndpointConfigFieldRuleEnabled constant value for the schema field rule.enabled
+	HttpEndpointConfigFieldRuleEnabled = "enabled"
+	//HttpEndpointConfigFieldRulePathSegments constant value for the schema field rule.path_segments
+	HttpEndpointConfigFieldRulePathSegments = "path_segments"
+	//HttpEndpointConfigFieldRuleTestCases constant value for the schema field rule.test_cases
+	HttpEndpointConfigFieldRuleTestCases = "test_cases"
+
+	httpPathSegmentMatchAll = "**"
+)
+
+var httpPathSegmentRegex = regexp.MustCompile(`^(\*\*|\{[^/{}*\s]+\}|[^/{}*\s]+)$`)
+
+// NewHttpEndpointConfigResourceHandle creates the resource handle for HTTP endpoint configurations
+func NewHttpEndpointConfigResourceHandle() ResourceHandle[*restapi.HttpEndpointConfig] {
+	return &httpEndpointConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaHttpEndpointConfig,
+			Schema: map[string]*schema.Schema{
+				HttpEndpointConfigFieldServiceID: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The ID of the service the HTTP endpoint configuration applies to",
+				},
+				HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Flag to indicate whether endpoints are named by the first path segment when no rule matches",
+				},
+				HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Flag to indicate whether endpoints are named by the path templates collected from the monitored frameworks",
+				},
+				HttpEndpointConfigFieldRule: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    500,
+					Description: "The ordered list of rules to name the endpoints of the service",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							HttpEndpointConfigFieldRuleEnabled: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Flag to indicate whether the rule is enabled",
+							},
+							HttpEndpointConfigFieldRulePathSegments: {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 16,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringMatch(httpPathSegmentRegex, "path segment must either be a fixed name, a parameter in curly braces (e.g. {id}) or ** to match all remaining segments; slashes, asterisks and whitespaces are not allowed"),
+								},
+								Description: "The ordered path segment matchers of the rule. A segment is either a fixed name (e.g. api), a path parameter in curly braces (e.g. {version}) or ** to match all remaining segments. ** is only allowed as the last path segment",
+							},
+							HttpEndpointConfigFieldRuleTestCases: {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 32,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+								Description: "Example paths which are expected to match the rule",
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			CustomizeDiff:    validateHttpEndpointRulePathSegments,
+		},
+	}
+}
+
+// validateHttpEndpointRulePathSegments verifies that the match all path segment ** is only used as the last path segment of a rule
+func validateHttpEndpointRulePathSegments(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(HttpEndpointConfigFieldRule) {
+		return nil
+	}
+	for i, ruleState := range d.Get(HttpEndpointConfigFieldRule).([]interface{}) {
+		rule, ok := ruleState.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		segments, ok := rule[HttpEndpointConfigFieldRulePathSegments].([]interface{})
+		if !ok {
+			continue
+		}
+		if err := validateHttpPathSegmentMatchAllPosition(segments); err != nil {
+			return fmt.Errorf("%s.%d.%s: %w", HttpEndpointConfigFieldRule, i, HttpEndpointConfigFieldRulePathSegments, err)
+		}
+	}
+	return nil
+}
+
+func validateHttpPathSegmentMatchAllPosition(segments []interface{}) error {
+	for i, segment := range segments {
+		if segment == httpPathSegmentMatchAll && i < len(segments)-1 {
+			return fmt.Errorf("%s is only allowed as the last path segment", httpPathSegmentMatchAll)
+		}
+	}
+	return nil
+}
+
+type httpEndpointConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *httpEndpointConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *httpEndpointConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *httpEndpointConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.HttpEndpointConfig] {
+	return api.HttpEndpointConfigs()
+}
+
+func (r *httpEndpointConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *httpEndpointConfigResource) UpdateState(d *schema.ResourceData, config *restapi.HttpEndpointConfig) error {
+	rules := make([]interface{}, len(config.Rules))
+	for i, rule := range config.Rules {
+		pathSegments, err := r.mapPathSegmentsToState(rule.PathSegments)
+		if err != nil {
+			return err
+		}
+		rules[i] = map[string]interface{}{
+			HttpEndpointConfigFieldRuleEnabled:      rule.Enabled,
+			HttpEndpointConfigFieldRulePathSegments: pathSegments,
+			HttpEndpointConfigFieldRuleTestCases:    rule.TestCases,
+		}
+	}
+
+	d.SetId(config.ServiceID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		HttpEndpointConfigFieldServiceID:                                      config.ServiceID,
+		HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled:      config.EndpointNameByFirstPathSegmentRuleEnabled,
+		HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: config.EndpointNameByCollectedPathTemplateRuleEnabled,
+		HttpEndpointConfigFieldRule:                                           rules,
+	})
+}
+
+func (r *httpEndpointConfigResource) mapPathSegmentsToState(segments []restapi.HttpPathSegmentMatchingRule) ([]string, error) {
+	result := make([]string, len(segments))
+	for i, segment := range segments {
+		switch segment.Type {
+		case restapi.HttpPathSegmentMatchingTypeMatchAll:
+			result[i] = httpPathSegmentMatchAll
+		case restapi.HttpPathSegmentMatchingTypeParameter:
+			result[i] = "{" + r.getSegmentName(segment) + "}"
+		case restapi.HttpPathSegmentMatchingTypeFixed:
+			result[i] = r.getSegmentName(segment)
+		default:
+			return nil, fmt.Errorf("unsupported path segment type %s", segment.Type)
+		}
+	}
+	return result, nil
+}
+
+func (r *httpEndpointConfigResource) getSegmentName(segment restapi.HttpPathSegmentMatchingRule) string {
+	if segment.Name == nil {
+		return ""
+	}
+	return *segment.Name
+}
+
+func (r *httpEndpointConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.HttpEndpointConfig, error) {
+	rulesState := d.Get(HttpEndpointConfigFieldRule).([]interface{})
+	rules := make([]restapi.HttpEndpointRule, len(rulesState))
+	for i, v := range rulesState {
+		ruleState := v.(map[string]interface{})
+		pathSegments, err := r.mapPathSegmentsFromState(ruleState[HttpEndpointConfigFieldRulePathSegments].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = restapi.HttpEndpointRule{
+			Enabled:      ruleState[HttpEndpointConfigFieldRuleEnabled].(bool),
+			PathSegments: pathSegments,
+			TestCases:    r.mapStringListFromState(ruleState[HttpEndpointConfigFieldRuleTestCases].([]interface{})),
+		}
+	}
+
+	return &restapi.HttpEndpointConfig{
+		ServiceID: d.Get(HttpEndpointConfigFieldServiceID).(string),
+		EndpointNameByFirstPathSegmentRuleEnabled:      d.Get(HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool),
+		EndpointNameByCollectedPathTemplateRuleEnabled: d.Get(HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool),
+		Rules: rules,
+	}, nil
+}
+
+func (r *httpEndpointConfigResource) mapPathSegmentsFromState(segmentsState []interface{}) ([]restapi.HttpPathSegmentMatchingRule, error) {
+	if err := validateHttpPathSegmentMatchAllPosition(segmentsState); err != nil {
+		return nil, err
+	}
+	result := make([]restapi.HttpPathSegmentMatchingRule, len(segmentsState))
+	for i, v := range segmentsState {
+		segment := v.(string)
+		if !httpPathSegmentRegex.MatchString(segment) {
+			return nil, fmt.Errorf("invalid path segment %s", segment)
+		}
+		if segment == httpPathSegmentMatchAll {
+			result[i] = restapi.HttpPathSegmentMatchingRule{Type: restapi.HttpPathSegmentMatchingTypeMatchAll}
+		} else if strings.HasPrefix(segment, "{") {
+			name := strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+			result[i] = restapi.HttpPathSegmentMatchingRule{Type: restapi.HttpPathSegmentMatchingTypeParameter, Name: &name}
+		} else {
+			name := segment
+			result[i] = restapi.HttpPathSegmentMatchingRule{Type: restapi.HttpPathSegmentMatchingTypeFixed, Name: &name}
+		}
+	}
+	return result, nil
+}
+
+func (r *httpEndpointConfigResource) mapStringListFromState(values []interface{}) []string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = v.(string)
+	}
+	return result
+}
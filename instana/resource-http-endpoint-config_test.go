@@ -0,0 +1,317 @@
+package instana_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestHttpEndpointConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaHttpEndpointConfig + ".example"
+	inst := &httpEndpointConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewHttpEndpointConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type httpEndpointConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.HttpEndpointConfig]
+}
+
+var httpEndpointConfigTerraformTemplate = `
+resource "instana_http_endpoint_config" "example" {
+	service_id                                        = "%s"
+	endpoint_name_by_first_path_segment_rule_enabled = true
+
+	rule {
+		path_segments = [ "api", "{version}", "**" ]
+		test_cases    = [ "/api/v%d/users" ]
+	}
+}
+`
+
+var httpEndpointConfigServerResponseTemplate = `
+{
+	"serviceId": "%s",
+	"endpointNameByFirstPathSegmentRuleEnabled": true,
+	"endpointNameByCollectedPathTemplateRuleEnabled": false,
+	"rules": [
+		{
+			"enabled": true,
+			"pathSegments": [
+				{ "type": "FIXED", "name": "api" },
+				{ "type": "PARAMETER", "name": "version" },
+				{ "type": "MATCH_ALL" }
+			],
+			"testCases": [ "/api/v%d/users" ]
+		}
+	]
+}
+`
+
+func (test *httpEndpointConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaHttpEndpointConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaHttpEndpointConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaHttpEndpointConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaHttpEndpointConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaHttpEndpointConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should fail to update terraform state when path segment type is not supported", ResourceInstanaHttpEndpointConfig), test.createTestShouldFailToUpdateTerraformResourceStateWhenPathSegmentTypeIsNotSupported())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaHttpEndpointConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should validate path segments", ResourceInstanaHttpEndpointConfig), test.createTestShouldValidatePathSegments())
+	t.Run(fmt.Sprintf("%s should allow match all path segment as last segment", ResourceInstanaHttpEndpointConfig), test.createTestShouldAllowMatchAllPathSegmentAsLastSegment())
+	t.Run(fmt.Sprintf("%s should reject match all path segment before last segment", ResourceInstanaHttpEndpointConfig), test.createTestShouldRejectMatchAllPathSegmentBeforeLastSegment())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state to model when match all path segment is not the last segment", ResourceInstanaHttpEndpointConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenMatchAllPathSegmentIsNotTheLastSegment())
+}
+
+func (test *httpEndpointConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		serviceID := RandomID()
+		resourceRestAPIPath := restapi.HttpEndpointConfigResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodPut, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPut, resourceRestAPIPath+"/"+serviceID)
+			jsonData := fmt.Sprintf(httpEndpointConfigServerResponseTemplate, serviceID, modCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, serviceID),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, serviceID),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, serviceID),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, serviceID),
+			},
+		})
+	}
+}
+
+func (test *httpEndpointConfigTest) createIntegrationTestStep(httpPort int, iteration int, serviceID string) resource.TestStep {
+	ruleField := fmt.Sprintf("%s.0", HttpEndpointConfigFieldRule)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(httpEndpointConfigTerraformTemplate, serviceID, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", serviceID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, HttpEndpointConfigFieldServiceID, serviceID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, trueAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, falseAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.%s", ruleField, HttpEndpointConfigFieldRuleEnabled), trueAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.%s.0", ruleField, HttpEndpointConfigFieldRulePathSegments), "api"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.%s.1", ruleField, HttpEndpointConfigFieldRulePathSegments), "{version}"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.%s.2", ruleField, HttpEndpointConfigFieldRulePathSegments), "**"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.%s.0", ruleField, HttpEndpointConfigFieldRuleTestCases), fmt.Sprintf("/api/v%d/users", iteration)),
+		),
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_http_endpoint_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		api := "api"
+		version := "version"
+		config := &restapi.HttpEndpointConfig{
+			ServiceID: "service-id",
+			EndpointNameByCollectedPathTemplateRuleEnabled: true,
+			Rules: []restapi.HttpEndpointRule{
+				{
+					Enabled: false,
+					PathSegments: []restapi.HttpPathSegmentMatchingRule{
+						{Type: restapi.HttpPathSegmentMatchingTypeFixed, Name: &api},
+						{Type: restapi.HttpPathSegmentMatchingTypeParameter, Name: &version},
+						{Type: restapi.HttpPathSegmentMatchingTypeMatchAll},
+					},
+					TestCases: []string{"/api/v1/users"},
+				},
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.HttpEndpointConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "service-id", resourceData.Id())
+		require.Equal(t, "service-id", resourceData.Get(HttpEndpointConfigFieldServiceID))
+		require.False(t, resourceData.Get(HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool))
+		require.True(t, resourceData.Get(HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				HttpEndpointConfigFieldRuleEnabled:      false,
+				HttpEndpointConfigFieldRulePathSegments: []interface{}{"api", "{version}", "**"},
+				HttpEndpointConfigFieldRuleTestCases:    []interface{}{"/api/v1/users"},
+			},
+		}, resourceData.Get(HttpEndpointConfigFieldRule))
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldFailToUpdateTerraformResourceStateWhenPathSegmentTypeIsNotSupported() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := &restapi.HttpEndpointConfig{
+			ServiceID: "service-id",
+			Rules: []restapi.HttpEndpointRule{
+				{
+					Enabled:      true,
+					PathSegments: []restapi.HttpPathSegmentMatchingRule{{Type: restapi.HttpPathSegmentMatchingTypeUnsupported}},
+				},
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.HttpEndpointConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, config)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "UNSUPPORTED")
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.HttpEndpointConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("service-id")
+		setValueOnResourceData(t, resourceData, HttpEndpointConfigFieldServiceID, "service-id")
+		setValueOnResourceData(t, resourceData, HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, true)
+		setValueOnResourceData(t, resourceData, HttpEndpointConfigFieldRule, []interface{}{
+			map[string]interface{}{
+				HttpEndpointConfigFieldRuleEnabled:      true,
+				HttpEndpointConfigFieldRulePathSegments: []interface{}{"api", "{version}", "**"},
+				HttpEndpointConfigFieldRuleTestCases:    []interface{}{"/api/v1/users"},
+			},
+		})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		api := "api"
+		version := "version"
+		require.Equal(t, &restapi.HttpEndpointConfig{
+			ServiceID: "service-id",
+			EndpointNameByFirstPathSegmentRuleEnabled: true,
+			Rules: []restapi.HttpEndpointRule{
+				{
+					Enabled: true,
+					PathSegments: []restapi.HttpPathSegmentMatchingRule{
+						{Type: restapi.HttpPathSegmentMatchingTypeFixed, Name: &api},
+						{Type: restapi.HttpPathSegmentMatchingTypeParameter, Name: &version},
+						{Type: restapi.HttpPathSegmentMatchingTypeMatchAll},
+					},
+					TestCases: []string{"/api/v1/users"},
+				},
+			},
+		}, result)
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldValidatePathSegments() func(t *testing.T) {
+	return func(t *testing.T) {
+		ruleSchema := test.resourceHandle.MetaData().Schema[HttpEndpointConfigFieldRule].Elem.(*schema.Resource)
+		validateFunc := ruleSchema.Schema[HttpEndpointConfigFieldRulePathSegments].Elem.(*schema.Schema).ValidateFunc
+
+		for _, valid := range []string{"api", "{version}", "**", "v1.0", "users-list"} {
+			_, errs := validateFunc(valid, HttpEndpointConfigFieldRulePathSegments)
+			require.Empty(t, errs, "expected %s to be valid", valid)
+		}
+		for _, invalid := range []string{"", "api/v1", "*", "***", "{}", "{version", "version}", "{ver/sion}", "a b"} {
+			_, errs := validateFunc(invalid, HttpEndpointConfigFieldRulePathSegments)
+			require.NotEmpty(t, errs, "expected %s to be invalid", invalid)
+		}
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldAllowMatchAllPathSegmentAsLastSegment() func(t *testing.T) {
+	return func(t *testing.T) {
+		for _, segments := range [][]interface{}{{"**"}, {"api", "**"}, {"api", "{version}"}} {
+			_, err := test.calculateDiff(segments)
+
+			require.NoError(t, err, "expected %v to be valid", segments)
+		}
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldRejectMatchAllPathSegmentBeforeLastSegment() func(t *testing.T) {
+	return func(t *testing.T) {
+		for _, segments := range [][]interface{}{{"**", "api"}, {"api", "**", "{version}"}, {"**", "**"}} {
+			_, err := test.calculateDiff(segments)
+
+			require.ErrorContains(t, err, "rule.1.path_segments: ** is only allowed as the last path segment", "expected %v to be invalid", segments)
+		}
+	}
+}
+
+func (test *httpEndpointConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenMatchAllPathSegmentIsNotTheLastSegment() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.HttpEndpointConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("service-id")
+		setValueOnResourceData(t, resourceData, HttpEndpointConfigFieldServiceID, "service-id")
+		setValueOnResourceData(t, resourceData, HttpEndpointConfigFieldRule, []interface{}{
+			map[string]interface{}{
+				HttpEndpointConfigFieldRulePathSegments: []interface{}{"**", "api"},
+			},
+		})
+
+		_, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.ErrorContains(t, err, "** is only allowed as the last path segment")
+	}
+}
+
+func (test *httpEndpointConfigTest) calculateDiff(pathSegments []interface{}) (*terraform.InstanceDiff, error) {
+	schemaResource := NewTerraformResource(test.resourceHandle).ToSchemaResource()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		HttpEndpointConfigFieldServiceID: "service-id",
+		HttpEndpointConfigFieldRule: []interface{}{
+			map[string]interface{}{
+				HttpEndpointConfigFieldRulePathSegments: []interface{}{"api"},
+			},
+			map[string]interface{}{
+				HttpEndpointConfigFieldRulePathSegments: pathSegments,
+			},
+		},
+	})
+	return schemaResource.Diff(context.Background(), &terraform.InstanceState{}, config, nil)
+}
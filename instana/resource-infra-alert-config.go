@@ -0,0 +1,373 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaInfraAlertConfig the name of the terraform-provider-instana resource to manage infrastructure alert configs
+const ResourceInstanaInfraAlertConfig = "instana_infrastructure_alert_config"
+
+const (
+	//InfraAlertConfigFieldAlertChannelIDs constant value for field alert_channel_ids of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldAlertChannelIDs = "alert_channel_ids"
+	//InfraAlertConfigFieldDescription constant value for field description of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldDescription = "description"
+	//InfraAlertConfigFieldGranularity constant value for field granularity of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldGranularity = "granularity"
+	//InfraAlertConfigFieldGroupBy constant value for field group_by of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldGroupBy = "group_by"
+	//InfraAlertConfigFieldName constant value for field name of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldName = "name"
+
+	//InfraAlertConfigFieldRule constant value for field rule of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldRule = "rule"
+	//InfraAlertConfigFieldRuleGenericRule constant value for field rule.generic_rule of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldRuleGenericRule = "generic_rule"
+	//InfraAlertConfigFieldRuleMetricName constant value for field rule.generic_rule.metric_name of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldRuleMetricName = "metric_name"
+	//InfraAlertConfigFieldRuleEntityType constant value for field rule.generic_rule.entity_type of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldRuleEntityType = "entity_type"
+	//InfraAlertConfigFieldRuleAggregation constant value for field rule.generic_rule.aggregation of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldRuleAggregation = "aggregation"
+	//InfraAlertConfigFieldRuleCrossSeriesAggregation constant value for field rule.generic_rule.cross_series_aggregation of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldRuleCrossSeriesAggregation = "cross_series_aggregation"
+
+	//InfraAlertConfigFieldSeverity constant value for field severity of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldSeverity = "severity"
+	//InfraAlertConfigFieldTagFilter constant value for field tag_filter of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldTagFilter = "tag_filter"
+
+	//InfraAlertConfigFieldTimeThreshold constant value for field time_threshold of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldTimeThreshold = "time_threshold"
+	//InfraAlertConfigFieldTimeThresholdViolationsInSequence constant value for field time_threshold.violations_in_sequence of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldTimeThresholdViolationsInSequence = "violations_in_sequence"
+	//InfraAlertConfigFieldTimeThresholdTimeWindow constant value for field time_threshold.violations_in_sequence.time_window of resource instana_infrastructure_alert_config
+	InfraAlertConfigFieldTimeThresholdTimeWindow = "time_window"
+)
+
+var (
+	infraAlertConfigSchemaAlertChannelIDs = &schema.Schema{
+		Type:     schema.TypeSet,
+		MinItems: 0,
+		MaxItems: 1024,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		Description: "List of IDs of alert channels defined in Instana.",
+	}
+	infraAlertConfigSchemaDescription = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The description text of the infrastructure alert config",
+		ValidateFunc: validation.StringLenBetween(0, 65536),
+	}
+	infraAlertConfigSchemaGranularity = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      restapi.Granularity600000,
+		ValidateFunc: validation.IntInSlice(restapi.SupportedGranularities.ToIntSlice()),
+		Description:  "The evaluation granularity used for detection of violations of the defined threshold. In other words, it defines the size of the tumbling window used",
+	}
+	infraAlertConfigSchemaGroupBy = &schema.Schema{
+		Type:     schema.TypeList,
+		MinItems: 0,
+		MaxItems: 5,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional:    true,
+		Description: "The list of tag names used to group the metric series of the infrastructure alert config. Each group is evaluated separately",
+	}
+	infraAlertConfigSchemaName = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Name for the infrastructure alert configuration",
+		ValidateFunc: validation.StringLenBetween(0, 256),
+	}
+	infraAlertConfigSchemaRule = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of rule this alert configuration is about.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				InfraAlertConfigFieldRuleGenericRule: {
+					Type:        schema.TypeList,
+					MinItems:    1,
+					MaxItems:    1,
+					Required:    true,
+					Description: "Rule based on a generic infrastructure metric of the configured alert configuration target",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							InfraAlertConfigFieldRuleMetricName: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The metric name of the infrastructure alert rule",
+							},
+							InfraAlertConfigFieldRuleEntityType: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The entity type of the infrastructure alert rule (e.g. host or kubernetesPod)",
+							},
+							InfraAlertConfigFieldRuleAggregation: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(restapi.SupportedAggregations.ToStringSlice(), true),
+								Description:  "The aggregation function applied to the metric of each entity",
+							},
+							InfraAlertConfigFieldRuleCrossSeriesAggregation: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(restapi.SupportedAggregations.ToStringSlice(), true),
+								Description:  "The aggregation function applied across the metric series of a group",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	infraAlertConfigSchemaSeverity = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(restapi.SupportedSeverities.TerraformRepresentations(), false),
+		Description:  "The severity of the alert when triggered",
+	}
+	infraAlertConfigSchemaTimeThreshold = &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Indicates the type of violation of the defined threshold.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				InfraAlertConfigFieldTimeThresholdViolationsInSequence: {
+					Type:        schema.TypeList,
+					MinItems:    1,
+					MaxItems:    1,
+					Required:    true,
+					Description: "Time threshold base on violations in sequence",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							InfraAlertConfigFieldTimeThresholdTimeWindow: {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The time window if the time threshold",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+)
+
+var infraAlertConfigResourceSchema = map[string]*schema.Schema{
+	InfraAlertConfigFieldAlertChannelIDs: infraAlertConfigSchemaAlertChannelIDs,
+	DefaultCustomPayloadFieldsName:       buildCustomPayloadFields(),
+	InfraAlertConfigFieldDescription:     infraAlertConfigSchemaDescription,
+	InfraAlertConfigFieldGranularity:     infraAlertConfigSchemaGranularity,
+	InfraAlertConfigFieldGroupBy:         infraAlertConfigSchemaGroupBy,
+	InfraAlertConfigFieldName:            infraAlertConfigSchemaName,
+	InfraAlertConfigFieldRule:            infraAlertConfigSchemaRule,
+	InfraAlertConfigFieldSeverity:        infraAlertConfigSchemaSeverity,
+	InfraAlertConfigFieldTagFilter:       OptionalTagFilterExpressionSchema,
+	ResourceFieldThreshold:               thresholdSchema,
+	InfraAlertConfigFieldTimeThreshold:   infraAlertConfigSchemaTimeThreshold,
+}
+
+// NewInfraAlertConfigResourceHandle creates the resource handle for Infrastructure Alert Configs
+func NewInfraAlertConfigResourceHandle() ResourceHandle[*restapi.InfraAlertConfig] {
+	return &infraAlertConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName:     ResourceInstanaInfraAlertConfig,
+			Schema:           infraAlertConfigResourceSchema,
+			SkipIDGeneration: true,
+			SchemaVersion:    0,
+		},
+	}
+}
+
+type infraAlertConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *infraAlertConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *infraAlertConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *infraAlertConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.InfraAlertConfig] {
+	return api.InfraAlertConfigs()
+}
+
+func (r *infraAlertConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *infraAlertConfigResource) UpdateState(d *schema.ResourceData, config *restapi.InfraAlertConfig) error {
+	severity, err := ConvertSeverityFromInstanaAPIToTerraformRepresentation(config.Severity)
+	if err != nil {
+		return err
+	}
+	var normalizedTagFilterString *string
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		InfraAlertConfigFieldAlertChannelIDs: config.AlertChannelIDs,
+		DefaultCustomPayloadFieldsName:       mapCustomPayloadFieldsToSchema(config),
+		InfraAlertConfigFieldDescription:     config.Description,
+		InfraAlertConfigFieldGranularity:     config.Granularity,
+		InfraAlertConfigFieldGroupBy:         config.GroupBy,
+		InfraAlertConfigFieldName:            config.Name,
+		InfraAlertConfigFieldRule:            r.mapRuleToSchema(config),
+		InfraAlertConfigFieldSeverity:        severity,
+		InfraAlertConfigFieldTagFilter:       normalizedTagFilterString,
+		ResourceFieldThreshold:               newThresholdMapper().toState(&config.Threshold),
+		InfraAlertConfigFieldTimeThreshold:   r.mapTimeThresholdToSchema(config),
+	})
+}
+
+func (r *infraAlertConfigResource) mapRuleToSchema(config *restapi.InfraAlertConfig) []map[string]interface{} {
+	ruleAttribute := make(map[string]interface{})
+	ruleAttribute[InfraAlertConfigFieldRuleMetricName] = config.Rule.MetricName
+	ruleAttribute[InfraAlertConfigFieldRuleEntityType] = config.Rule.EntityType
+
+	if config.Rule.Aggregation != nil {
+		ruleAttribute[InfraAlertConfigFieldRuleAggregation] = string(*config.Rule.Aggregation)
+	}
+	if config.Rule.CrossSeriesAggregation != nil {
+		ruleAttribute[InfraAlertConfigFieldRuleCrossSeriesAggregation] = string(*config.Rule.CrossSeriesAggregation)
+	}
+
+	rule := make(map[string]interface{})
+	rule[InfraAlertConfigFieldRuleGenericRule] = []interface{}{ruleAttribute}
+	result := make([]map[string]interface{}, 1)
+	result[0] = rule
+	return result
+}
+
+func (r *infraAlertConfigResource) mapTimeThresholdToSchema(config *restapi.InfraAlertConfig) []map[string]interface{} {
+	timeThresholdConfig := make(map[string]interface{})
+	if config.TimeThreshold.TimeWindow != nil {
+		timeThresholdConfig[InfraAlertConfigFieldTimeThresholdTimeWindow] = int(*config.TimeThreshold.TimeWindow)
+	}
+
+	timeThreshold := make(map[string]interface{})
+	timeThreshold[InfraAlertConfigFieldTimeThresholdViolationsInSequence] = []interface{}{timeThresholdConfig}
+	result := make([]map[string]interface{}, 1)
+	result[0] = timeThreshold
+	return result
+}
+
+func (r *infraAlertConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.InfraAlertConfig, error) {
+	severity, err := ConvertSeverityFromTerraformToInstanaAPIRepresentation(d.Get(InfraAlertConfigFieldSeverity).(string))
+	if err != nil {
+		return nil, err
+	}
+
+	var tagFilter *restapi.TagFilter
+	tagFilterStr, ok := d.GetOk(InfraAlertConfigFieldTagFilter)
+	if ok {
+		tagFilter, err = r.mapTagFilterExpressionFromSchema(tagFilterStr.(string))
+		if err != nil {
+			return &restapi.InfraAlertConfig{}, err
+		}
+	}
+	customPayloadFields, err := mapDefaultCustomPayloadFieldsFromSchema(d)
+	if err != nil {
+		return &restapi.InfraAlertConfig{}, err
+	}
+
+	threshold := newThresholdMapper().fromState(d)
+
+	return &restapi.InfraAlertConfig{
+		ID:                    d.Id(),
+		AlertChannelIDs:       ReadStringSetParameterFromResource(d, InfraAlertConfigFieldAlertChannelIDs),
+		CustomerPayloadFields: customPayloadFields,
+		Description:           d.Get(InfraAlertConfigFieldDescription).(string),
+		Granularity:           restapi.Granularity(d.Get(InfraAlertConfigFieldGranularity).(int)),
+		GroupBy:               ConvertInterfaceSlice[string](d.Get(InfraAlertConfigFieldGroupBy).([]interface{})),
+		Name:                  d.Get(InfraAlertConfigFieldName).(string),
+		Rule:                  *r.mapRuleFromSchema(d),
+		Severity:              severity,
+		TagFilterExpression:   tagFilter,
+		Threshold:             *threshold,
+		TimeThreshold:         *r.mapTimeThresholdFromSchema(d),
+	}, nil
+}
+
+func (r *infraAlertConfigResource) mapTagFilterExpressionFromSchema(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
+
+func (r *infraAlertConfigResource) mapRuleFromSchema(d *schema.ResourceData) *restapi.InfraAlertRule {
+	ruleSlice := d.Get(InfraAlertConfigFieldRule).([]interface{})
+	if len(ruleSlice) == 1 && ruleSlice[0] != nil {
+		rule := ruleSlice[0].(map[string]interface{})
+		configSlice := rule[InfraAlertConfigFieldRuleGenericRule].([]interface{})
+		if len(configSlice) == 1 && configSlice[0] != nil {
+			config := configSlice[0].(map[string]interface{})
+			return &restapi.InfraAlertRule{
+				AlertType:              "genericRule",
+				MetricName:             config[InfraAlertConfigFieldRuleMetricName].(string),
+				EntityType:             config[InfraAlertConfigFieldRuleEntityType].(string),
+				Aggregation:            r.mapAggregationFromSchema(config, InfraAlertConfigFieldRuleAggregation),
+				CrossSeriesAggregation: r.mapAggregationFromSchema(config, InfraAlertConfigFieldRuleCrossSeriesAggregation),
+			}
+		}
+	}
+	return &restapi.InfraAlertRule{}
+}
+
+func (r *infraAlertConfigResource) mapAggregationFromSchema(config map[string]interface{}, key string) *restapi.Aggregation {
+	if v, ok := config[key]; ok && len(v.(string)) > 0 {
+		aggregation := restapi.Aggregation(v.(string))
+		return &aggregation
+	}
+	return nil
+}
+
+func (r *infraAlertConfigResource) mapTimeThresholdFromSchema(d *schema.ResourceData) *restapi.InfraTimeThreshold {
+	timeThresholdSlice := d.Get(InfraAlertConfigFieldTimeThreshold).([]interface{})
+	if len(timeThresholdSlice) == 1 && timeThresholdSlice[0] != nil {
+		timeThreshold := timeThresholdSlice[0].(map[string]interface{})
+		configSlice := timeThreshold[InfraAlertConfigFieldTimeThresholdViolationsInSequence].([]interface{})
+		if len(configSlice) == 1 && configSlice[0] != nil {
+			config := configSlice[0].(map[string]interface{})
+			var timeWindowPtr *int64
+			if v, ok := config[InfraAlertConfigFieldTimeThresholdTimeWindow]; ok {
+				timeWindow := int64(v.(int))
+				timeWindowPtr = &timeWindow
+			}
+			return &restapi.InfraTimeThreshold{
+				Type:       "violationsInSequence",
+				TimeWindow: timeWindowPtr,
+			}
+		}
+	}
+	return &restapi.InfraTimeThreshold{}
+}
@@ -0,0 +1,503 @@
+package instana_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestInfraAlertConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaInfraAlertConfig + ".example"
+	inst := &infraAlertConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewInfraAlertConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type infraAlertConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.InfraAlertConfig]
+}
+
+var infraAlertConfigTerraformTemplate = `
+resource "instana_infrastructure_alert_config" "example" {
+	name              = "name %d"
+	description       = "test-alert-description"
+	severity          = "warning"
+	alert_channel_ids = [ "alert-channel-id-1", "alert-channel-id-2" ]
+	granularity       = 600000
+	group_by          = [ "host.name" ]
+	tag_filter        = "host.name@na EQUALS 'test'"
+
+	rule {
+		generic_rule {
+			metric_name              = "cpu.used"
+			entity_type              = "host"
+			aggregation              = "MEAN"
+			cross_series_aggregation = "MAX"
+		}
+	}
+
+	threshold {
+		static {
+			operator = ">="
+			value    = 5.0
+		}
+	}
+
+	time_threshold {
+		violations_in_sequence {
+			time_window = 600000
+		}
+	}
+
+	custom_payload_field {
+		key    = "test1"
+		value  = "test123"
+	}
+}
+`
+
+var infraAlertConfigServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"description": "test-alert-description",
+	"severity": 5,
+	"tagFilterExpression": {
+		"type": "TAG_FILTER",
+		"name": "host.name",
+		"stringValue": "test",
+		"numberValue": null,
+		"booleanValue": null,
+		"key": null,
+		"value": "test",
+		"operator": "EQUALS",
+		"entity": "NOT_APPLICABLE"
+	},
+	"groupBy": [ "host.name" ],
+	"rule": {
+		"alertType": "genericRule",
+		"metricName": "cpu.used",
+		"entityType": "host",
+		"aggregation": "MEAN",
+		"crossSeriesAggregation": "MAX"
+	},
+	"threshold": {
+		"type": "staticThreshold",
+		"operator": ">=",
+		"value": 5.0,
+		"lastUpdated": 0
+	},
+	"alertChannelIds": [ "alert-channel-id-1", "alert-channel-id-2" ],
+	"granularity": 600000,
+	"timeThreshold": {
+		"type": "violationsInSequence",
+		"timeWindow": 600000
+	},
+	"customPayloadFields": [
+		{
+			"type": "staticString",
+			"key": "test1",
+			"value": "test123"
+		}
+	],
+	"created": 1647679325301,
+	"readOnly": false,
+	"enabled": true
+}
+`
+
+func (test *infraAlertConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaInfraAlertConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaInfraAlertConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaInfraAlertConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaInfraAlertConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaInfraAlertConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should fail to update state from model when severity is invalid", ResourceInstanaInfraAlertConfig), test.createTestShouldFailToUpdateTerraformResourceStateFromModelWhenSeverityIsNotValid())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaInfraAlertConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when severity is invalid", ResourceInstanaInfraAlertConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when tag filter expression is invalid", ResourceInstanaInfraAlertConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when custom field is not valid", ResourceInstanaInfraAlertConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenCustomFieldIsNotValid())
+}
+
+func (test *infraAlertConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.InfraAlertConfigResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.InfraAlertConfig{}
+			err := json.NewDecoder(r.Body).Decode(config)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				err = r.Write(bytes.NewBufferString("Failed to get request"))
+				if err != nil {
+					fmt.Printf("failed to write response; %s\n", err)
+				}
+			} else {
+				config.ID = id
+				w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+				w.WriteHeader(http.StatusOK)
+				err = json.NewEncoder(w).Encode(config)
+				if err != nil {
+					fmt.Printf("failed to encode json; %s\n", err)
+				}
+			}
+		})
+		httpServer.AddRoute(http.MethodPost, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPost, resourceRestAPIPath+"/"+id)
+			jsonData := fmt.Sprintf(infraAlertConfigServerResponseTemplate, id, modCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+			},
+		})
+	}
+}
+
+func (test *infraAlertConfigTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	ruleMetricName := fmt.Sprintf("%s.0.%s.0.%s", InfraAlertConfigFieldRule, InfraAlertConfigFieldRuleGenericRule, InfraAlertConfigFieldRuleMetricName)
+	ruleEntityType := fmt.Sprintf("%s.0.%s.0.%s", InfraAlertConfigFieldRule, InfraAlertConfigFieldRuleGenericRule, InfraAlertConfigFieldRuleEntityType)
+	ruleAggregation := fmt.Sprintf("%s.0.%s.0.%s", InfraAlertConfigFieldRule, InfraAlertConfigFieldRuleGenericRule, InfraAlertConfigFieldRuleAggregation)
+	ruleCrossSeriesAggregation := fmt.Sprintf("%s.0.%s.0.%s", InfraAlertConfigFieldRule, InfraAlertConfigFieldRuleGenericRule, InfraAlertConfigFieldRuleCrossSeriesAggregation)
+	thresholdStaticOperator := fmt.Sprintf("%s.0.%s.0.%s", ResourceFieldThreshold, ResourceFieldThresholdStatic, ResourceFieldThresholdOperator)
+	thresholdStaticValue := fmt.Sprintf("%s.0.%s.0.%s", ResourceFieldThreshold, ResourceFieldThresholdStatic, ResourceFieldThresholdStaticValue)
+	timeThresholdTimeWindow := fmt.Sprintf("%s.0.%s.0.%s", InfraAlertConfigFieldTimeThreshold, InfraAlertConfigFieldTimeThresholdViolationsInSequence, InfraAlertConfigFieldTimeThresholdTimeWindow)
+	customPayloadFieldStaticKey := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldKey)
+	customPayloadFieldStaticValue := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldStaticStringValue)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(infraAlertConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", id),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, InfraAlertConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, InfraAlertConfigFieldDescription, "test-alert-description"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, InfraAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, InfraAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, InfraAlertConfigFieldAlertChannelIDs+".1", "alert-channel-id-2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, InfraAlertConfigFieldGranularity, "600000"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, InfraAlertConfigFieldGroupBy+".0", "host.name"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, InfraAlertConfigFieldTagFilter, "host.name@na EQUALS 'test'"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleMetricName, "cpu.used"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleEntityType, "host"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleAggregation, "MEAN"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleCrossSeriesAggregation, "MAX"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, thresholdStaticOperator, ">="),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, thresholdStaticValue, "5"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, timeThresholdTimeWindow, "600000"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticKey, "test1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticValue, "test123"),
+		),
+	}
+}
+
+func (test *infraAlertConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *infraAlertConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *infraAlertConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_infrastructure_alert_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *infraAlertConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		aggregation := restapi.MeanAggregation
+		crossSeriesAggregation := restapi.MaxAggregation
+		thresholdValue := 5.0
+		timeWindow := int64(600000)
+		config := restapi.InfraAlertConfig{
+			ID:              "infra-alert-config-id",
+			Name:            "infra-alert-config-name",
+			Description:     "infra-alert-config-description",
+			Severity:        restapi.SeverityCritical.GetAPIRepresentation(),
+			AlertChannelIDs: []string{"channel-1", "channel-2"},
+			Granularity:     restapi.Granularity600000,
+			GroupBy:         []string{"host.name"},
+			CustomerPayloadFields: []restapi.CustomPayloadField[any]{
+				{
+					Type:  restapi.StaticStringCustomPayloadType,
+					Key:   "static-key",
+					Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+				},
+			},
+			Rule: restapi.InfraAlertRule{
+				AlertType:              "genericRule",
+				MetricName:             "cpu.used",
+				EntityType:             "host",
+				Aggregation:            &aggregation,
+				CrossSeriesAggregation: &crossSeriesAggregation,
+			},
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "host.name", restapi.EqualsOperator, "test"),
+			Threshold: restapi.Threshold{
+				Type:     "staticThreshold",
+				Operator: restapi.ThresholdOperatorGreaterThanOrEqual,
+				Value:    &thresholdValue,
+			},
+			TimeThreshold: restapi.InfraTimeThreshold{
+				Type:       "violationsInSequence",
+				TimeWindow: &timeWindow,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.InfraAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.NoError(t, err)
+		require.Equal(t, "infra-alert-config-id", resourceData.Id())
+		require.Equal(t, "infra-alert-config-name", resourceData.Get(InfraAlertConfigFieldName))
+		require.Equal(t, "infra-alert-config-description", resourceData.Get(InfraAlertConfigFieldDescription))
+		require.Equal(t, restapi.SeverityCritical.GetTerraformRepresentation(), resourceData.Get(InfraAlertConfigFieldSeverity))
+		require.Equal(t, []interface{}{"channel-2", "channel-1"}, (resourceData.Get(InfraAlertConfigFieldAlertChannelIDs).(*schema.Set)).List())
+		require.Equal(t, int(restapi.Granularity600000), resourceData.Get(InfraAlertConfigFieldGranularity))
+		require.Equal(t, []interface{}{"host.name"}, resourceData.Get(InfraAlertConfigFieldGroupBy))
+		require.Equal(t, "host.name@na EQUALS 'test'", resourceData.Get(InfraAlertConfigFieldTagFilter))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				InfraAlertConfigFieldRuleGenericRule: []interface{}{
+					map[string]interface{}{
+						InfraAlertConfigFieldRuleMetricName:             "cpu.used",
+						InfraAlertConfigFieldRuleEntityType:             "host",
+						InfraAlertConfigFieldRuleAggregation:            string(aggregation),
+						InfraAlertConfigFieldRuleCrossSeriesAggregation: string(crossSeriesAggregation),
+					},
+				},
+			},
+		}, resourceData.Get(InfraAlertConfigFieldRule))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				InfraAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+					map[string]interface{}{
+						InfraAlertConfigFieldTimeThresholdTimeWindow: int(timeWindow),
+					},
+				},
+			},
+		}, resourceData.Get(InfraAlertConfigFieldTimeThreshold))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+			},
+		}, resourceData.Get(DefaultCustomPayloadFieldsName).(*schema.Set).List())
+		thresholdStatic := resourceData.Get(ResourceFieldThreshold).([]interface{})[0].(map[string]interface{})[ResourceFieldThresholdStatic].([]interface{})
+		require.Len(t, thresholdStatic, 1)
+		require.Equal(t, thresholdValue, thresholdStatic[0].(map[string]interface{})[ResourceFieldThresholdStaticValue])
+	}
+}
+
+func (test *infraAlertConfigTest) createTestShouldFailToUpdateTerraformResourceStateFromModelWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := restapi.InfraAlertConfig{
+			Name:     "test",
+			Severity: -1,
+		}
+
+		testHelper := NewTestHelper[*restapi.InfraAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.Error(t, err)
+		require.Equal(t, "-1 is not a valid severity", err.Error())
+	}
+}
+
+func (test *infraAlertConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		aggregation := restapi.MeanAggregation
+		crossSeriesAggregation := restapi.MaxAggregation
+		thresholdValue := 5.0
+		thresholdLastUpdated := int64(0)
+		timeWindow := int64(600000)
+		expected := &restapi.InfraAlertConfig{
+			ID:              "infra-alert-config-id",
+			Name:            "infra-alert-config-name",
+			Description:     "infra-alert-config-description",
+			Severity:        restapi.SeverityCritical.GetAPIRepresentation(),
+			AlertChannelIDs: []string{"channel-2", "channel-1"},
+			Granularity:     restapi.Granularity600000,
+			GroupBy:         []string{"host.name"},
+			CustomerPayloadFields: []restapi.CustomPayloadField[any]{
+				{
+					Type:  restapi.StaticStringCustomPayloadType,
+					Key:   "static-key",
+					Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+				},
+			},
+			Rule: restapi.InfraAlertRule{
+				AlertType:              "genericRule",
+				MetricName:             "cpu.used",
+				EntityType:             "host",
+				Aggregation:            &aggregation,
+				CrossSeriesAggregation: &crossSeriesAggregation,
+			},
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "host.name", restapi.EqualsOperator, "test"),
+			Threshold: restapi.Threshold{
+				Type:        "staticThreshold",
+				Operator:    restapi.ThresholdOperatorGreaterThanOrEqual,
+				LastUpdated: &thresholdLastUpdated,
+				Value:       &thresholdValue,
+			},
+			TimeThreshold: restapi.InfraTimeThreshold{
+				Type:       "violationsInSequence",
+				TimeWindow: &timeWindow,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.InfraAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("infra-alert-config-id")
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldName, "infra-alert-config-name")
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldDescription, "infra-alert-config-description")
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldSeverity, restapi.SeverityCritical.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldAlertChannelIDs, []interface{}{"channel-2", "channel-1"})
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldGranularity, restapi.Granularity600000)
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldGroupBy, []interface{}{"host.name"})
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldTagFilter, "host.name@na EQUALS 'test'")
+		setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+			},
+		})
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldRule, []interface{}{
+			map[string]interface{}{
+				InfraAlertConfigFieldRuleGenericRule: []interface{}{
+					map[string]interface{}{
+						InfraAlertConfigFieldRuleMetricName:             "cpu.used",
+						InfraAlertConfigFieldRuleEntityType:             "host",
+						InfraAlertConfigFieldRuleAggregation:            string(aggregation),
+						InfraAlertConfigFieldRuleCrossSeriesAggregation: string(crossSeriesAggregation),
+					},
+				},
+			},
+		})
+		setValueOnResourceData(t, resourceData, ResourceFieldThreshold, []interface{}{
+			map[string]interface{}{
+				ResourceFieldThresholdStatic: []interface{}{
+					map[string]interface{}{
+						ResourceFieldThresholdOperator:    string(restapi.ThresholdOperatorGreaterThanOrEqual),
+						ResourceFieldThresholdStaticValue: thresholdValue,
+					},
+				},
+			},
+		})
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldTimeThreshold, []interface{}{
+			map[string]interface{}{
+				InfraAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+					map[string]interface{}{
+						InfraAlertConfigFieldTimeThresholdTimeWindow: int(timeWindow),
+					},
+				},
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+	}
+}
+
+func (test *infraAlertConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.InfraAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldName, "infra-alert-config-name")
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldSeverity, "invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Equal(t, "invalid is not a valid severity", err.Error())
+	}
+}
+
+func (test *infraAlertConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.InfraAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldName, "infra-alert-config-name")
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldTagFilter, "invalid invalid invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unexpected token")
+	}
+}
+
+func (test *infraAlertConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenCustomFieldIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.InfraAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldName, "infra-alert-config-name")
+		setValueOnResourceData(t, resourceData, InfraAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "dynamic-key",
+				CustomPayloadFieldsFieldStaticStringValue: "invalid",
+				CustomPayloadFieldsFieldDynamicValue: []interface{}{
+					map[string]interface{}{
+						CustomPayloadFieldsFieldDynamicKey:     "dynamic-value-key",
+						CustomPayloadFieldsFieldDynamicTagName: "dynamic-value-tag-name",
+					},
+				},
+			},
+		})
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.ErrorContains(t, err, "either a static string value or a dynamic value must")
+	}
+}
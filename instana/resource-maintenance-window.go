@@ -0,0 +1,316 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaMaintenanceWindow the name of the terraform-provider-instana resource to manage maintenance windows
+const ResourceInstanaMaintenanceWindow = "instana_maintenance_window"
+
+const (
+	//MaintenanceWindowFieldName constant value for the schema field name
+	MaintenanceWindowFieldName = "name"
+	//MaintenanceWindowFieldQuery constant value for the schema field query
+	MaintenanceWindowFieldQuery = "query"
+	//MaintenanceWindowFieldTagFilter constant value for the schema field tag_filter
+	MaintenanceWindowFieldTagFilter = "tag_filter"
+	//MaintenanceWindowFieldPaused constant value for the schema field paused
+	MaintenanceWindowFieldPaused = "paused"
+	//MaintenanceWindowFieldScheduling constant value for the schema field scheduling
+	MaintenanceWindowFieldScheduling = "scheduling"
+	//MaintenanceWindowFieldSchedulingStart constant value for the schema field scheduling.start
+	MaintenanceWindowFieldSchedulingStart = "start"
+	//MaintenanceWindowFieldSchedulingDuration constant value for the schema field scheduling.duration
+	MaintenanceWindowFieldSchedulingDuration = "duration"
+	//MaintenanceWindowFieldSchedulingDurationAmount constant value for the schema field scheduling.duration.amount
+	MaintenanceWindowFieldSchedulingDurationAmount = "amount"
+	//MaintenanceWindowFieldSchedulingDurationUnit constant value for the schema field scheduling.duration.unit
+	MaintenanceWindowFieldSchedulingDurationUnit = "unit"
+	//MaintenanceWindowFieldSchedulingRecurrent constant value for the schema field scheduling.recurrent
+	MaintenanceWindowFieldSchedulingRecurrent = "recurrent"
+	//MaintenanceWindowFieldSchedulingRecurrentRRule constant value for the schema field scheduling.recurrent.rrule
+	MaintenanceWindowFieldSchedulingRecurrentRRule = "rrule"
+	//MaintenanceWindowFieldSchedulingRecurrentTimezoneID constant value for the schema field scheduling.recurrent.timezone_id
+	MaintenanceWindowFieldSchedulingRecurrentTimezoneID = "timezone_id"
+)
+
+var maintenanceWindowQuerySyntaxSanityCheckValidateFunc = func(val interface{}, key string) (warns []string, errs []error) {
+	if err := sanityCheckDynamicFocusQuerySyntax(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q failed the syntax sanity check of the dynamic focus query; %s", key, err))
+	}
+	return
+}
+
+// sanityCheckDynamicFocusQuerySyntax is a syntax sanity check of the given dynamic focus query, not a validation of
+// the query language. It only verifies that quoted values are terminated and that brackets are balanced, so that
+// obvious typos are reported during plan. Keys, operators and values are not checked; the query itself is validated
+// by the Instana API on apply.
+func sanityCheckDynamicFocusQuerySyntax(query string) error {
+	depth := 0
+	inQuotes := false
+	escaped := false
+	for i, c := range query {
+		if escaped {
+			escaped = false
+			continue
+		}
+		switch {
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+		case c == '(' && !inQuotes:
+			depth++
+		case c == ')' && !inQuotes:
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("unexpected closing bracket at position %d", i)
+			}
+		}
+	}
+	if inQuotes {
+		return fmt.Errorf("unterminated quoted value")
+	}
+	if depth > 0 {
+		return fmt.Errorf("missing closing bracket")
+	}
+	return nil
+}
+
+var maintenanceWindowResourceSchema = map[string]*schema.Schema{
+	MaintenanceWindowFieldName: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The name of the maintenance window",
+		ValidateFunc: validation.StringLenBetween(0, 256),
+	},
+	MaintenanceWindowFieldQuery: {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "",
+		Description:  "The dynamic focus query used to filter the alert notifications which are muted during the maintenance window. An empty query applies to all entities. The provider only performs a syntax sanity check (terminated quoted values, balanced brackets); the query itself is validated by the Instana API",
+		ValidateFunc: validation.All(validation.StringLenBetween(0, 2048), maintenanceWindowQuerySyntaxSanityCheckValidateFunc),
+	},
+	MaintenanceWindowFieldTagFilter: OptionalTagFilterExpressionSchema,
+	MaintenanceWindowFieldPaused: {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Indicates whether the maintenance window is paused. Alerts are no longer muted while the maintenance window is paused. Only supported for recurrent maintenance windows",
+	},
+	MaintenanceWindowFieldScheduling: {
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		Description: "Defines when the maintenance window is scheduled",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				MaintenanceWindowFieldSchedulingStart: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The start time of the (first occurrence of the) maintenance window in milliseconds since epoch",
+				},
+				MaintenanceWindowFieldSchedulingDuration: {
+					Type:        schema.TypeList,
+					MinItems:    1,
+					MaxItems:    1,
+					Required:    true,
+					Description: "The duration of each occurrence of the maintenance window",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MaintenanceWindowFieldSchedulingDurationAmount: {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The amount of time units of the duration",
+							},
+							MaintenanceWindowFieldSchedulingDurationUnit: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(restapi.SupportedMaintenanceDurationUnits.ToStringSlice(), false),
+								Description:  "The time unit of the duration",
+							},
+						},
+					},
+				},
+				MaintenanceWindowFieldSchedulingRecurrent: {
+					Type:        schema.TypeList,
+					MinItems:    0,
+					MaxItems:    1,
+					Optional:    true,
+					Description: "Configures the maintenance window as recurrent. The maintenance window is scheduled exactly once when not defined",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							MaintenanceWindowFieldSchedulingRecurrentRRule: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotWhiteSpace,
+								Description:  "The recurrence rule of the maintenance window according to the RRULE standard of the iCalendar specification (RFC 5545)",
+							},
+							MaintenanceWindowFieldSchedulingRecurrentTimezoneID: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The ID of the timezone used to evaluate the recurrence rule, e.g. America/New_York",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// NewMaintenanceWindowResourceHandle creates the resource handle for maintenance windows
+func NewMaintenanceWindowResourceHandle() ResourceHandle[*restapi.MaintenanceWindowConfig] {
+	return &maintenanceWindowResource{
+		metaData: ResourceMetaData{
+			ResourceName:  ResourceInstanaMaintenanceWindow,
+			Schema:        maintenanceWindowResourceSchema,
+			SchemaVersion: 0,
+			CustomizeDiff: validateMaintenanceWindowPausedState,
+		},
+	}
+}
+
+// validateMaintenanceWindowPausedState ensures that only recurrent maintenance windows are paused as the pause and resume endpoints of the Instana API are only supported for recurrent maintenance windows
+func validateMaintenanceWindowPausedState(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(MaintenanceWindowFieldPaused) || !d.Get(MaintenanceWindowFieldPaused).(bool) || !d.NewValueKnown(MaintenanceWindowFieldScheduling) {
+		return nil
+	}
+	schedulingSlice := d.Get(MaintenanceWindowFieldScheduling).([]interface{})
+	if len(schedulingSlice) == 1 && schedulingSlice[0] != nil {
+		recurrentSlice, ok := schedulingSlice[0].(map[string]interface{})[MaintenanceWindowFieldSchedulingRecurrent].([]interface{})
+		if ok && len(recurrentSlice) > 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is only supported for recurrent maintenance windows", MaintenanceWindowFieldPaused)
+}
+
+type maintenanceWindowResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *maintenanceWindowResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *maintenanceWindowResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *maintenanceWindowResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.MaintenanceWindowConfig] {
+	return api.MaintenanceWindowConfigs()
+}
+
+func (r *maintenanceWindowResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *maintenanceWindowResource) UpdateState(d *schema.ResourceData, config *restapi.MaintenanceWindowConfig) error {
+	var normalizedTagFilterString *string
+	var err error
+	if config.TagFilterExpressionEnabled && config.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		MaintenanceWindowFieldName:       config.Name,
+		MaintenanceWindowFieldQuery:      config.Query,
+		MaintenanceWindowFieldTagFilter:  normalizedTagFilterString,
+		MaintenanceWindowFieldPaused:     config.Paused,
+		MaintenanceWindowFieldScheduling: r.mapSchedulingToSchema(config.Scheduling),
+	})
+}
+
+func (r *maintenanceWindowResource) mapSchedulingToSchema(scheduling restapi.MaintenanceScheduling) []interface{} {
+	result := map[string]interface{}{
+		MaintenanceWindowFieldSchedulingStart: int(scheduling.Start),
+		MaintenanceWindowFieldSchedulingDuration: []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingDurationAmount: int(scheduling.Duration.Amount),
+				MaintenanceWindowFieldSchedulingDurationUnit:   string(scheduling.Duration.Unit),
+			},
+		},
+	}
+	if scheduling.Type == restapi.MaintenanceSchedulingTypeRecurrent {
+		recurrent := make(map[string]interface{})
+		if scheduling.RRule != nil {
+			recurrent[MaintenanceWindowFieldSchedulingRecurrentRRule] = *scheduling.RRule
+		}
+		if scheduling.TimezoneID != nil {
+			recurrent[MaintenanceWindowFieldSchedulingRecurrentTimezoneID] = *scheduling.TimezoneID
+		}
+		result[MaintenanceWindowFieldSchedulingRecurrent] = []interface{}{recurrent}
+	}
+	return []interface{}{result}
+}
+
+func (r *maintenanceWindowResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.MaintenanceWindowConfig, error) {
+	var tagFilter *restapi.TagFilter
+	tagFilterStr, ok := d.GetOk(MaintenanceWindowFieldTagFilter)
+	if ok {
+		expr, err := tagfilter.NewParser().Parse(tagFilterStr.(string))
+		if err != nil {
+			return &restapi.MaintenanceWindowConfig{}, err
+		}
+		tagFilter = tagfilter.NewMapper().ToAPIModel(expr)
+	}
+
+	return &restapi.MaintenanceWindowConfig{
+		ID:                         d.Id(),
+		Name:                       d.Get(MaintenanceWindowFieldName).(string),
+		Query:                      d.Get(MaintenanceWindowFieldQuery).(string),
+		Scheduling:                 r.mapSchedulingFromSchema(d),
+		TagFilterExpressionEnabled: tagFilter != nil,
+		TagFilterExpression:        tagFilter,
+		Paused:                     d.Get(MaintenanceWindowFieldPaused).(bool),
+	}, nil
+}
+
+func (r *maintenanceWindowResource) mapSchedulingFromSchema(d *schema.ResourceData) restapi.MaintenanceScheduling {
+	schedulingSlice := d.Get(MaintenanceWindowFieldScheduling).([]interface{})
+	if len(schedulingSlice) != 1 || schedulingSlice[0] == nil {
+		return restapi.MaintenanceScheduling{}
+	}
+	scheduling := schedulingSlice[0].(map[string]interface{})
+	result := restapi.MaintenanceScheduling{
+		Start: int64(scheduling[MaintenanceWindowFieldSchedulingStart].(int)),
+		Type:  restapi.MaintenanceSchedulingTypeOneTime,
+	}
+
+	durationSlice := scheduling[MaintenanceWindowFieldSchedulingDuration].([]interface{})
+	if len(durationSlice) == 1 && durationSlice[0] != nil {
+		duration := durationSlice[0].(map[string]interface{})
+		result.Duration = restapi.MaintenanceDuration{
+			Amount: int64(duration[MaintenanceWindowFieldSchedulingDurationAmount].(int)),
+			Unit:   restapi.MaintenanceDurationUnit(duration[MaintenanceWindowFieldSchedulingDurationUnit].(string)),
+		}
+	}
+
+	recurrentSlice, ok := scheduling[MaintenanceWindowFieldSchedulingRecurrent].([]interface{})
+	if ok && len(recurrentSlice) == 1 && recurrentSlice[0] != nil {
+		recurrent := recurrentSlice[0].(map[string]interface{})
+		result.Type = restapi.MaintenanceSchedulingTypeRecurrent
+		rrule := recurrent[MaintenanceWindowFieldSchedulingRecurrentRRule].(string)
+		result.RRule = &rrule
+		if v, ok := recurrent[MaintenanceWindowFieldSchedulingRecurrentTimezoneID]; ok && len(v.(string)) > 0 {
+			timezoneID := v.(string)
+			result.TimezoneID = &timezoneID
+		}
+	}
+	return result
+}
@@ -0,0 +1,412 @@
+package instana_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaMaintenanceWindow + ".example"
+	inst := &maintenanceWindowTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewMaintenanceWindowResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type maintenanceWindowTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.MaintenanceWindowConfig]
+}
+
+var maintenanceWindowTerraformTemplate = `
+resource "instana_maintenance_window" "example" {
+	name       = "name %d"
+	query      = "entity.type:host AND entity.tag:\"env=prod\""
+	tag_filter = "synthetic.syntheticType@na EQUALS 'HTTPScript'"
+	paused     = true
+
+	scheduling {
+		start = 1683827571245
+		duration {
+			amount = 2
+			unit   = "HOURS"
+		}
+		recurrent {
+			rrule       = "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA;COUNT=10"
+			timezone_id = "America/New_York"
+		}
+	}
+}
+`
+
+var maintenanceWindowServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"query": "entity.type:host AND entity.tag:\"env=prod\"",
+	"scheduling": {
+		"start": 1683827571245,
+		"duration": {
+			"amount": 2,
+			"unit": "HOURS"
+		},
+		"type": "RECURRENT",
+		"rrule": "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA;COUNT=10",
+		"timezoneId": "America/New_York"
+	},
+	"tagFilterExpressionEnabled": true,
+	"tagFilterExpression": {
+		"type": "TAG_FILTER",
+		"name": "synthetic.syntheticType",
+		"stringValue": "HTTPScript",
+		"numberValue": null,
+		"booleanValue": null,
+		"key": null,
+		"value": "HTTPScript",
+		"operator": "EQUALS",
+		"entity": "NOT_APPLICABLE"
+	},
+	"paused": true,
+	"validVersion": 1,
+	"lastUpdated": 1683901553115,
+	"state": "PAUSED"
+}
+`
+
+func (test *maintenanceWindowTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaMaintenanceWindow), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaMaintenanceWindow), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaMaintenanceWindow), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaMaintenanceWindow), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model of recurrent maintenance window", ResourceInstanaMaintenanceWindow), test.createTestShouldUpdateTerraformResourceStateFromModelOfRecurrentMaintenanceWindow())
+	t.Run(fmt.Sprintf("%s should update terraform state from model of one time maintenance window", ResourceInstanaMaintenanceWindow), test.createTestShouldUpdateTerraformResourceStateFromModelOfOneTimeMaintenanceWindow())
+	t.Run(fmt.Sprintf("%s should map terraform state of recurrent maintenance window to model", ResourceInstanaMaintenanceWindow), test.createTestShouldMapTerraformResourceStateOfRecurrentMaintenanceWindowToModel())
+	t.Run(fmt.Sprintf("%s should map terraform state of one time maintenance window to model", ResourceInstanaMaintenanceWindow), test.createTestShouldMapTerraformResourceStateOfOneTimeMaintenanceWindowToModel())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when tag filter expression is invalid", ResourceInstanaMaintenanceWindow), test.createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid())
+	t.Run(fmt.Sprintf("%s should sanity check syntax of dynamic focus query", ResourceInstanaMaintenanceWindow), test.createTestShouldSanityCheckSyntaxOfDynamicFocusQuery())
+	t.Run(fmt.Sprintf("%s should allow paused recurrent maintenance windows", ResourceInstanaMaintenanceWindow), test.createTestShouldAllowPausedRecurrentMaintenanceWindows())
+	t.Run(fmt.Sprintf("%s should reject paused one time maintenance windows", ResourceInstanaMaintenanceWindow), test.createTestShouldRejectPausedOneTimeMaintenanceWindows())
+}
+
+func (test *maintenanceWindowTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		resourceRestAPIPath := restapi.MaintenanceWindowConfigResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			vars := mux.Vars(r)
+			modCount := httpServer.GetCallCount(http.MethodPut, resourceRestAPIPath+"/"+vars["internal-id"])
+			jsonData := fmt.Sprintf(maintenanceWindowServerResponseTemplate, vars["internal-id"], modCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+	}
+}
+
+func (test *maintenanceWindowTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	schedulingStart := fmt.Sprintf("%s.0.%s", MaintenanceWindowFieldScheduling, MaintenanceWindowFieldSchedulingStart)
+	durationAmount := fmt.Sprintf("%s.0.%s.0.%s", MaintenanceWindowFieldScheduling, MaintenanceWindowFieldSchedulingDuration, MaintenanceWindowFieldSchedulingDurationAmount)
+	durationUnit := fmt.Sprintf("%s.0.%s.0.%s", MaintenanceWindowFieldScheduling, MaintenanceWindowFieldSchedulingDuration, MaintenanceWindowFieldSchedulingDurationUnit)
+	recurrentRRule := fmt.Sprintf("%s.0.%s.0.%s", MaintenanceWindowFieldScheduling, MaintenanceWindowFieldSchedulingRecurrent, MaintenanceWindowFieldSchedulingRecurrentRRule)
+	recurrentTimezone := fmt.Sprintf("%s.0.%s.0.%s", MaintenanceWindowFieldScheduling, MaintenanceWindowFieldSchedulingRecurrent, MaintenanceWindowFieldSchedulingRecurrentTimezoneID)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(maintenanceWindowTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(test.terraformResourceInstanceName, "id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MaintenanceWindowFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MaintenanceWindowFieldQuery, "entity.type:host AND entity.tag:\"env=prod\""),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MaintenanceWindowFieldTagFilter, "synthetic.syntheticType@na EQUALS 'HTTPScript'"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MaintenanceWindowFieldPaused, trueAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, schedulingStart, "1683827571245"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, durationAmount, "2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, durationUnit, "HOURS"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, recurrentRRule, "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA;COUNT=10"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, recurrentTimezone, "America/New_York"),
+		),
+	}
+}
+
+func (test *maintenanceWindowTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *maintenanceWindowTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *maintenanceWindowTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_maintenance_window", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *maintenanceWindowTest) createRecurrentMaintenanceWindowConfig() *restapi.MaintenanceWindowConfig {
+	rrule := "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA;COUNT=10"
+	timezoneID := "America/New_York"
+	return &restapi.MaintenanceWindowConfig{
+		ID:    "maintenance-window-id",
+		Name:  "maintenance-window-name",
+		Query: "entity.type:host",
+		Scheduling: restapi.MaintenanceScheduling{
+			Start: 1683827571245,
+			Duration: restapi.MaintenanceDuration{
+				Amount: 2,
+				Unit:   restapi.MaintenanceDurationUnitHours,
+			},
+			Type:       restapi.MaintenanceSchedulingTypeRecurrent,
+			RRule:      &rrule,
+			TimezoneID: &timezoneID,
+		},
+		TagFilterExpressionEnabled: true,
+		TagFilterExpression:        restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "synthetic.syntheticType", restapi.EqualsOperator, "HTTPScript"),
+		Paused:                     true,
+	}
+}
+
+func (test *maintenanceWindowTest) createOneTimeMaintenanceWindowConfig() *restapi.MaintenanceWindowConfig {
+	return &restapi.MaintenanceWindowConfig{
+		ID:    "maintenance-window-id",
+		Name:  "maintenance-window-name",
+		Query: "",
+		Scheduling: restapi.MaintenanceScheduling{
+			Start: 1683827571245,
+			Duration: restapi.MaintenanceDuration{
+				Amount: 30,
+				Unit:   restapi.MaintenanceDurationUnitMinutes,
+			},
+			Type: restapi.MaintenanceSchedulingTypeOneTime,
+		},
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldUpdateTerraformResourceStateFromModelOfRecurrentMaintenanceWindow() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, test.createRecurrentMaintenanceWindowConfig())
+
+		require.NoError(t, err)
+		require.Equal(t, "maintenance-window-id", resourceData.Id())
+		require.Equal(t, "maintenance-window-name", resourceData.Get(MaintenanceWindowFieldName))
+		require.Equal(t, "entity.type:host", resourceData.Get(MaintenanceWindowFieldQuery))
+		require.Equal(t, "synthetic.syntheticType@na EQUALS 'HTTPScript'", resourceData.Get(MaintenanceWindowFieldTagFilter))
+		require.True(t, resourceData.Get(MaintenanceWindowFieldPaused).(bool))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingStart: 1683827571245,
+				MaintenanceWindowFieldSchedulingDuration: []interface{}{
+					map[string]interface{}{
+						MaintenanceWindowFieldSchedulingDurationAmount: 2,
+						MaintenanceWindowFieldSchedulingDurationUnit:   "HOURS",
+					},
+				},
+				MaintenanceWindowFieldSchedulingRecurrent: []interface{}{
+					map[string]interface{}{
+						MaintenanceWindowFieldSchedulingRecurrentRRule:      "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA;COUNT=10",
+						MaintenanceWindowFieldSchedulingRecurrentTimezoneID: "America/New_York",
+					},
+				},
+			},
+		}, resourceData.Get(MaintenanceWindowFieldScheduling))
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldUpdateTerraformResourceStateFromModelOfOneTimeMaintenanceWindow() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, test.createOneTimeMaintenanceWindowConfig())
+
+		require.NoError(t, err)
+		require.Equal(t, "maintenance-window-id", resourceData.Id())
+		require.Equal(t, "", resourceData.Get(MaintenanceWindowFieldQuery))
+		require.Equal(t, "", resourceData.Get(MaintenanceWindowFieldTagFilter))
+		require.False(t, resourceData.Get(MaintenanceWindowFieldPaused).(bool))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingStart: 1683827571245,
+				MaintenanceWindowFieldSchedulingDuration: []interface{}{
+					map[string]interface{}{
+						MaintenanceWindowFieldSchedulingDurationAmount: 30,
+						MaintenanceWindowFieldSchedulingDurationUnit:   "MINUTES",
+					},
+				},
+				MaintenanceWindowFieldSchedulingRecurrent: []interface{}{},
+			},
+		}, resourceData.Get(MaintenanceWindowFieldScheduling))
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldMapTerraformResourceStateOfRecurrentMaintenanceWindowToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("maintenance-window-id")
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldName, "maintenance-window-name")
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldQuery, "entity.type:host")
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldTagFilter, "synthetic.syntheticType@na EQUALS 'HTTPScript'")
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldPaused, true)
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldScheduling, []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingStart: 1683827571245,
+				MaintenanceWindowFieldSchedulingDuration: []interface{}{
+					map[string]interface{}{
+						MaintenanceWindowFieldSchedulingDurationAmount: 2,
+						MaintenanceWindowFieldSchedulingDurationUnit:   "HOURS",
+					},
+				},
+				MaintenanceWindowFieldSchedulingRecurrent: []interface{}{
+					map[string]interface{}{
+						MaintenanceWindowFieldSchedulingRecurrentRRule:      "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA;COUNT=10",
+						MaintenanceWindowFieldSchedulingRecurrentTimezoneID: "America/New_York",
+					},
+				},
+			},
+		})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, test.createRecurrentMaintenanceWindowConfig(), result)
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldMapTerraformResourceStateOfOneTimeMaintenanceWindowToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("maintenance-window-id")
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldName, "maintenance-window-name")
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldScheduling, []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingStart: 1683827571245,
+				MaintenanceWindowFieldSchedulingDuration: []interface{}{
+					map[string]interface{}{
+						MaintenanceWindowFieldSchedulingDurationAmount: 30,
+						MaintenanceWindowFieldSchedulingDurationUnit:   "MINUTES",
+					},
+				},
+			},
+		})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, test.createOneTimeMaintenanceWindowConfig(), result)
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MaintenanceWindowConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldName, "maintenance-window-name")
+		setValueOnResourceData(t, resourceData, MaintenanceWindowFieldTagFilter, "invalid invalid invalid")
+
+		_, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldSanityCheckSyntaxOfDynamicFocusQuery() func(t *testing.T) {
+	return func(t *testing.T) {
+		validateFunc := test.resourceHandle.MetaData().Schema[MaintenanceWindowFieldQuery].ValidateFunc
+
+		for _, query := range []string{"", "entity.type:host", "entity.application.id:\"abc\" AND (entity.zone:\"a\" OR entity.zone:\"b\")", "entity.tag:\"value with \\\" and )\"", "entity.unknown:host AND AND"} {
+			_, errs := validateFunc(query, MaintenanceWindowFieldQuery)
+			require.Empty(t, errs, "expected query %s to be valid", query)
+		}
+		for _, query := range []string{"entity.application.id:\"abc", "(entity.type:host", "entity.type:host)"} {
+			_, errs := validateFunc(query, MaintenanceWindowFieldQuery)
+			require.Len(t, errs, 1, "expected query %s to be invalid", query)
+			require.ErrorContains(t, errs[0], "failed the syntax sanity check of the dynamic focus query")
+		}
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldAllowPausedRecurrentMaintenanceWindows() func(t *testing.T) {
+	return func(t *testing.T) {
+		scheduling := test.createSchedulingConfig()
+		scheduling[MaintenanceWindowFieldSchedulingRecurrent] = []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingRecurrentRRule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA;COUNT=10",
+			},
+		}
+
+		_, err := test.calculateDiff(scheduling, true)
+
+		require.NoError(t, err)
+	}
+}
+
+func (test *maintenanceWindowTest) createTestShouldRejectPausedOneTimeMaintenanceWindows() func(t *testing.T) {
+	return func(t *testing.T) {
+		_, err := test.calculateDiff(test.createSchedulingConfig(), true)
+
+		require.ErrorContains(t, err, "paused is only supported for recurrent maintenance windows")
+
+		_, err = test.calculateDiff(test.createSchedulingConfig(), false)
+
+		require.NoError(t, err)
+	}
+}
+
+func (test *maintenanceWindowTest) createSchedulingConfig() map[string]interface{} {
+	return map[string]interface{}{
+		MaintenanceWindowFieldSchedulingStart: 1683827571245,
+		MaintenanceWindowFieldSchedulingDuration: []interface{}{
+			map[string]interface{}{
+				MaintenanceWindowFieldSchedulingDurationAmount: 2,
+				MaintenanceWindowFieldSchedulingDurationUnit:   "HOURS",
+			},
+		},
+	}
+}
+
+func (test *maintenanceWindowTest) calculateDiff(scheduling map[string]interface{}, paused bool) (*terraform.InstanceDiff, error) {
+	schemaResource := NewTerraformResource(test.resourceHandle).ToSchemaResource()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		MaintenanceWindowFieldName:       "name",
+		MaintenanceWindowFieldPaused:     paused,
+		MaintenanceWindowFieldScheduling: []interface{}{scheduling},
+	})
+	return schemaResource.Diff(context.Background(), &terraform.InstanceState{}, config, nil)
+}
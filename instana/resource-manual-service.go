@@ -0,0 +1,119 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInstanaManualService the name of the terraform-provider-instana resource to manage manual service configurations
+const ResourceInstanaManualService = "instana_manual_service"
+
+const (
+	//ManualServiceFieldTagFilter constant value for the schema field tag_filter
+	ManualServiceFieldTagFilter = "tag_filter"
+	//ManualServiceFieldUnmonitoredServiceName constant value for the schema field unmonitored_service_name
+	ManualServiceFieldUnmonitoredServiceName = "unmonitored_service_name"
+	//ManualServiceFieldExistingServiceID constant value for the schema field existing_service_id
+	ManualServiceFieldExistingServiceID = "existing_service_id"
+	//ManualServiceFieldDescription constant value for the schema field description
+	ManualServiceFieldDescription = "description"
+	//ManualServiceFieldEnabled constant value for the schema field enabled
+	ManualServiceFieldEnabled = "enabled"
+)
+
+var manualServiceTargetKeys = []string{ManualServiceFieldUnmonitoredServiceName, ManualServiceFieldExistingServiceID}
+
+// NewManualServiceResourceHandle creates the resource handle for manual service configurations
+func NewManualServiceResourceHandle() ResourceHandle[*restapi.ManualServiceConfig] {
+	return &manualServiceResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaManualService,
+			Schema: map[string]*schema.Schema{
+				ManualServiceFieldTagFilter: RequiredTagFilterExpressionSchema,
+				ManualServiceFieldUnmonitoredServiceName: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: manualServiceTargetKeys,
+					Description:  "The name of the unmonitored service the matching calls are mapped to",
+				},
+				ManualServiceFieldExistingServiceID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: manualServiceTargetKeys,
+					Description:  "The ID of the existing service the matching calls are mapped to",
+				},
+				ManualServiceFieldDescription: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The description of the manual service configuration",
+				},
+				ManualServiceFieldEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether the manual service configuration is enabled",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type manualServiceResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *manualServiceResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *manualServiceResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *manualServiceResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ManualServiceConfig] {
+	return api.ManualServiceConfigs()
+}
+
+func (r *manualServiceResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *manualServiceResource) UpdateState(d *schema.ResourceData, config *restapi.ManualServiceConfig) error {
+	var normalizedTagFilterString *string
+	var err error
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ManualServiceFieldTagFilter:              normalizedTagFilterString,
+		ManualServiceFieldUnmonitoredServiceName: config.UnmonitoredServiceName,
+		ManualServiceFieldExistingServiceID:      config.ExistingServiceID,
+		ManualServiceFieldDescription:            config.Description,
+		ManualServiceFieldEnabled:                config.Enabled,
+	})
+}
+
+func (r *manualServiceResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ManualServiceConfig, error) {
+	expr, err := tagfilter.NewParser().Parse(d.Get(ManualServiceFieldTagFilter).(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &restapi.ManualServiceConfig{
+		ID:                     d.Id(),
+		Description:            GetStringPointerFromResourceData(d, ManualServiceFieldDescription),
+		Enabled:                d.Get(ManualServiceFieldEnabled).(bool),
+		ExistingServiceID:      GetStringPointerFromResourceData(d, ManualServiceFieldExistingServiceID),
+		TagFilterExpression:    tagfilter.NewMapper().ToAPIModel(expr),
+		UnmonitoredServiceName: GetStringPointerFromResourceData(d, ManualServiceFieldUnmonitoredServiceName),
+	}, nil
+}
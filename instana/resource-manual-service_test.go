@@ -0,0 +1,212 @@
+package instana_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestManualService(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaManualService + ".example"
+	inst := &manualServiceTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewManualServiceResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type manualServiceTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.ManualServiceConfig]
+}
+
+var manualServiceTerraformTemplate = `
+resource "instana_manual_service" "example" {
+	tag_filter               = "call.http.host@na EQUALS 'example.com'"
+	unmonitored_service_name = "service %d"
+	description              = "description"
+	enabled                  = true
+}
+`
+
+var manualServiceServerResponseTemplate = `
+[
+	{
+		"id": "%s",
+		"description": "description",
+		"enabled": true,
+		"unmonitoredServiceName": "service %d",
+		"tagFilterExpression": {
+			"type": "TAG_FILTER",
+			"name": "call.http.host",
+			"stringValue": "example.com",
+			"operator": "EQUALS",
+			"entity": "NOT_APPLICABLE"
+		}
+	}
+]
+`
+
+func (test *manualServiceTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaManualService), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaManualService), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaManualService), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaManualService), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaManualService), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaManualService), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state when tag filter is invalid", ResourceInstanaManualService), test.createTestShouldFailToMapTerraformResourceStateWhenTagFilterIsInvalid())
+}
+
+func (test *manualServiceTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.ManualServiceConfigResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.ManualServiceConfig{}
+			err := json.NewDecoder(r.Body).Decode(config)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				err = r.Write(bytes.NewBufferString("Failed to get request"))
+				if err != nil {
+					fmt.Printf("failed to write response; %s\n", err)
+				}
+			} else {
+				config.ID = id
+				w.Header().Set(contentType, r.Header.Get(contentType))
+				w.WriteHeader(http.StatusOK)
+				err = json.NewEncoder(w).Encode(config)
+				if err != nil {
+					fmt.Printf("failed to encode json; %s\n", err)
+				}
+			}
+		})
+		httpServer.AddRoute(http.MethodPut, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPut, resourceRestAPIPath+"/"+id)
+			jsonData := fmt.Sprintf(manualServiceServerResponseTemplate, id, modCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+			},
+		})
+	}
+}
+
+func (test *manualServiceTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(manualServiceTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", id),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ManualServiceFieldTagFilter, "call.http.host@na EQUALS 'example.com'"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ManualServiceFieldUnmonitoredServiceName, fmt.Sprintf("service %d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ManualServiceFieldDescription, "description"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ManualServiceFieldEnabled, trueAsString),
+		),
+	}
+}
+
+func (test *manualServiceTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *manualServiceTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *manualServiceTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_manual_service", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *manualServiceTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		description := "description"
+		existingServiceID := "service-id"
+		config := &restapi.ManualServiceConfig{
+			ID:                  "manual-service-id",
+			Description:         &description,
+			Enabled:             false,
+			ExistingServiceID:   &existingServiceID,
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "service.name", restapi.EqualsOperator, "test"),
+		}
+
+		testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "manual-service-id", resourceData.Id())
+		require.Equal(t, "service.name@dest EQUALS 'test'", resourceData.Get(ManualServiceFieldTagFilter))
+		require.Equal(t, "service-id", resourceData.Get(ManualServiceFieldExistingServiceID))
+		require.Empty(t, resourceData.Get(ManualServiceFieldUnmonitoredServiceName))
+		require.Equal(t, "description", resourceData.Get(ManualServiceFieldDescription))
+		require.False(t, resourceData.Get(ManualServiceFieldEnabled).(bool))
+	}
+}
+
+func (test *manualServiceTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("manual-service-id")
+		setValueOnResourceData(t, resourceData, ManualServiceFieldTagFilter, "service.name@dest EQUALS 'test'")
+		setValueOnResourceData(t, resourceData, ManualServiceFieldUnmonitoredServiceName, "service")
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		serviceName := "service"
+		require.Equal(t, &restapi.ManualServiceConfig{
+			ID:                     "manual-service-id",
+			Enabled:                true,
+			TagFilterExpression:    restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "service.name", restapi.EqualsOperator, "test"),
+			UnmonitoredServiceName: &serviceName,
+		}, result)
+	}
+}
+
+func (test *manualServiceTest) createTestShouldFailToMapTerraformResourceStateWhenTagFilterIsInvalid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, ManualServiceFieldTagFilter, "invalid invalid invalid")
+		setValueOnResourceData(t, resourceData, ManualServiceFieldUnmonitoredServiceName, "service")
+
+		_, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,615 @@
+package instana_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestMobileAppAlertConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaMobileAppAlertConfig + ".example"
+	inst := &mobileAppAlertConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewMobileAppAlertConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type mobileAppAlertConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.MobileAppAlertConfig]
+}
+
+var mobileAppAlertConfigTerraformTemplate = `
+resource "instana_mobile_app_alert_config" "example" {
+	name              = "name %d"
+	description       = "test-alert-description"
+	severity          = "warning"
+	triggering        = true
+	mobile_app_id     = "mobile-app-id"
+	alert_channel_ids = [ "alert-channel-id-1", "alert-channel-id-2" ]
+	granularity       = 600000
+	tag_filter        = "call.http.status@na EQUALS 404"
+
+	baseline_refresh_trigger = {
+		iteration = "%d"
+	}
+
+	rule {
+		status_code {
+			metric_name = "httpStatusCode"
+			aggregation = "SUM"
+			operator    = "EQUALS"
+			value       = "404"
+		}
+	}
+
+	threshold {
+		static {
+			operator = ">="
+			value    = 5.0
+		}
+	}
+
+	time_threshold {
+		violations_in_sequence {
+			time_window = 600000
+		}
+	}
+
+	custom_payload_field {
+		key    = "test1"
+		value  = "test123"
+	}
+}
+`
+
+var mobileAppAlertConfigServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"description": "test-alert-description",
+	"mobileAppId": "mobile-app-id",
+	"severity": 5,
+	"triggering": true,
+	"tagFilterExpression": {
+		"type": "TAG_FILTER",
+		"name": "call.http.status",
+		"stringValue": null,
+		"numberValue": 404,
+		"booleanValue": null,
+		"key": null,
+		"value": 404,
+		"operator": "EQUALS",
+		"entity": "NOT_APPLICABLE"
+	},
+	"rule": {
+		"alertType": "statusCode",
+		"metricName": "httpStatusCode",
+		"aggregation": "SUM",
+		"operator": "EQUALS",
+		"value": "404"
+	},
+	"threshold": {
+		"type": "staticThreshold",
+		"operator": ">=",
+		"value": 5.0,
+		"lastUpdated": 0
+	},
+	"alertChannelIds": [ "alert-channel-id-1", "alert-channel-id-2" ],
+	"granularity": 600000,
+	"timeThreshold": {
+		"type": "violationsInSequence",
+		"timeWindow": 600000
+	},
+	"customPayloadFields": [
+		{
+			"type": "staticString",
+			"key": "test1",
+			"value": "test123"
+		}
+	],
+	"created": 1647679325301,
+	"readOnly": false,
+	"enabled": true
+}
+`
+
+func (test *mobileAppAlertConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaMobileAppAlertConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaMobileAppAlertConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaMobileAppAlertConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaMobileAppAlertConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaMobileAppAlertConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should update terraform state from model with custom event rule and user impact time threshold", ResourceInstanaMobileAppAlertConfig), test.createTestShouldUpdateTerraformResourceStateFromModelWithCustomEventRuleAndUserImpactTimeThreshold())
+	t.Run(fmt.Sprintf("%s should fail to update state from model when severity is invalid", ResourceInstanaMobileAppAlertConfig), test.createTestShouldFailToUpdateTerraformResourceStateFromModelWhenSeverityIsNotValid())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaMobileAppAlertConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when severity is invalid", ResourceInstanaMobileAppAlertConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid())
+	t.Run(fmt.Sprintf("%s should fail to map state to model when tag filter expression is invalid", ResourceInstanaMobileAppAlertConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid())
+	t.Run(fmt.Sprintf("%s should update baseline after update when baseline refresh trigger changed", ResourceInstanaMobileAppAlertConfig), test.createTestShouldUpdateBaselineWhenBaselineRefreshTriggerChanged())
+	t.Run(fmt.Sprintf("%s should not update baseline after update when baseline refresh trigger did not change", ResourceInstanaMobileAppAlertConfig), test.createTestShouldNotUpdateBaselineWhenBaselineRefreshTriggerDidNotChange())
+	t.Run(fmt.Sprintf("%s should return error when baseline update fails", ResourceInstanaMobileAppAlertConfig), test.createTestShouldReturnErrorWhenBaselineUpdateFails())
+}
+
+func (test *mobileAppAlertConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.MobileAppAlertConfigResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.MobileAppAlertConfig{}
+			err := json.NewDecoder(r.Body).Decode(config)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				err = r.Write(bytes.NewBufferString("Failed to get request"))
+				if err != nil {
+					fmt.Printf("failed to write response; %s\n", err)
+				}
+			} else {
+				config.ID = id
+				w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+				w.WriteHeader(http.StatusOK)
+				err = json.NewEncoder(w).Encode(config)
+				if err != nil {
+					fmt.Printf("failed to encode json; %s\n", err)
+				}
+			}
+		})
+		httpServer.AddRoute(http.MethodPost, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodPost, resourceInstanceRestAPIPath+"/update-baseline", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPost, resourceRestAPIPath+"/"+id)
+			jsonData := fmt.Sprintf(mobileAppAlertConfigServerResponseTemplate, id, modCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id, ResourceFieldBaselineRefreshTrigger),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id, ResourceFieldBaselineRefreshTrigger),
+			},
+		})
+		require.Equal(t, 1, httpServer.GetCallCount(http.MethodPost, resourceRestAPIPath+"/"+id+"/update-baseline"))
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	ruleMetricName := fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldRule, MobileAppAlertConfigFieldRuleStatusCode, MobileAppAlertConfigFieldRuleMetricName)
+	ruleAggregation := fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldRule, MobileAppAlertConfigFieldRuleStatusCode, MobileAppAlertConfigFieldRuleAggregation)
+	ruleOperator := fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldRule, MobileAppAlertConfigFieldRuleStatusCode, MobileAppAlertConfigFieldRuleOperator)
+	ruleValue := fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldRule, MobileAppAlertConfigFieldRuleStatusCode, MobileAppAlertConfigFieldRuleValue)
+	thresholdStaticOperator := fmt.Sprintf("%s.0.%s.0.%s", ResourceFieldThreshold, ResourceFieldThresholdStatic, ResourceFieldThresholdOperator)
+	thresholdStaticValue := fmt.Sprintf("%s.0.%s.0.%s", ResourceFieldThreshold, ResourceFieldThresholdStatic, ResourceFieldThresholdStaticValue)
+	timeThresholdTimeWindow := fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldTimeThreshold, MobileAppAlertConfigFieldTimeThresholdViolationsInSequence, MobileAppAlertConfigFieldTimeThresholdTimeWindow)
+	customPayloadFieldStaticKey := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldKey)
+	customPayloadFieldStaticValue := fmt.Sprintf("%s.0.%s", DefaultCustomPayloadFieldsName, CustomPayloadFieldsFieldStaticStringValue)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(mobileAppAlertConfigTerraformTemplate, iteration, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", id),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldDescription, "test-alert-description"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldMobileAppID, "mobile-app-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldTriggering, trueAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldAlertChannelIDs+".1", "alert-channel-id-2"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldGranularity, "600000"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppAlertConfigFieldTagFilter, "call.http.status@na EQUALS 404"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ResourceFieldBaselineRefreshTrigger+".iteration", fmt.Sprintf("%d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleMetricName, "httpStatusCode"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleAggregation, "SUM"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleOperator, "EQUALS"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ruleValue, "404"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, thresholdStaticOperator, ">="),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, thresholdStaticValue, "5"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, timeThresholdTimeWindow, "600000"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticKey, "test1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, customPayloadFieldStaticValue, "test123"),
+		),
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_mobile_app_alert_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		aggregation := restapi.SumAggregation
+		operator := restapi.EqualsOperator
+		value := "404"
+		thresholdValue := 5.0
+		timeWindow := int64(600000)
+		violations := int32(3)
+		config := restapi.MobileAppAlertConfig{
+			ID:              "mobile-app-alert-config-id",
+			Name:            "mobile-app-alert-config-name",
+			Description:     "mobile-app-alert-config-description",
+			Severity:        restapi.SeverityCritical.GetAPIRepresentation(),
+			Triggering:      true,
+			MobileAppID:     "mobile-app-id",
+			AlertChannelIDs: []string{"channel-1", "channel-2"},
+			Granularity:     restapi.Granularity600000,
+			CustomerPayloadFields: []restapi.CustomPayloadField[any]{
+				{
+					Type:  restapi.StaticStringCustomPayloadType,
+					Key:   "static-key",
+					Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+				},
+			},
+			Rule: restapi.MobileAppAlertRule{
+				AlertType:   "statusCode",
+				MetricName:  "httpStatusCode",
+				Aggregation: &aggregation,
+				Operator:    &operator,
+				Value:       &value,
+			},
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "call.http.status", restapi.EqualsOperator, "test"),
+			Threshold: restapi.Threshold{
+				Type:     "staticThreshold",
+				Operator: restapi.ThresholdOperatorGreaterThanOrEqual,
+				Value:    &thresholdValue,
+			},
+			TimeThreshold: restapi.MobileAppTimeThreshold{
+				Type:       "violationsInPeriod",
+				TimeWindow: &timeWindow,
+				Violations: &violations,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.NoError(t, err)
+		require.Equal(t, "mobile-app-alert-config-id", resourceData.Id())
+		require.Equal(t, "mobile-app-alert-config-name", resourceData.Get(MobileAppAlertConfigFieldName))
+		require.Equal(t, "mobile-app-alert-config-description", resourceData.Get(MobileAppAlertConfigFieldDescription))
+		require.Equal(t, restapi.SeverityCritical.GetTerraformRepresentation(), resourceData.Get(MobileAppAlertConfigFieldSeverity))
+		require.True(t, resourceData.Get(MobileAppAlertConfigFieldTriggering).(bool))
+		require.Equal(t, "mobile-app-id", resourceData.Get(MobileAppAlertConfigFieldMobileAppID))
+		require.Equal(t, []interface{}{"channel-2", "channel-1"}, (resourceData.Get(MobileAppAlertConfigFieldAlertChannelIDs).(*schema.Set)).List())
+		require.Equal(t, int(restapi.Granularity600000), resourceData.Get(MobileAppAlertConfigFieldGranularity))
+		require.Equal(t, "call.http.status@na EQUALS 'test'", resourceData.Get(MobileAppAlertConfigFieldTagFilter))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				MobileAppAlertConfigFieldRuleCustomEvent: []interface{}{},
+				MobileAppAlertConfigFieldRuleStatusCode: []interface{}{
+					map[string]interface{}{
+						MobileAppAlertConfigFieldRuleMetricName:  "httpStatusCode",
+						MobileAppAlertConfigFieldRuleAggregation: string(aggregation),
+						MobileAppAlertConfigFieldRuleOperator:    string(operator),
+						MobileAppAlertConfigFieldRuleValue:       value,
+					},
+				},
+				MobileAppAlertConfigFieldRuleThroughput: []interface{}{},
+			},
+		}, resourceData.Get(MobileAppAlertConfigFieldRule))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence: []interface{}{},
+				MobileAppAlertConfigFieldTimeThresholdViolationsInPeriod: []interface{}{
+					map[string]interface{}{
+						MobileAppAlertConfigFieldTimeThresholdTimeWindow:                   int(timeWindow),
+						MobileAppAlertConfigFieldTimeThresholdViolationsInPeriodViolations: int(violations),
+					},
+				},
+				MobileAppAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{},
+			},
+		}, resourceData.Get(MobileAppAlertConfigFieldTimeThreshold))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+			},
+		}, resourceData.Get(DefaultCustomPayloadFieldsName).(*schema.Set).List())
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldUpdateTerraformResourceStateFromModelWithCustomEventRuleAndUserImpactTimeThreshold() func(t *testing.T) {
+	return func(t *testing.T) {
+		customEventName := "custom-event"
+		thresholdValue := 5.0
+		impactMeasurementMethod := restapi.WebsiteImpactMeasurementMethodPerWindow
+		userPercentage := 0.5
+		users := int32(10)
+		config := restapi.MobileAppAlertConfig{
+			ID:          "mobile-app-alert-config-id",
+			Name:        "mobile-app-alert-config-name",
+			Description: "mobile-app-alert-config-description",
+			Severity:    restapi.SeverityWarning.GetAPIRepresentation(),
+			MobileAppID: "mobile-app-id",
+			Granularity: restapi.Granularity600000,
+			Rule: restapi.MobileAppAlertRule{
+				AlertType:       "customEvent",
+				MetricName:      "customEvents",
+				CustomEventName: &customEventName,
+			},
+			Threshold: restapi.Threshold{
+				Type:     "staticThreshold",
+				Operator: restapi.ThresholdOperatorGreaterThanOrEqual,
+				Value:    &thresholdValue,
+			},
+			TimeThreshold: restapi.MobileAppTimeThreshold{
+				Type:                    "userImpactOfViolationsInSequence",
+				ImpactMeasurementMethod: &impactMeasurementMethod,
+				UserPercentage:          &userPercentage,
+				Users:                   &users,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.NoError(t, err)
+		require.Equal(t, "customEvents", resourceData.Get(fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldRule, MobileAppAlertConfigFieldRuleCustomEvent, MobileAppAlertConfigFieldRuleMetricName)))
+		require.Equal(t, customEventName, resourceData.Get(fmt.Sprintf("%s.0.%s.0.%s", MobileAppAlertConfigFieldRule, MobileAppAlertConfigFieldRuleCustomEvent, MobileAppAlertConfigFieldRuleCustomEventName)))
+		timeThresholdPrefix := fmt.Sprintf("%s.0.%s.0.", MobileAppAlertConfigFieldTimeThreshold, MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequence)
+		require.Equal(t, string(impactMeasurementMethod), resourceData.Get(timeThresholdPrefix+MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceImpactMeasurementMethod))
+		require.Equal(t, userPercentage, resourceData.Get(timeThresholdPrefix+MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUserPercentage))
+		require.Equal(t, int(users), resourceData.Get(timeThresholdPrefix+MobileAppAlertConfigFieldTimeThresholdUserImpactOfViolationsInSequenceUsers))
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldFailToUpdateTerraformResourceStateFromModelWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := restapi.MobileAppAlertConfig{
+			Name:     "test",
+			Severity: -1,
+		}
+
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+		err := sut.UpdateState(resourceData, &config)
+
+		require.Error(t, err)
+		require.Equal(t, "-1 is not a valid severity", err.Error())
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		aggregation := restapi.SumAggregation
+		operator := restapi.EqualsOperator
+		value := "404"
+		thresholdValue := 5.0
+		thresholdLastUpdated := int64(0)
+		timeWindow := int64(600000)
+		expected := &restapi.MobileAppAlertConfig{
+			ID:              "mobile-app-alert-config-id",
+			Name:            "mobile-app-alert-config-name",
+			Description:     "mobile-app-alert-config-description",
+			Severity:        restapi.SeverityCritical.GetAPIRepresentation(),
+			Triggering:      true,
+			MobileAppID:     "mobile-app-id",
+			AlertChannelIDs: []string{"channel-2", "channel-1"},
+			Granularity:     restapi.Granularity600000,
+			CustomerPayloadFields: []restapi.CustomPayloadField[any]{
+				{
+					Type:  restapi.StaticStringCustomPayloadType,
+					Key:   "static-key",
+					Value: restapi.StaticStringCustomPayloadFieldValue("static-value"),
+				},
+			},
+			Rule: restapi.MobileAppAlertRule{
+				AlertType:   "statusCode",
+				MetricName:  "httpStatusCode",
+				Aggregation: &aggregation,
+				Operator:    &operator,
+				Value:       &value,
+			},
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "call.http.status", restapi.EqualsOperator, "test"),
+			Threshold: restapi.Threshold{
+				Type:        "staticThreshold",
+				Operator:    restapi.ThresholdOperatorGreaterThanOrEqual,
+				LastUpdated: &thresholdLastUpdated,
+				Value:       &thresholdValue,
+			},
+			TimeThreshold: restapi.MobileAppTimeThreshold{
+				Type:       "violationsInSequence",
+				TimeWindow: &timeWindow,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		resourceData.SetId("mobile-app-alert-config-id")
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldName, "mobile-app-alert-config-name")
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldDescription, "mobile-app-alert-config-description")
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldSeverity, restapi.SeverityCritical.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTriggering, true)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldMobileAppID, "mobile-app-id")
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldAlertChannelIDs, []interface{}{"channel-2", "channel-1"})
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldGranularity, restapi.Granularity600000)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTagFilter, "call.http.status@na EQUALS 'test'")
+		setValueOnResourceData(t, resourceData, DefaultCustomPayloadFieldsName, []interface{}{
+			map[string]interface{}{
+				CustomPayloadFieldsFieldKey:               "static-key",
+				CustomPayloadFieldsFieldStaticStringValue: "static-value",
+				CustomPayloadFieldsFieldDynamicValue:      []interface{}{},
+			},
+		})
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldRule, []interface{}{
+			map[string]interface{}{
+				MobileAppAlertConfigFieldRuleStatusCode: []interface{}{
+					map[string]interface{}{
+						MobileAppAlertConfigFieldRuleMetricName:  "httpStatusCode",
+						MobileAppAlertConfigFieldRuleAggregation: string(aggregation),
+						MobileAppAlertConfigFieldRuleOperator:    string(operator),
+						MobileAppAlertConfigFieldRuleValue:       value,
+					},
+				},
+			},
+		})
+		setValueOnResourceData(t, resourceData, ResourceFieldThreshold, []interface{}{
+			map[string]interface{}{
+				ResourceFieldThresholdStatic: []interface{}{
+					map[string]interface{}{
+						ResourceFieldThresholdOperator:    string(restapi.ThresholdOperatorGreaterThanOrEqual),
+						ResourceFieldThresholdStaticValue: thresholdValue,
+					},
+				},
+			},
+		})
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTimeThreshold, []interface{}{
+			map[string]interface{}{
+				MobileAppAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+					map[string]interface{}{
+						MobileAppAlertConfigFieldTimeThresholdTimeWindow: int(timeWindow),
+					},
+				},
+			},
+		})
+
+		result, err := sut.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldName, "mobile-app-alert-config-name")
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldSeverity, "invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Equal(t, "invalid is not a valid severity", err.Error())
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		sut := test.resourceHandle
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldName, "mobile-app-alert-config-name")
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation())
+		setValueOnResourceData(t, resourceData, MobileAppAlertConfigFieldTagFilter, "invalid invalid invalid")
+
+		_, err := sut.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unexpected token")
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldUpdateBaselineWhenBaselineRefreshTriggerChanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			resourceData := testHelper.CreateResourceDataForResourceHandle(test.resourceHandle, map[string]interface{}{
+				ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "value"},
+			})
+			updatedConfig := &restapi.MobileAppAlertConfig{ID: "test-id", Name: "updated"}
+			refreshedConfig := &restapi.MobileAppAlertConfig{ID: "test-id", Name: "refreshed"}
+			mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.MobileAppAlertConfig](ctrl)
+
+			mockInstanaAPI.EXPECT().MobileAppAlertConfigs().Return(mockRestResource).Times(1)
+			mockRestResource.EXPECT().UpdateBaseline("test-id").Return(nil).Times(1)
+			mockRestResource.EXPECT().GetOne("test-id").Return(refreshedConfig, nil).Times(1)
+
+			sut := test.resourceHandle.(PostUpdateHook[*restapi.MobileAppAlertConfig])
+			result, err := sut.PostUpdate(resourceData, providerMeta.InstanaAPI, updatedConfig)
+
+			require.NoError(t, err)
+			require.Equal(t, refreshedConfig, result)
+		})
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldNotUpdateBaselineWhenBaselineRefreshTriggerDidNotChange() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+			updatedConfig := &restapi.MobileAppAlertConfig{ID: "test-id", Name: "updated"}
+
+			sut := test.resourceHandle.(PostUpdateHook[*restapi.MobileAppAlertConfig])
+			result, err := sut.PostUpdate(resourceData, providerMeta.InstanaAPI, updatedConfig)
+
+			require.NoError(t, err)
+			require.Equal(t, updatedConfig, result)
+		})
+	}
+}
+
+func (test *mobileAppAlertConfigTest) createTestShouldReturnErrorWhenBaselineUpdateFails() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			resourceData := testHelper.CreateResourceDataForResourceHandle(test.resourceHandle, map[string]interface{}{
+				ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "value"},
+			})
+			updatedConfig := &restapi.MobileAppAlertConfig{ID: "test-id", Name: "updated"}
+			expectedError := errors.New("test")
+			mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.MobileAppAlertConfig](ctrl)
+
+			mockInstanaAPI.EXPECT().MobileAppAlertConfigs().Return(mockRestResource).Times(1)
+			mockRestResource.EXPECT().UpdateBaseline("test-id").Return(expectedError).Times(1)
+
+			sut := test.resourceHandle.(PostUpdateHook[*restapi.MobileAppAlertConfig])
+			_, err := sut.PostUpdate(resourceData, providerMeta.InstanaAPI, updatedConfig)
+
+			require.Error(t, err)
+			require.Equal(t, expectedError, err)
+		})
+	}
+}
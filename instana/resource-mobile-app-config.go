@@ -0,0 +1,73 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaMobileAppConfig the name of the terraform-provider-instana resource to manage mobile app monitoring configurations
+const ResourceInstanaMobileAppConfig = "instana_mobile_app_config"
+
+const (
+	//MobileAppConfigFieldName constant value for the schema field name
+	MobileAppConfigFieldName = "name"
+)
+
+// NewMobileAppConfigResourceHandle creates the resource handle for mobile app monitoring configurations
+func NewMobileAppConfigResourceHandle() ResourceHandle[*restapi.MobileAppConfig] {
+	return &mobileAppConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaMobileAppConfig,
+			Schema: map[string]*schema.Schema{
+				MobileAppConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+					Description:  "The name of the mobile app monitoring configuration",
+				},
+				MonitoringPrivacySettingsFieldGeoLocation:     monitoringPrivacySettingsSchemaGeoLocation,
+				MonitoringPrivacySettingsFieldIPMasking:       monitoringPrivacySettingsSchemaIPMasking,
+				MonitoringPrivacySettingsFieldGeoMappingRules: monitoringPrivacySettingsSchemaGeoMappingRules,
+			},
+			SchemaVersion: 0,
+		},
+	}
+}
+
+type mobileAppConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *mobileAppConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *mobileAppConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *mobileAppConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.MobileAppConfig] {
+	return api.MobileAppConfigs()
+}
+
+func (r *mobileAppConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *mobileAppConfigResource) UpdateState(d *schema.ResourceData, config *restapi.MobileAppConfig) error {
+	data := mapMonitoringPrivacySettingsToState(config.MonitoringPrivacySettings)
+	data[MobileAppConfigFieldName] = config.Name
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *mobileAppConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.MobileAppConfig, error) {
+	return &restapi.MobileAppConfig{
+		ID:                        d.Id(),
+		Name:                      d.Get(MobileAppConfigFieldName).(string),
+		MonitoringPrivacySettings: mapMonitoringPrivacySettingsFromState(d),
+	}, nil
+}
@@ -0,0 +1,266 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestMobileAppConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaMobileAppConfig + ".example"
+	inst := &mobileAppConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewMobileAppConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type mobileAppConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.MobileAppConfig]
+}
+
+var mobileAppConfigTerraformTemplate = `
+resource "instana_mobile_app_config" "example" {
+	name = "name %d"
+
+	geo_location {
+		geo_detail_removal = "REMOVE_CITY"
+	}
+
+	ip_masking {
+		mode = "STRICT"
+	}
+
+	geo_mapping_rules {
+		csv = "10.%d.0.0/16,Europe,Germany"
+	}
+}
+`
+
+func (test *mobileAppConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaMobileAppConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaMobileAppConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaMobileAppConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaMobileAppConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaMobileAppConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaMobileAppConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model without privacy settings", ResourceInstanaMobileAppConfig), test.createTestShouldMapTerraformResourceStateWithoutPrivacySettingsToModel())
+	t.Run(fmt.Sprintf("%s should suppress formatting differences of geo mapping rules", ResourceInstanaMobileAppConfig), test.createTestShouldSuppressFormattingDifferencesOfGeoMappingRules())
+}
+
+func (test *mobileAppConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		server := &mobileAppConfigTestServer{httpServer: testutils.NewTestHTTPServer()}
+		server.start()
+		defer server.httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(server.httpServer.GetPort(), 0),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(server.httpServer.GetPort(), 1),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+	}
+}
+
+func (test *mobileAppConfigTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(mobileAppConfigTerraformTemplate, iteration, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(test.terraformResourceInstanceName, "id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, MobileAppConfigFieldName, fmt.Sprintf("name %d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.0.%s", MonitoringPrivacySettingsFieldGeoLocation, MonitoringPrivacySettingsFieldGeoDetailRemoval), "REMOVE_CITY"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.0.%s", MonitoringPrivacySettingsFieldIPMasking, MonitoringPrivacySettingsFieldIPMaskingMode), "STRICT"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.0.%s", MonitoringPrivacySettingsFieldGeoMappingRules, MonitoringPrivacySettingsFieldGeoMappingRulesCSV), fmt.Sprintf("10.%d.0.0/16,Europe,Germany", iteration)),
+		),
+	}
+}
+
+type mobileAppConfigTestServer struct {
+	httpServer      testutils.TestHTTPServer
+	app             *restapi.MobileAppConfig
+	geoLocation     string
+	ipMasking       string
+	geoMappingRules string
+}
+
+func (s *mobileAppConfigTestServer) start() {
+	instancePath := restapi.MobileAppConfigResourcePath + "/{id}"
+	s.geoLocation = `{"geoDetailRemoval":"NO_REMOVAL"}`
+	s.ipMasking = `{"ipMasking":"DEFAULT"}`
+	s.httpServer.AddRoute(http.MethodPost, restapi.MobileAppConfigResourcePath, s.onPostOrPut)
+	s.httpServer.AddRoute(http.MethodPut, instancePath, s.onPostOrPut)
+	s.httpServer.AddRoute(http.MethodDelete, instancePath, testutils.EchoHandlerFunc)
+	s.httpServer.AddRoute(http.MethodGet, restapi.MobileAppConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		apps := make([]*restapi.MobileAppConfig, 0)
+		if s.app != nil {
+			apps = append(apps, s.app)
+		}
+		s.writeJSON(w, apps)
+	})
+	s.httpServer.AddRoute(http.MethodPut, instancePath+"/geo-location", func(w http.ResponseWriter, r *http.Request) {
+		s.geoLocation = s.readBody(r)
+		s.writeRaw(w, s.geoLocation)
+	})
+	s.httpServer.AddRoute(http.MethodGet, instancePath+"/geo-location", func(w http.ResponseWriter, r *http.Request) {
+		s.writeRaw(w, s.geoLocation)
+	})
+	s.httpServer.AddRoute(http.MethodPut, instancePath+"/ip-masking", func(w http.ResponseWriter, r *http.Request) {
+		s.ipMasking = s.readBody(r)
+		s.writeRaw(w, s.ipMasking)
+	})
+	s.httpServer.AddRoute(http.MethodGet, instancePath+"/ip-masking", func(w http.ResponseWriter, r *http.Request) {
+		s.writeRaw(w, s.ipMasking)
+	})
+	s.httpServer.AddRoute(http.MethodPut, instancePath+"/geo-mapping-rules", func(w http.ResponseWriter, r *http.Request) {
+		s.geoMappingRules = s.readBody(r)
+		s.writeRaw(w, s.geoMappingRules)
+	})
+	s.httpServer.AddRoute(http.MethodGet, instancePath+"/geo-mapping-rules", func(w http.ResponseWriter, r *http.Request) {
+		s.writeRaw(w, s.geoMappingRules+"\n")
+	})
+	s.httpServer.Start()
+}
+
+func (s *mobileAppConfigTestServer) onPostOrPut(w http.ResponseWriter, r *http.Request) {
+	id, ok := mux.Vars(r)["id"]
+	if !ok {
+		id = RandomID()
+	}
+	s.app = &restapi.MobileAppConfig{ID: id, Name: r.URL.Query().Get("name")}
+	s.writeJSON(w, s.app)
+}
+
+func (s *mobileAppConfigTestServer) readBody(r *http.Request) string {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		fmt.Printf("failed to read request body; %s\n", err)
+	}
+	return string(data)
+}
+
+func (s *mobileAppConfigTestServer) writeJSON(w http.ResponseWriter, data interface{}) {
+	w.Header().Set(contentType, "application/json")
+	w.WriteHeader(http.StatusOK)
+	err := json.NewEncoder(w).Encode(data)
+	if err != nil {
+		fmt.Printf("failed to encode json; %s\n", err)
+	}
+}
+
+func (s *mobileAppConfigTestServer) writeRaw(w http.ResponseWriter, data string) {
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write([]byte(data))
+	if err != nil {
+		fmt.Printf("failed to write response; %s\n", err)
+	}
+}
+
+func (test *mobileAppConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *mobileAppConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *mobileAppConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_mobile_app_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *mobileAppConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		geoMappingRules := "10.0.0.0/8,Europe,Germany"
+		config := &restapi.MobileAppConfig{
+			ID:   "mobile-app-id",
+			Name: "name",
+			MonitoringPrivacySettings: restapi.MonitoringPrivacySettings{
+				GeoLocation:     &restapi.GeoLocationConfiguration{GeoDetailRemoval: restapi.GeoDetailRemovalRemoveAll},
+				IPMasking:       &restapi.IPMaskingConfiguration{IPMasking: restapi.IPMaskingRemoveAllDetails},
+				GeoMappingRules: &geoMappingRules,
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.MobileAppConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "mobile-app-id", resourceData.Id())
+		require.Equal(t, "name", resourceData.Get(MobileAppConfigFieldName))
+		require.Equal(t, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldGeoDetailRemoval: "REMOVE_ALL"}}, resourceData.Get(MonitoringPrivacySettingsFieldGeoLocation))
+		require.Equal(t, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldIPMaskingMode: "REMOVE_ALL_DETAILS"}}, resourceData.Get(MonitoringPrivacySettingsFieldIPMasking))
+		require.Equal(t, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldGeoMappingRulesCSV: geoMappingRules}}, resourceData.Get(MonitoringPrivacySettingsFieldGeoMappingRules))
+	}
+}
+
+func (test *mobileAppConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("mobile-app-id")
+		setValueOnResourceData(t, resourceData, MobileAppConfigFieldName, "name")
+		setValueOnResourceData(t, resourceData, MonitoringPrivacySettingsFieldGeoLocation, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldGeoDetailRemoval: "REMOVE_COORDINATES"}})
+		setValueOnResourceData(t, resourceData, MonitoringPrivacySettingsFieldIPMasking, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldIPMaskingMode: "STRICT"}})
+		setValueOnResourceData(t, resourceData, MonitoringPrivacySettingsFieldGeoMappingRules, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldGeoMappingRulesCSV: "csv"}})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		geoMappingRules := "csv"
+		require.Equal(t, &restapi.MobileAppConfig{
+			ID:   "mobile-app-id",
+			Name: "name",
+			MonitoringPrivacySettings: restapi.MonitoringPrivacySettings{
+				GeoLocation:     &restapi.GeoLocationConfiguration{GeoDetailRemoval: restapi.GeoDetailRemovalRemoveCoordinates},
+				IPMasking:       &restapi.IPMaskingConfiguration{IPMasking: restapi.IPMaskingStrict},
+				GeoMappingRules: &geoMappingRules,
+			},
+		}, result)
+	}
+}
+
+func (test *mobileAppConfigTest) createTestShouldMapTerraformResourceStateWithoutPrivacySettingsToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.MobileAppConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("mobile-app-id")
+		setValueOnResourceData(t, resourceData, MobileAppConfigFieldName, "name")
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.MobileAppConfig{ID: "mobile-app-id", Name: "name"}, result)
+	}
+}
+
+func (test *mobileAppConfigTest) createTestShouldSuppressFormattingDifferencesOfGeoMappingRules() func(t *testing.T) {
+	return func(t *testing.T) {
+		csvSchema := test.resourceHandle.MetaData().Schema[MonitoringPrivacySettingsFieldGeoMappingRules].Elem.(*schema.Resource).Schema[MonitoringPrivacySettingsFieldGeoMappingRulesCSV]
+
+		require.True(t, csvSchema.DiffSuppressFunc("", "a,b\r\nc,d\n", "a,b\nc,d", nil))
+		require.False(t, csvSchema.DiffSuppressFunc("", "a,b\nc,d", "a,b\nc,e", nil))
+	}
+}
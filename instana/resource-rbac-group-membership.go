@@ -0,0 +1,88 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaGroupMembership the name of the terraform-provider-instana resource to manage the membership of a single user in a group for role based access control
+const ResourceInstanaGroupMembership = "instana_rbac_group_membership"
+
+const (
+	//GroupMembershipFieldGroupID constant value for the schema field group_id
+	GroupMembershipFieldGroupID = "group_id"
+	//GroupMembershipFieldUserID constant value for the schema field user_id
+	GroupMembershipFieldUserID = "user_id"
+	//GroupMembershipFieldEmail constant value for the schema field email
+	GroupMembershipFieldEmail = "email"
+)
+
+// NewGroupMembershipResourceHandle creates the resource handle for the membership of a single user in an RBAC group
+func NewGroupMembershipResourceHandle() ResourceHandle[*restapi.GroupMembership] {
+	return &groupMembershipResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGroupMembership,
+			Schema: map[string]*schema.Schema{
+				GroupMembershipFieldGroupID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the RBAC group",
+				},
+				GroupMembershipFieldUserID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the user which is member of the group",
+				},
+				GroupMembershipFieldEmail: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The email address of the user",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type groupMembershipResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *groupMembershipResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *groupMembershipResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *groupMembershipResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.GroupMembership] {
+	return api.GroupMemberships()
+}
+
+func (r *groupMembershipResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *groupMembershipResource) UpdateState(d *schema.ResourceData, membership *restapi.GroupMembership) error {
+	d.SetId(membership.GetIDForResourcePath())
+	return tfutils.UpdateState(d, map[string]interface{}{
+		GroupMembershipFieldGroupID: membership.GroupID,
+		GroupMembershipFieldUserID:  membership.UserID,
+		GroupMembershipFieldEmail:   membership.Email,
+	})
+}
+
+func (r *groupMembershipResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.GroupMembership, error) {
+	return &restapi.GroupMembership{
+		GroupID: d.Get(GroupMembershipFieldGroupID).(string),
+		UserID:  d.Get(GroupMembershipFieldUserID).(string),
+	}, nil
+}
@@ -0,0 +1,182 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestGroupMembership(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaGroupMembership + ".example"
+	inst := &groupMembershipTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewGroupMembershipResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type groupMembershipTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.GroupMembership]
+}
+
+var groupMembershipTerraformTemplate = `
+resource "instana_rbac_group_membership" "example" {
+	group_id = "group-id"
+	user_id  = "user-%d"
+}
+`
+
+func (test *groupMembershipTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaGroupMembership), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaGroupMembership), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaGroupMembership), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaGroupMembership), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should not provide update operation", ResourceInstanaGroupMembership), test.createTestResourceShouldNotProvideUpdateOperation())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaGroupMembership), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaGroupMembership), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *groupMembershipTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		group := &restapi.Group{ID: "group-id", Name: "group", Members: []restapi.APIMember{{UserID: "other-user"}}}
+
+		groupPath := restapi.GroupsResourcePath + "/{id}"
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, groupPath+"/users", func(w http.ResponseWriter, r *http.Request) {
+			userIDs := make([]string, 0)
+			err := json.NewDecoder(r.Body).Decode(&userIDs)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mutex.Lock()
+			for _, userID := range userIDs {
+				email := userID + "@example.com"
+				group.Members = append(group.Members, restapi.APIMember{UserID: userID, Email: &email})
+			}
+			mutex.Unlock()
+			test.writeGroup(w, r, &mutex, group)
+		})
+		httpServer.AddRoute(http.MethodDelete, groupPath+"/user/{userId}", func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			members := make([]restapi.APIMember, 0)
+			for _, m := range group.Members {
+				if m.UserID != mux.Vars(r)["userId"] {
+					members = append(members, m)
+				}
+			}
+			group.Members = members
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, groupPath, func(w http.ResponseWriter, r *http.Request) {
+			test.writeGroup(w, r, &mutex, group)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+
+		require.Equal(t, []restapi.APIMember{{UserID: "other-user"}}, group.Members)
+	}
+}
+
+func (test *groupMembershipTest) writeGroup(w http.ResponseWriter, r *http.Request, mutex *sync.Mutex, group *restapi.Group) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	w.Header().Set(contentType, r.Header.Get(contentType))
+	w.WriteHeader(http.StatusOK)
+	err := json.NewEncoder(w).Encode(group)
+	if err != nil {
+		fmt.Printf("failed to encode json; %s\n", err)
+	}
+}
+
+func (test *groupMembershipTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(groupMembershipTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", fmt.Sprintf("group-id/user-%d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GroupMembershipFieldGroupID, "group-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GroupMembershipFieldUserID, fmt.Sprintf("user-%d", iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GroupMembershipFieldEmail, fmt.Sprintf("user-%d@example.com", iteration)),
+		),
+	}
+}
+
+func (test *groupMembershipTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *groupMembershipTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *groupMembershipTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_rbac_group_membership", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *groupMembershipTest) createTestResourceShouldNotProvideUpdateOperation() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Nil(t, NewTerraformResource(test.resourceHandle).ToSchemaResource().UpdateContext)
+	}
+}
+
+func (test *groupMembershipTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		email := "user@example.com"
+		membership := &restapi.GroupMembership{GroupID: "group-id", UserID: "user-id", Email: &email}
+
+		testHelper := NewTestHelper[*restapi.GroupMembership](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, membership)
+
+		require.NoError(t, err)
+		require.Equal(t, "group-id/user-id", resourceData.Id())
+		require.Equal(t, "group-id", resourceData.Get(GroupMembershipFieldGroupID))
+		require.Equal(t, "user-id", resourceData.Get(GroupMembershipFieldUserID))
+		require.Equal(t, email, resourceData.Get(GroupMembershipFieldEmail))
+	}
+}
+
+func (test *groupMembershipTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.GroupMembership](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, GroupMembershipFieldGroupID, "group-id")
+		setValueOnResourceData(t, resourceData, GroupMembershipFieldUserID, "user-id")
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.GroupMembership{GroupID: "group-id", UserID: "user-id"}, result)
+		require.Equal(t, "group-id/user-id", result.GetIDForResourcePath())
+	}
+}
@@ -0,0 +1,89 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaGroupPermissionSet the name of the terraform-provider-instana resource to manage the permissions of a group for role based access control
+const ResourceInstanaGroupPermissionSet = "instana_rbac_group_permission_set"
+
+const (
+	//GroupPermissionSetFieldGroupID constant value for the schema field group_id
+	GroupPermissionSetFieldGroupID = "group_id"
+	//GroupPermissionSetFieldPermissions constant value for the schema field permissions
+	GroupPermissionSetFieldPermissions = "permissions"
+)
+
+// NewGroupPermissionSetResourceHandle creates the resource handle for the permissions of an RBAC group
+func NewGroupPermissionSetResourceHandle() ResourceHandle[*restapi.GroupPermissionSet] {
+	return &groupPermissionSetResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGroupPermissionSet,
+			Schema: map[string]*schema.Schema{
+				GroupPermissionSetFieldGroupID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the RBAC group",
+				},
+				GroupPermissionSetFieldPermissions: {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MaxItems:    groupMaxNumberOfSetElements,
+					Description: "The permissions assigned to the users of the group",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(restapi.SupportedInstanaPermissions.ToStringSlice(), false),
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type groupPermissionSetResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *groupPermissionSetResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *groupPermissionSetResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *groupPermissionSetResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.GroupPermissionSet] {
+	return api.GroupPermissionSets()
+}
+
+func (r *groupPermissionSetResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *groupPermissionSetResource) UpdateState(d *schema.ResourceData, permissionSet *restapi.GroupPermissionSet) error {
+	d.SetId(permissionSet.GroupID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		GroupPermissionSetFieldGroupID:     permissionSet.GroupID,
+		GroupPermissionSetFieldPermissions: restapi.InstanaPermissions(permissionSet.Permissions).ToStringSlice(),
+	})
+}
+
+func (r *groupPermissionSetResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.GroupPermissionSet, error) {
+	permissions := make([]restapi.InstanaPermission, 0)
+	if set, ok := d.Get(GroupPermissionSetFieldPermissions).(*schema.Set); ok {
+		for _, v := range set.List() {
+			permissions = append(permissions, restapi.InstanaPermission(v.(string)))
+		}
+	}
+	return &restapi.GroupPermissionSet{
+		GroupID:     d.Get(GroupPermissionSetFieldGroupID).(string),
+		Permissions: permissions,
+	}, nil
+}
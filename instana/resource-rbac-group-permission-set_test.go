@@ -0,0 +1,161 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestGroupPermissionSet(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaGroupPermissionSet + ".example"
+	inst := &groupPermissionSetTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewGroupPermissionSetResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type groupPermissionSetTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.GroupPermissionSet]
+}
+
+var groupPermissionSetTerraformTemplate = `
+resource "instana_rbac_group_permission_set" "example" {
+	group_id    = "group-id"
+	permissions = [ "%s" ]
+}
+`
+
+func (test *groupPermissionSetTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaGroupPermissionSet), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaGroupPermissionSet), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaGroupPermissionSet), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaGroupPermissionSet), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaGroupPermissionSet), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaGroupPermissionSet), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *groupPermissionSetTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		userEmail := "user@example.com"
+		group := &restapi.Group{ID: "group-id", Name: "group", Members: []restapi.APIMember{{UserID: "user-id", Email: &userEmail}}}
+
+		writeGroup := func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(group)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+
+		groupPath := restapi.GroupsResourcePath + "/{id}"
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, groupPath, func(w http.ResponseWriter, r *http.Request) {
+			update := &restapi.Group{}
+			err := json.NewDecoder(r.Body).Decode(update)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mutex.Lock()
+			group = update
+			mutex.Unlock()
+			writeGroup(w, r)
+		})
+		httpServer.AddRoute(http.MethodGet, groupPath, writeGroup)
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), restapi.PermissionCanViewLogs),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(httpServer.GetPort(), restapi.PermissionCanConfigureAgents),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+
+		require.Empty(t, group.PermissionSet.Permissions)
+		require.Len(t, group.Members, 1)
+	}
+}
+
+func (test *groupPermissionSetTest) createIntegrationTestStep(httpPort int, permission restapi.InstanaPermission) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(groupPermissionSetTerraformTemplate, permission), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", "group-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GroupPermissionSetFieldGroupID, "group-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GroupPermissionSetFieldPermissions+".#", "1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, GroupPermissionSetFieldPermissions+".0", string(permission)),
+		),
+	}
+}
+
+func (test *groupPermissionSetTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *groupPermissionSetTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *groupPermissionSetTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_rbac_group_permission_set", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *groupPermissionSetTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		permissionSet := &restapi.GroupPermissionSet{
+			GroupID:     "group-id",
+			Permissions: []restapi.InstanaPermission{restapi.PermissionCanViewLogs, restapi.PermissionCanConfigureAgents},
+		}
+
+		testHelper := NewTestHelper[*restapi.GroupPermissionSet](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, permissionSet)
+
+		require.NoError(t, err)
+		require.Equal(t, "group-id", resourceData.Id())
+		require.Equal(t, "group-id", resourceData.Get(GroupPermissionSetFieldGroupID))
+		require.ElementsMatch(t, []interface{}{string(restapi.PermissionCanViewLogs), string(restapi.PermissionCanConfigureAgents)}, resourceData.Get(GroupPermissionSetFieldPermissions).(*schema.Set).List())
+	}
+}
+
+func (test *groupPermissionSetTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.GroupPermissionSet](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("group-id")
+		setValueOnResourceData(t, resourceData, GroupPermissionSetFieldGroupID, "group-id")
+		setValueOnResourceData(t, resourceData, GroupPermissionSetFieldPermissions, []interface{}{string(restapi.PermissionCanViewLogs)})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.GroupPermissionSet{GroupID: "group-id", Permissions: []restapi.InstanaPermission{restapi.PermissionCanViewLogs}}, result)
+	}
+}
@@ -0,0 +1,89 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaIdpMapping the name of the terraform-provider-instana resource to manage identity provider group mappings for role based access control
+const ResourceInstanaIdpMapping = "instana_rbac_idp_mapping"
+
+const (
+	//IdpMappingFieldGroupID constant value for the schema field group_id
+	IdpMappingFieldGroupID = "group_id"
+	//IdpMappingFieldKey constant value for the schema field key
+	IdpMappingFieldKey = "key"
+	//IdpMappingFieldValue constant value for the schema field value
+	IdpMappingFieldValue = "value"
+)
+
+// NewIdpMappingResourceHandle creates the resource handle for identity provider group mappings
+func NewIdpMappingResourceHandle() ResourceHandle[*restapi.IdpGroupMapping] {
+	return &idpMappingResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaIdpMapping,
+			Schema: map[string]*schema.Schema{
+				IdpMappingFieldGroupID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Instana group the users are assigned to when the key/value pair of the identity provider matches",
+				},
+				IdpMappingFieldKey: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 65536),
+					Description:  "The key of the attribute sent by the identity provider (LDAP, OIDC, SAML), e.g. the name of the groups attribute",
+				},
+				IdpMappingFieldValue: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 65536),
+					Description:  "The value of the attribute sent by the identity provider, e.g. the name of the group at the identity provider",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type idpMappingResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *idpMappingResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *idpMappingResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *idpMappingResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.IdpGroupMapping] {
+	return api.IdpGroupMappings()
+}
+
+func (r *idpMappingResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *idpMappingResource) UpdateState(d *schema.ResourceData, mapping *restapi.IdpGroupMapping) error {
+	d.SetId(mapping.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		IdpMappingFieldGroupID: mapping.GroupID,
+		IdpMappingFieldKey:     mapping.Key,
+		IdpMappingFieldValue:   mapping.Value,
+	})
+}
+
+func (r *idpMappingResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.IdpGroupMapping, error) {
+	return &restapi.IdpGroupMapping{
+		ID:      d.Id(),
+		GroupID: d.Get(IdpMappingFieldGroupID).(string),
+		Key:     d.Get(IdpMappingFieldKey).(string),
+		Value:   d.Get(IdpMappingFieldValue).(string),
+	}, nil
+}
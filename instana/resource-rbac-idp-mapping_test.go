@@ -0,0 +1,179 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestIdpMapping(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaIdpMapping + ".example"
+	inst := &idpMappingTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewIdpMappingResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type idpMappingTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.IdpGroupMapping]
+}
+
+var idpMappingTerraformTemplate = `
+resource "instana_rbac_idp_mapping" "example" {
+	group_id = "group-id"
+	key      = "groups"
+	value    = "admins-%d"
+}
+`
+
+const idpMappingID = "idp-mapping-id"
+
+func (test *idpMappingTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaIdpMapping), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaIdpMapping), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaIdpMapping), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaIdpMapping), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaIdpMapping), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaIdpMapping), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *idpMappingTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		mappings := make(map[string]*restapi.IdpGroupMapping)
+
+		onPostOrPut := func(w http.ResponseWriter, r *http.Request) {
+			mapping := &restapi.IdpGroupMapping{}
+			err := json.NewDecoder(r.Body).Decode(mapping)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if id, ok := mux.Vars(r)["id"]; ok {
+				mapping.ID = id
+			} else {
+				mapping.ID = idpMappingID
+			}
+			mutex.Lock()
+			mappings[mapping.ID] = mapping
+			mutex.Unlock()
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err = json.NewEncoder(w).Encode(mapping)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.IdpGroupMappingsResourcePath, onPostOrPut)
+		httpServer.AddRoute(http.MethodPut, restapi.IdpGroupMappingsResourcePath+"/{id}", onPostOrPut)
+		httpServer.AddRoute(http.MethodDelete, restapi.IdpGroupMappingsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			delete(mappings, mux.Vars(r)["id"])
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.IdpGroupMappingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			result := make([]*restapi.IdpGroupMapping, 0, len(mappings))
+			for _, m := range mappings {
+				result = append(result, m)
+			}
+			mutex.Unlock()
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(result)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0),
+				testStepImport(test.terraformResourceInstanceName),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1),
+				testStepImport(test.terraformResourceInstanceName),
+			},
+		})
+	}
+}
+
+func (test *idpMappingTest) createIntegrationTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(idpMappingTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", idpMappingID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, IdpMappingFieldGroupID, "group-id"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, IdpMappingFieldKey, "groups"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, IdpMappingFieldValue, fmt.Sprintf("admins-%d", iteration)),
+		),
+	}
+}
+
+func (test *idpMappingTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *idpMappingTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *idpMappingTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_rbac_idp_mapping", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *idpMappingTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		mapping := &restapi.IdpGroupMapping{ID: idpMappingID, GroupID: "group-id", Key: "groups", Value: "admins"}
+
+		testHelper := NewTestHelper[*restapi.IdpGroupMapping](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, mapping)
+
+		require.NoError(t, err)
+		require.Equal(t, idpMappingID, resourceData.Id())
+		require.Equal(t, "group-id", resourceData.Get(IdpMappingFieldGroupID))
+		require.Equal(t, "groups", resourceData.Get(IdpMappingFieldKey))
+		require.Equal(t, "admins", resourceData.Get(IdpMappingFieldValue))
+	}
+}
+
+func (test *idpMappingTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.IdpGroupMapping](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId(idpMappingID)
+		setValueOnResourceData(t, resourceData, IdpMappingFieldGroupID, "group-id")
+		setValueOnResourceData(t, resourceData, IdpMappingFieldKey, "groups")
+		setValueOnResourceData(t, resourceData, IdpMappingFieldValue, "admins")
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.IdpGroupMapping{ID: idpMappingID, GroupID: "group-id", Key: "groups", Value: "admins"}, result)
+	}
+}
@@ -0,0 +1,66 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInstanaIdpSettings the name of the terraform-provider-instana resource to manage the identity provider settings for role based access control
+const ResourceInstanaIdpSettings = "instana_rbac_idp_settings"
+
+const (
+	//IdpSettingsFieldRestrictEmptyIdpGroups constant value for the schema field restrict_empty_idp_groups
+	IdpSettingsFieldRestrictEmptyIdpGroups = "restrict_empty_idp_groups"
+)
+
+// NewIdpSettingsResourceHandle creates the resource handle for the identity provider settings
+func NewIdpSettingsResourceHandle() ResourceHandle[*restapi.IdpSettings] {
+	return &idpSettingsResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaIdpSettings,
+			Schema: map[string]*schema.Schema{
+				IdpSettingsFieldRestrictEmptyIdpGroups: {
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "Flag to restrict the access to Instana to users which match at least one identity provider group mapping during login",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type idpSettingsResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *idpSettingsResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *idpSettingsResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *idpSettingsResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.IdpSettings] {
+	return api.IdpSettings()
+}
+
+func (r *idpSettingsResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *idpSettingsResource) UpdateState(d *schema.ResourceData, settings *restapi.IdpSettings) error {
+	d.SetId(restapi.IdpSettingsID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		IdpSettingsFieldRestrictEmptyIdpGroups: settings.RestrictEmptyIdpGroups,
+	})
+}
+
+func (r *idpSettingsResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.IdpSettings, error) {
+	return &restapi.IdpSettings{
+		RestrictEmptyIdpGroups: d.Get(IdpSettingsFieldRestrictEmptyIdpGroups).(bool),
+	}, nil
+}
@@ -0,0 +1,142 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestIdpSettings(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaIdpSettings + ".example"
+	inst := &idpSettingsTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewIdpSettingsResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type idpSettingsTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.IdpSettings]
+}
+
+var idpSettingsTerraformTemplate = `
+resource "instana_rbac_idp_settings" "example" {
+	restrict_empty_idp_groups = %t
+}
+`
+
+func (test *idpSettingsTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaIdpSettings), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaIdpSettings), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaIdpSettings), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaIdpSettings), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaIdpSettings), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaIdpSettings), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *idpSettingsTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		current := &restapi.IdpSettings{}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, restapi.IdpSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			settings := &restapi.IdpSettings{}
+			err := json.NewDecoder(r.Body).Decode(settings)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mutex.Lock()
+			current = settings
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.IdpSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(current)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), true),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.IdpSettingsID),
+				test.createIntegrationTestStep(httpServer.GetPort(), false),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.IdpSettingsID),
+			},
+		})
+	}
+}
+
+func (test *idpSettingsTest) createIntegrationTestStep(httpPort int, restrictEmptyIdpGroups bool) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(idpSettingsTerraformTemplate, restrictEmptyIdpGroups), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", restapi.IdpSettingsID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, IdpSettingsFieldRestrictEmptyIdpGroups, fmt.Sprintf("%t", restrictEmptyIdpGroups)),
+		),
+	}
+}
+
+func (test *idpSettingsTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *idpSettingsTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *idpSettingsTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_rbac_idp_settings", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *idpSettingsTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.IdpSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, &restapi.IdpSettings{RestrictEmptyIdpGroups: true})
+
+		require.NoError(t, err)
+		require.Equal(t, restapi.IdpSettingsID, resourceData.Id())
+		require.True(t, resourceData.Get(IdpSettingsFieldRestrictEmptyIdpGroups).(bool))
+	}
+}
+
+func (test *idpSettingsTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.IdpSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, IdpSettingsFieldRestrictEmptyIdpGroups, true)
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.IdpSettings{RestrictEmptyIdpGroups: true}, result)
+	}
+}
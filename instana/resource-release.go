@@ -0,0 +1,175 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaRelease the name of the terraform-provider-instana resource to manage releases
+const ResourceInstanaRelease = "instana_release"
+
+const (
+	//ReleaseFieldName constant value for the schema field name
+	ReleaseFieldName = "name"
+	//ReleaseFieldStart constant value for the schema field start
+	ReleaseFieldStart = "start"
+	//ReleaseFieldApplicationNames constant value for the schema field application_names
+	ReleaseFieldApplicationNames = "application_names"
+	//ReleaseFieldService constant value for the schema field service
+	ReleaseFieldService = "service"
+	//ReleaseFieldServiceName constant value for the schema field service.name
+	ReleaseFieldServiceName = "name"
+	//ReleaseFieldServiceScopedToApplicationNames constant value for the schema field service.scoped_to_application_names
+	ReleaseFieldServiceScopedToApplicationNames = "scoped_to_application_names"
+)
+
+var releaseResourceSchema = map[string]*schema.Schema{
+	ReleaseFieldName: {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The name of the release",
+		ValidateFunc: validation.StringLenBetween(0, 256),
+	},
+	ReleaseFieldStart: {
+		Type:         schema.TypeInt,
+		Required:     true,
+		Description:  "The start time of the release in milliseconds since epoch",
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	ReleaseFieldApplicationNames: {
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "The names of the application perspectives the release is scoped to",
+	},
+	ReleaseFieldService: {
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ReleaseFieldServiceName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The name of the service the release is scoped to",
+					ValidateFunc: validation.StringLenBetween(0, 256),
+				},
+				ReleaseFieldServiceScopedToApplicationNames: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					MaxItems: 10,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "The names of the application perspectives the service scope is restricted to",
+				},
+			},
+		},
+		Description: "The services the release is scoped to",
+	},
+}
+
+// NewReleaseResourceHandle creates the resource handle for releases
+func NewReleaseResourceHandle() ResourceHandle[*restapi.Release] {
+	return &releaseResource{
+		metaData: ResourceMetaData{
+			ResourceName:     ResourceInstanaRelease,
+			Schema:           releaseResourceSchema,
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type releaseResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *releaseResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *releaseResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *releaseResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.Release] {
+	return api.Releases()
+}
+
+func (r *releaseResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *releaseResource) UpdateState(d *schema.ResourceData, release *restapi.Release) error {
+	services := make([]interface{}, len(release.Services))
+	for i, service := range release.Services {
+		scopedToApplicationNames := make([]string, 0)
+		if service.ScopedTo != nil {
+			scopedToApplicationNames = r.mapApplicationScopesToNames(service.ScopedTo.Applications)
+		}
+		services[i] = map[string]interface{}{
+			ReleaseFieldServiceName:                     service.Name,
+			ReleaseFieldServiceScopedToApplicationNames: scopedToApplicationNames,
+		}
+	}
+
+	d.SetId(release.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ReleaseFieldName:             release.Name,
+		ReleaseFieldStart:            int(release.Start),
+		ReleaseFieldApplicationNames: r.mapApplicationScopesToNames(release.Applications),
+		ReleaseFieldService:          services,
+	})
+}
+
+func (r *releaseResource) mapApplicationScopesToNames(scopes []restapi.ReleaseApplicationScope) []string {
+	result := make([]string, len(scopes))
+	for i, scope := range scopes {
+		result[i] = scope.Name
+	}
+	return result
+}
+
+func (r *releaseResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.Release, error) {
+	var services []restapi.ReleaseServiceScope
+	if val, ok := d.GetOk(ReleaseFieldService); ok {
+		for _, s := range val.(*schema.Set).List() {
+			service := s.(map[string]interface{})
+			scope := restapi.ReleaseServiceScope{
+				Name: service[ReleaseFieldServiceName].(string),
+			}
+			scopedToApplicationNames := ReadSetParameterFromMap[string](service, ReleaseFieldServiceScopedToApplicationNames)
+			if len(scopedToApplicationNames) > 0 {
+				scope.ScopedTo = &restapi.ReleaseServiceScopedTo{
+					Applications: r.mapNamesToApplicationScopes(scopedToApplicationNames),
+				}
+			}
+			services = append(services, scope)
+		}
+	}
+
+	return &restapi.Release{
+		ID:           d.Id(),
+		Name:         d.Get(ReleaseFieldName).(string),
+		Start:        int64(d.Get(ReleaseFieldStart).(int)),
+		Applications: r.mapNamesToApplicationScopes(ReadStringSetParameterFromResource(d, ReleaseFieldApplicationNames)),
+		Services:     services,
+	}, nil
+}
+
+func (r *releaseResource) mapNamesToApplicationScopes(names []string) []restapi.ReleaseApplicationScope {
+	if len(names) == 0 {
+		return nil
+	}
+	result := make([]restapi.ReleaseApplicationScope, len(names))
+	for i, name := range names {
+		result[i] = restapi.ReleaseApplicationScope{Name: name}
+	}
+	return result
+}
@@ -0,0 +1,270 @@
+package instana_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestRelease(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaRelease + ".example"
+	inst := &releaseTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewReleaseResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type releaseTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.Release]
+}
+
+var releaseTerraformTemplate = `
+resource "instana_release" "example" {
+	name              = "name %d"
+	start             = 1683827571245
+	application_names = [ "app-1" ]
+
+	service {
+		name                        = "service-1"
+		scoped_to_application_names = [ "app-1" ]
+	}
+}
+`
+
+var releaseServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"start": 1683827571245,
+	"lastUpdated": 1683827571300,
+	"applications": [ { "id": "app-id-1", "name": "app-1" } ],
+	"services": [
+		{
+			"id": "service-id-1",
+			"name": "service-1",
+			"scopedTo": {
+				"applications": [ { "id": "app-id-1", "name": "app-1" } ]
+			}
+		}
+	]
+}
+`
+
+func (test *releaseTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaRelease), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaRelease), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaRelease), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaRelease), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaRelease), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should update terraform state from model without scopes", ResourceInstanaRelease), test.createTestShouldUpdateTerraformResourceStateFromModelWithoutScopes())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaRelease), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should map terraform state without scopes to model", ResourceInstanaRelease), test.createTestShouldMapTerraformResourceStateWithoutScopesToModel())
+}
+
+func (test *releaseTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.ReleasesResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			release := &restapi.Release{}
+			err := json.NewDecoder(r.Body).Decode(release)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				err = r.Write(bytes.NewBufferString("Failed to get request"))
+				if err != nil {
+					fmt.Printf("failed to write response; %s\n", err)
+				}
+			} else {
+				release.ID = id
+				w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+				w.WriteHeader(http.StatusOK)
+				err = json.NewEncoder(w).Encode(release)
+				if err != nil {
+					fmt.Printf("failed to encode json; %s\n", err)
+				}
+			}
+		})
+		httpServer.AddRoute(http.MethodPut, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPut, resourceRestAPIPath+"/"+id)
+			jsonData := fmt.Sprintf(releaseServerResponseTemplate, id, modCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+			},
+		})
+	}
+}
+
+func (test *releaseTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	serviceName := fmt.Sprintf("%s.0.%s", ReleaseFieldService, ReleaseFieldServiceName)
+	serviceScopedToApplicationName := fmt.Sprintf("%s.0.%s.0", ReleaseFieldService, ReleaseFieldServiceScopedToApplicationNames)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(releaseTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", id),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ReleaseFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ReleaseFieldStart, "1683827571245"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ReleaseFieldApplicationNames+".0", "app-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, serviceName, "service-1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, serviceScopedToApplicationName, "app-1"),
+		),
+	}
+}
+
+func (test *releaseTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *releaseTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *releaseTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_release", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *releaseTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		release := &restapi.Release{
+			ID:           "release-id",
+			Name:         "release-name",
+			Start:        1683827571245,
+			Applications: []restapi.ReleaseApplicationScope{{Name: "app-1"}},
+			Services: []restapi.ReleaseServiceScope{
+				{
+					Name: "service-1",
+					ScopedTo: &restapi.ReleaseServiceScopedTo{
+						Applications: []restapi.ReleaseApplicationScope{{Name: "app-1"}},
+					},
+				},
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.Release](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, release)
+
+		require.NoError(t, err)
+		require.Equal(t, "release-id", resourceData.Id())
+		require.Equal(t, "release-name", resourceData.Get(ReleaseFieldName))
+		require.Equal(t, 1683827571245, resourceData.Get(ReleaseFieldStart))
+		require.Equal(t, []interface{}{"app-1"}, resourceData.Get(ReleaseFieldApplicationNames).(*schema.Set).List())
+		services := resourceData.Get(ReleaseFieldService).(*schema.Set).List()
+		require.Len(t, services, 1)
+		service := services[0].(map[string]interface{})
+		require.Equal(t, "service-1", service[ReleaseFieldServiceName])
+		require.Equal(t, []interface{}{"app-1"}, service[ReleaseFieldServiceScopedToApplicationNames].(*schema.Set).List())
+	}
+}
+
+func (test *releaseTest) createTestShouldUpdateTerraformResourceStateFromModelWithoutScopes() func(t *testing.T) {
+	return func(t *testing.T) {
+		release := &restapi.Release{
+			ID:    "release-id",
+			Name:  "release-name",
+			Start: 1683827571245,
+		}
+
+		testHelper := NewTestHelper[*restapi.Release](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, release)
+
+		require.NoError(t, err)
+		require.Equal(t, "release-id", resourceData.Id())
+		require.Empty(t, resourceData.Get(ReleaseFieldApplicationNames).(*schema.Set).List())
+		require.Empty(t, resourceData.Get(ReleaseFieldService).(*schema.Set).List())
+	}
+}
+
+func (test *releaseTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.Release](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("release-id")
+		setValueOnResourceData(t, resourceData, ReleaseFieldName, "release-name")
+		setValueOnResourceData(t, resourceData, ReleaseFieldStart, 1683827571245)
+		setValueOnResourceData(t, resourceData, ReleaseFieldApplicationNames, []interface{}{"app-1"})
+		setValueOnResourceData(t, resourceData, ReleaseFieldService, []interface{}{
+			map[string]interface{}{
+				ReleaseFieldServiceName:                     "service-1",
+				ReleaseFieldServiceScopedToApplicationNames: []interface{}{"app-1"},
+			},
+			map[string]interface{}{
+				ReleaseFieldServiceName: "service-2",
+			},
+		})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, "release-id", result.ID)
+		require.Equal(t, "release-name", result.Name)
+		require.Equal(t, int64(1683827571245), result.Start)
+		require.Equal(t, []restapi.ReleaseApplicationScope{{Name: "app-1"}}, result.Applications)
+		require.ElementsMatch(t, []restapi.ReleaseServiceScope{
+			{
+				Name: "service-1",
+				ScopedTo: &restapi.ReleaseServiceScopedTo{
+					Applications: []restapi.ReleaseApplicationScope{{Name: "app-1"}},
+				},
+			},
+			{
+				Name: "service-2",
+			},
+		}, result.Services)
+	}
+}
+
+func (test *releaseTest) createTestShouldMapTerraformResourceStateWithoutScopesToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.Release](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("release-id")
+		setValueOnResourceData(t, resourceData, ReleaseFieldName, "release-name")
+		setValueOnResourceData(t, resourceData, ReleaseFieldStart, 1683827571245)
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.Release{ID: "release-id", Name: "release-name", Start: 1683827571245}, result)
+	}
+}
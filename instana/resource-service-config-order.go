@@ -0,0 +1,76 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInstanaServiceConfigOrder the name of the terraform-provider-instana resource to manage the order of service configurations
+const ResourceInstanaServiceConfigOrder = "instana_service_config_order"
+
+const (
+	//ServiceConfigOrderFieldServiceConfigIDs constant value for the schema field service_config_ids
+	ServiceConfigOrderFieldServiceConfigIDs = "service_config_ids"
+)
+
+// NewServiceConfigOrderResourceHandle creates the resource handle for the order of service configurations
+func NewServiceConfigOrderResourceHandle() ResourceHandle[*restapi.ServiceConfigOrder] {
+	return &serviceConfigOrderResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaServiceConfigOrder,
+			Schema: map[string]*schema.Schema{
+				ServiceConfigOrderFieldServiceConfigIDs: {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "The IDs of all service configurations in the order in which they are applied by Instana. The list must contain all existing service configurations",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type serviceConfigOrderResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *serviceConfigOrderResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *serviceConfigOrderResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *serviceConfigOrderResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ServiceConfigOrder] {
+	return api.ServiceConfigOrder()
+}
+
+func (r *serviceConfigOrderResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *serviceConfigOrderResource) UpdateState(d *schema.ResourceData, order *restapi.ServiceConfigOrder) error {
+	d.SetId(restapi.ServiceConfigOrderID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ServiceConfigOrderFieldServiceConfigIDs: order.ServiceConfigIDs,
+	})
+}
+
+func (r *serviceConfigOrderResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ServiceConfigOrder, error) {
+	idsState := d.Get(ServiceConfigOrderFieldServiceConfigIDs).([]interface{})
+	ids := make([]string, len(idsState))
+	for i, v := range idsState {
+		ids[i] = v.(string)
+	}
+	return &restapi.ServiceConfigOrder{
+		ID:               restapi.ServiceConfigOrderID,
+		ServiceConfigIDs: ids,
+	}, nil
+}
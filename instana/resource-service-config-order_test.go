@@ -0,0 +1,153 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestServiceConfigOrder(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaServiceConfigOrder + ".example"
+	inst := &serviceConfigOrderTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewServiceConfigOrderResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type serviceConfigOrderTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.ServiceConfigOrder]
+}
+
+var serviceConfigOrderTerraformTemplate = `
+resource "instana_service_config_order" "example" {
+	service_config_ids = [ %s ]
+}
+`
+
+func (test *serviceConfigOrderTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaServiceConfigOrder), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaServiceConfigOrder), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaServiceConfigOrder), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaServiceConfigOrder), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaServiceConfigOrder), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaServiceConfigOrder), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *serviceConfigOrderTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		currentOrder := []string{"id-1", "id-2", "id-3"}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, restapi.ServiceConfigOrderResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			order := make([]string, 0)
+			err := json.NewDecoder(r.Body).Decode(&order)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mutex.Lock()
+			currentOrder = order
+			mutex.Unlock()
+			w.WriteHeader(http.StatusOK)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.ServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			configs := make([]restapi.ServiceConfig, len(currentOrder))
+			for i, id := range currentOrder {
+				configs[i] = restapi.ServiceConfig{ID: id, Name: id, Label: "{service.name}", Enabled: true, MatchSpecification: []restapi.ServiceMatchingRule{}}
+			}
+			mutex.Unlock()
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(configs)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), []string{"id-3", "id-1", "id-2"}),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.ServiceConfigOrderID),
+				test.createIntegrationTestStep(httpServer.GetPort(), []string{"id-2", "id-3", "id-1"}),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.ServiceConfigOrderID),
+			},
+		})
+	}
+}
+
+func (test *serviceConfigOrderTest) createIntegrationTestStep(httpPort int, ids []string) resource.TestStep {
+	config := fmt.Sprintf(serviceConfigOrderTerraformTemplate, "\""+strings.Join(ids, "\", \"")+"\"")
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", restapi.ServiceConfigOrderID),
+	}
+	for i, id := range ids {
+		checks = append(checks, resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.%d", ServiceConfigOrderFieldServiceConfigIDs, i), id))
+	}
+	return resource.TestStep{
+		Config: appendProviderConfig(config, httpPort),
+		Check:  resource.ComposeTestCheckFunc(checks...),
+	}
+}
+
+func (test *serviceConfigOrderTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *serviceConfigOrderTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *serviceConfigOrderTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_service_config_order", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *serviceConfigOrderTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		order := &restapi.ServiceConfigOrder{ID: restapi.ServiceConfigOrderID, ServiceConfigIDs: []string{"id-2", "id-1"}}
+
+		testHelper := NewTestHelper[*restapi.ServiceConfigOrder](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, order)
+
+		require.NoError(t, err)
+		require.Equal(t, restapi.ServiceConfigOrderID, resourceData.Id())
+		require.Equal(t, []interface{}{"id-2", "id-1"}, resourceData.Get(ServiceConfigOrderFieldServiceConfigIDs))
+	}
+}
+
+func (test *serviceConfigOrderTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ServiceConfigOrder](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, ServiceConfigOrderFieldServiceConfigIDs, []interface{}{"id-2", "id-1"})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.ServiceConfigOrder{ID: restapi.ServiceConfigOrderID, ServiceConfigIDs: []string{"id-2", "id-1"}}, result)
+	}
+}
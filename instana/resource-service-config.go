@@ -0,0 +1,144 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaServiceConfig the name of the terraform-provider-instana resource to manage service configurations
+const ResourceInstanaServiceConfig = "instana_service_config"
+
+const (
+	//ServiceConfigFieldName constant value for the schema field name
+	ServiceConfigFieldName = "name"
+	//ServiceConfigFieldLabel constant value for the schema field label
+	ServiceConfigFieldLabel = "label"
+	//ServiceConfigFieldEnabled constant value for the schema field enabled
+	ServiceConfigFieldEnabled = "enabled"
+	//ServiceConfigFieldComment constant value for the schema field comment
+	ServiceConfigFieldComment = "comment"
+	//ServiceConfigFieldMatchSpecification constant value for the schema field match_specification
+	ServiceConfigFieldMatchSpecification = "match_specification"
+	//ServiceConfigFieldMatchSpecificationKey constant value for the schema field match_specification.key
+	ServiceConfigFieldMatchSpecificationKey = "key"
+	//ServiceConfigFieldMatchSpecificationValue constant value for the schema field match_specification.value
+	ServiceConfigFieldMatchSpecificationValue = "value"
+)
+
+// NewServiceConfigResourceHandle creates the resource handle for service configurations
+func NewServiceConfigResourceHandle() ResourceHandle[*restapi.ServiceConfig] {
+	return &serviceConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaServiceConfig,
+			Schema: map[string]*schema.Schema{
+				ServiceConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+					Description:  "The name of the service configuration",
+				},
+				ServiceConfigFieldLabel: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The label template of the services mapped by this configuration, e.g. {docker.container.name}",
+				},
+				ServiceConfigFieldEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether the service configuration is enabled",
+				},
+				ServiceConfigFieldComment: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 2048),
+					Description:  "An optional comment of the service configuration",
+				},
+				ServiceConfigFieldMatchSpecification: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    20,
+					Description: "The tags which have to match to map a call to a service of this configuration",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ServiceConfigFieldMatchSpecificationKey: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The key of the tag, e.g. kubernetes.container.name",
+							},
+							ServiceConfigFieldMatchSpecificationValue: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The regular expression the value of the tag has to match",
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type serviceConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *serviceConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *serviceConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *serviceConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ServiceConfig] {
+	return api.ServiceConfigs()
+}
+
+func (r *serviceConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *serviceConfigResource) UpdateState(d *schema.ResourceData, config *restapi.ServiceConfig) error {
+	matchSpecification := make([]interface{}, len(config.MatchSpecification))
+	for i, rule := range config.MatchSpecification {
+		matchSpecification[i] = map[string]interface{}{
+			ServiceConfigFieldMatchSpecificationKey:   rule.Key,
+			ServiceConfigFieldMatchSpecificationValue: rule.Value,
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ServiceConfigFieldName:               config.Name,
+		ServiceConfigFieldLabel:              config.Label,
+		ServiceConfigFieldEnabled:            config.Enabled,
+		ServiceConfigFieldComment:            config.Comment,
+		ServiceConfigFieldMatchSpecification: matchSpecification,
+	})
+}
+
+func (r *serviceConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ServiceConfig, error) {
+	matchSpecificationState := d.Get(ServiceConfigFieldMatchSpecification).([]interface{})
+	matchSpecification := make([]restapi.ServiceMatchingRule, len(matchSpecificationState))
+	for i, v := range matchSpecificationState {
+		rule := v.(map[string]interface{})
+		matchSpecification[i] = restapi.ServiceMatchingRule{
+			Key:   rule[ServiceConfigFieldMatchSpecificationKey].(string),
+			Value: rule[ServiceConfigFieldMatchSpecificationValue].(string),
+		}
+	}
+
+	return &restapi.ServiceConfig{
+		ID:                 d.Id(),
+		Name:               d.Get(ServiceConfigFieldName).(string),
+		Comment:            GetStringPointerFromResourceData(d, ServiceConfigFieldComment),
+		Label:              d.Get(ServiceConfigFieldLabel).(string),
+		Enabled:            d.Get(ServiceConfigFieldEnabled).(bool),
+		MatchSpecification: matchSpecification,
+	}, nil
+}
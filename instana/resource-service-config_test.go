@@ -0,0 +1,235 @@
+package instana_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestServiceConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaServiceConfig + ".example"
+	inst := &serviceConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewServiceConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type serviceConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.ServiceConfig]
+}
+
+var serviceConfigTerraformTemplate = `
+resource "instana_service_config" "example" {
+	name    = "name %d"
+	label   = "{docker.container.name}"
+	enabled = true
+	comment = "comment"
+
+	match_specification {
+		key   = "docker.container.name"
+		value = ".*"
+	}
+}
+`
+
+var serviceConfigServerResponseTemplate = `
+{
+	"id": "%s",
+	"name": "name %d",
+	"label": "{docker.container.name}",
+	"enabled": true,
+	"comment": "comment",
+	"matchSpecification": [ { "key": "docker.container.name", "value": ".*" } ]
+}
+`
+
+func (test *serviceConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaServiceConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaServiceConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaServiceConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaServiceConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaServiceConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaServiceConfig), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should map terraform state without comment and match specification to model", ResourceInstanaServiceConfig), test.createTestShouldMapMinimalTerraformResourceStateToModel())
+}
+
+func (test *serviceConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		id := RandomID()
+		resourceRestAPIPath := restapi.ServiceConfigResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{internal-id}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			config := &restapi.ServiceConfig{}
+			err := json.NewDecoder(r.Body).Decode(config)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				err = r.Write(bytes.NewBufferString("Failed to get request"))
+				if err != nil {
+					fmt.Printf("failed to write response; %s\n", err)
+				}
+			} else {
+				config.ID = id
+				w.Header().Set(contentType, r.Header.Get(contentType))
+				w.WriteHeader(http.StatusOK)
+				err = json.NewEncoder(w).Encode(config)
+				if err != nil {
+					fmt.Printf("failed to encode json; %s\n", err)
+				}
+			}
+		})
+		httpServer.AddRoute(http.MethodPut, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			modCount := httpServer.GetCallCount(http.MethodPut, resourceRestAPIPath+"/"+id)
+			jsonData := fmt.Sprintf(serviceConfigServerResponseTemplate, id, modCount)
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(jsonData))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 0, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1, id),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, id),
+			},
+		})
+	}
+}
+
+func (test *serviceConfigTest) createIntegrationTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	matchSpecificationKey := fmt.Sprintf("%s.0.%s", ServiceConfigFieldMatchSpecification, ServiceConfigFieldMatchSpecificationKey)
+	matchSpecificationValue := fmt.Sprintf("%s.0.%s", ServiceConfigFieldMatchSpecification, ServiceConfigFieldMatchSpecificationValue)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(serviceConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", id),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ServiceConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ServiceConfigFieldLabel, "{docker.container.name}"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ServiceConfigFieldEnabled, trueAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, ServiceConfigFieldComment, "comment"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, matchSpecificationKey, "docker.container.name"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, matchSpecificationValue, ".*"),
+		),
+	}
+}
+
+func (test *serviceConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *serviceConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *serviceConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_service_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *serviceConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		comment := "comment"
+		config := &restapi.ServiceConfig{
+			ID:      "service-config-id",
+			Name:    "service-config-name",
+			Comment: &comment,
+			Label:   "{docker.container.name}",
+			Enabled: false,
+			MatchSpecification: []restapi.ServiceMatchingRule{
+				{Key: "docker.container.name", Value: ".*"},
+				{Key: "kubernetes.namespace.name", Value: "prod"},
+			},
+		}
+
+		testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, "service-config-id", resourceData.Id())
+		require.Equal(t, "service-config-name", resourceData.Get(ServiceConfigFieldName))
+		require.Equal(t, "comment", resourceData.Get(ServiceConfigFieldComment))
+		require.Equal(t, "{docker.container.name}", resourceData.Get(ServiceConfigFieldLabel))
+		require.False(t, resourceData.Get(ServiceConfigFieldEnabled).(bool))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{ServiceConfigFieldMatchSpecificationKey: "docker.container.name", ServiceConfigFieldMatchSpecificationValue: ".*"},
+			map[string]interface{}{ServiceConfigFieldMatchSpecificationKey: "kubernetes.namespace.name", ServiceConfigFieldMatchSpecificationValue: "prod"},
+		}, resourceData.Get(ServiceConfigFieldMatchSpecification))
+	}
+}
+
+func (test *serviceConfigTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("service-config-id")
+		setValueOnResourceData(t, resourceData, ServiceConfigFieldName, "service-config-name")
+		setValueOnResourceData(t, resourceData, ServiceConfigFieldComment, "comment")
+		setValueOnResourceData(t, resourceData, ServiceConfigFieldLabel, "{docker.container.name}")
+		setValueOnResourceData(t, resourceData, ServiceConfigFieldEnabled, true)
+		setValueOnResourceData(t, resourceData, ServiceConfigFieldMatchSpecification, []interface{}{
+			map[string]interface{}{ServiceConfigFieldMatchSpecificationKey: "docker.container.name", ServiceConfigFieldMatchSpecificationValue: ".*"},
+		})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		comment := "comment"
+		require.Equal(t, &restapi.ServiceConfig{
+			ID:                 "service-config-id",
+			Name:               "service-config-name",
+			Comment:            &comment,
+			Label:              "{docker.container.name}",
+			Enabled:            true,
+			MatchSpecification: []restapi.ServiceMatchingRule{{Key: "docker.container.name", Value: ".*"}},
+		}, result)
+	}
+}
+
+func (test *serviceConfigTest) createTestShouldMapMinimalTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId("service-config-id")
+		setValueOnResourceData(t, resourceData, ServiceConfigFieldName, "service-config-name")
+		setValueOnResourceData(t, resourceData, ServiceConfigFieldLabel, "{docker.container.name}")
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.ServiceConfig{
+			ID:                 "service-config-id",
+			Name:               "service-config-name",
+			Label:              "{docker.container.name}",
+			Enabled:            true,
+			MatchSpecification: []restapi.ServiceMatchingRule{},
+		}, result)
+	}
+}
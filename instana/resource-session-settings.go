@@ -0,0 +1,88 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaSessionSettings the name of the terraform-provider-instana resource to manage the session settings of the tenant
+const ResourceInstanaSessionSettings = "instana_session_settings"
+
+const (
+	//SessionSettingsFieldIdleTime constant value for the schema field idle_time
+	SessionSettingsFieldIdleTime = "idle_time"
+	//SessionSettingsFieldTokenLifeTime constant value for the schema field token_life_time
+	SessionSettingsFieldTokenLifeTime = "token_life_time"
+)
+
+// NewSessionSettingsResourceHandle creates the resource handle for the session settings
+func NewSessionSettingsResourceHandle() ResourceHandle[*restapi.SessionSettings] {
+	return &sessionSettingsResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaSessionSettings,
+			Schema: map[string]*schema.Schema{
+				SessionSettingsFieldIdleTime: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(60000, 28800000),
+					Description:  "The time in milliseconds after which an idle session of a user expires (1 minute - 8 hours)",
+				},
+				SessionSettingsFieldTokenLifeTime: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(900000, 604800000),
+					Description:  "The time in milliseconds after which a session of a user expires independent of the user activity (15 minutes - 7 days)",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type sessionSettingsResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *sessionSettingsResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *sessionSettingsResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *sessionSettingsResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.SessionSettings] {
+	return api.SessionSettings()
+}
+
+func (r *sessionSettingsResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *sessionSettingsResource) UpdateState(d *schema.ResourceData, settings *restapi.SessionSettings) error {
+	d.SetId(restapi.SessionSettingsID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		SessionSettingsFieldIdleTime:      settings.IdleTimeInMillis,
+		SessionSettingsFieldTokenLifeTime: settings.TokenLifeTimeInMillis,
+	})
+}
+
+func (r *sessionSettingsResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.SessionSettings, error) {
+	return &restapi.SessionSettings{
+		IdleTimeInMillis:      r.getOptionalInt64(d, SessionSettingsFieldIdleTime),
+		TokenLifeTimeInMillis: r.getOptionalInt64(d, SessionSettingsFieldTokenLifeTime),
+	}, nil
+}
+
+func (r *sessionSettingsResource) getOptionalInt64(d *schema.ResourceData, key string) *int64 {
+	if v, ok := d.GetOk(key); ok {
+		value := int64(v.(int))
+		return &value
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+func TestSessionSettings(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaSessionSettings + ".example"
+	inst := &sessionSettingsTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewSessionSettingsResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type sessionSettingsTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.SessionSettings]
+}
+
+var sessionSettingsTerraformTemplate = `
+resource "instana_session_settings" "example" {
+	idle_time       = %d
+	token_life_time = %d
+}
+`
+
+func (test *sessionSettingsTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaSessionSettings), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaSessionSettings), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaSessionSettings), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaSessionSettings), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaSessionSettings), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaSessionSettings), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model without unset values", ResourceInstanaSessionSettings), test.createTestShouldMapTerraformResourceStateToModelWithoutUnsetValues())
+}
+
+func (test *sessionSettingsTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		defaults := restapi.SessionSettings{IdleTimeInMillis: utils.Int64Ptr(3600000), TokenLifeTimeInMillis: utils.Int64Ptr(86400000)}
+		current := defaults
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, restapi.SessionSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			settings := restapi.SessionSettings{}
+			err := json.NewDecoder(r.Body).Decode(&settings)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mutex.Lock()
+			current = settings
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.SessionSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			current = defaults
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.SessionSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(current)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), 600000, 3600000),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.SessionSettingsID),
+				test.createIntegrationTestStep(httpServer.GetPort(), 1200000, 7200000),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.SessionSettingsID),
+			},
+		})
+
+		require.Equal(t, 1, httpServer.GetCallCount(http.MethodDelete, restapi.SessionSettingsResourcePath))
+		require.Equal(t, defaults, current)
+	}
+}
+
+func (test *sessionSettingsTest) createIntegrationTestStep(httpPort int, idleTime int64, tokenLifeTime int64) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(sessionSettingsTerraformTemplate, idleTime, tokenLifeTime), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", restapi.SessionSettingsID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SessionSettingsFieldIdleTime, fmt.Sprintf("%d", idleTime)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SessionSettingsFieldTokenLifeTime, fmt.Sprintf("%d", tokenLifeTime)),
+		),
+	}
+}
+
+func (test *sessionSettingsTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *sessionSettingsTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *sessionSettingsTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_session_settings", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *sessionSettingsTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SessionSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, &restapi.SessionSettings{IdleTimeInMillis: utils.Int64Ptr(600000), TokenLifeTimeInMillis: utils.Int64Ptr(3600000)})
+
+		require.NoError(t, err)
+		require.Equal(t, restapi.SessionSettingsID, resourceData.Id())
+		require.Equal(t, 600000, resourceData.Get(SessionSettingsFieldIdleTime))
+		require.Equal(t, 3600000, resourceData.Get(SessionSettingsFieldTokenLifeTime))
+	}
+}
+
+func (test *sessionSettingsTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SessionSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, SessionSettingsFieldIdleTime, 600000)
+		setValueOnResourceData(t, resourceData, SessionSettingsFieldTokenLifeTime, 3600000)
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.SessionSettings{IdleTimeInMillis: utils.Int64Ptr(600000), TokenLifeTimeInMillis: utils.Int64Ptr(3600000)}, result)
+	}
+}
+
+func (test *sessionSettingsTest) createTestShouldMapTerraformResourceStateToModelWithoutUnsetValues() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SessionSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.SessionSettings{}, result)
+	}
+}
@@ -0,0 +1,210 @@
+package instana
+
+import (
+	"reflect"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaSyntheticCallsSettings the name of the terraform-provider-instana resource to manage the synthetic calls settings of the tenant
+const ResourceInstanaSyntheticCallsSettings = "instana_synthetic_calls_settings"
+
+const (
+	//SyntheticCallsSettingsFieldDefaultRulesEnabled constant value for the schema field default_rules_enabled
+	SyntheticCallsSettingsFieldDefaultRulesEnabled = "default_rules_enabled"
+	//SyntheticCallsSettingsFieldCustomRule constant value for the schema field custom_rule
+	SyntheticCallsSettingsFieldCustomRule = "custom_rule"
+	//SyntheticCallsSettingsFieldCustomRuleName constant value for the schema field custom_rule.name
+	SyntheticCallsSettingsFieldCustomRuleName = "name"
+	//SyntheticCallsSettingsFieldCustomRuleDescription constant value for the schema field custom_rule.description
+	SyntheticCallsSettingsFieldCustomRuleDescription = "description"
+	//SyntheticCallsSettingsFieldCustomRuleEnabled constant value for the schema field custom_rule.enabled
+	SyntheticCallsSettingsFieldCustomRuleEnabled = "enabled"
+	//SyntheticCallsSettingsFieldCustomRuleMatchSpecification constant value for the schema field custom_rule.match_specification
+	SyntheticCallsSettingsFieldCustomRuleMatchSpecification = "match_specification"
+)
+
+// NewSyntheticCallsSettingsResourceHandle creates the resource handle for the synthetic calls settings
+func NewSyntheticCallsSettingsResourceHandle() ResourceHandle[*restapi.SyntheticCallsSettings] {
+	return &syntheticCallsSettingsResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaSyntheticCallsSettings,
+			Schema: map[string]*schema.Schema{
+				SyntheticCallsSettingsFieldDefaultRulesEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to enable or disable the default rules provided by Instana to flag calls as synthetic calls",
+				},
+				SyntheticCallsSettingsFieldCustomRule: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    500,
+					Description: "The custom rules to flag calls as synthetic calls",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticCallsSettingsFieldCustomRuleName: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+								Description:  "The name of the custom rule",
+							},
+							SyntheticCallsSettingsFieldCustomRuleDescription: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 2048),
+								Description:  "The description of the custom rule",
+							},
+							SyntheticCallsSettingsFieldCustomRuleEnabled: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Flag to enable or disable the custom rule",
+							},
+							SyntheticCallsSettingsFieldCustomRuleMatchSpecification: {
+								Type:             schema.TypeString,
+								Required:         true,
+								Description:      "The tag filter expression used to match the calls. Values are compared as strings",
+								DiffSuppressFunc: matchSpecificationDiffSuppressFunc,
+								StateFunc:        tagFilterStateFunc,
+								ValidateFunc:     tagFilterValidateFunc,
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type syntheticCallsSettingsResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *syntheticCallsSettingsResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *syntheticCallsSettingsResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *syntheticCallsSettingsResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.SyntheticCallsSettings] {
+	return api.SyntheticCallsSettings()
+}
+
+func (r *syntheticCallsSettingsResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+// matchSpecificationDiffSuppressFunc match expressions of the Instana API only support string values. Match specifications
+// are therefore considered equal when they result in the same match expression, e.g. x = 200 and x = '200'
+func matchSpecificationDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if tagFilterDiffSuppressFunc(k, old, new, d) {
+		return true
+	}
+	oldExpression, err := mapMatchSpecificationFromString(old)
+	if err != nil {
+		return false
+	}
+	newExpression, err := mapMatchSpecificationFromString(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldExpression, newExpression)
+}
+
+func (r *syntheticCallsSettingsResource) UpdateState(d *schema.ResourceData, settings *restapi.SyntheticCallsSettings) error {
+	currentCustomRules := d.Get(SyntheticCallsSettingsFieldCustomRule).([]interface{})
+	customRules := make([]interface{}, len(settings.CustomRules))
+	for i, rule := range settings.CustomRules {
+		matchSpecification, err := r.mapMatchSpecificationToState(rule.MatchSpecification, r.getCurrentMatchSpecification(currentCustomRules, i))
+		if err != nil {
+			return err
+		}
+		customRules[i] = map[string]interface{}{
+			SyntheticCallsSettingsFieldCustomRuleName:               rule.Name,
+			SyntheticCallsSettingsFieldCustomRuleDescription:        rule.Description,
+			SyntheticCallsSettingsFieldCustomRuleEnabled:            rule.Enabled,
+			SyntheticCallsSettingsFieldCustomRuleMatchSpecification: matchSpecification,
+		}
+	}
+
+	d.SetId(restapi.SyntheticCallsSettingsID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		SyntheticCallsSettingsFieldDefaultRulesEnabled: settings.DefaultRulesEnabled,
+		SyntheticCallsSettingsFieldCustomRule:          customRules,
+	})
+}
+
+func (r *syntheticCallsSettingsResource) getCurrentMatchSpecification(currentCustomRules []interface{}, index int) string {
+	if index >= len(currentCustomRules) || currentCustomRules[index] == nil {
+		return ""
+	}
+	value, _ := currentCustomRules[index].(map[string]interface{})[SyntheticCallsSettingsFieldCustomRuleMatchSpecification].(string)
+	return value
+}
+
+// mapMatchSpecificationToState maps the match expression to its normalized string representation. The current match
+// specification is kept when it results in the same match expression to preserve the types of number and boolean values
+// which are returned as strings by the Instana API
+func (r *syntheticCallsSettingsResource) mapMatchSpecificationToState(matchSpecification *restapi.MatchExpression, current string) (*string, error) {
+	if matchSpecification == nil {
+		return nil, nil
+	}
+	if len(current) > 0 {
+		if currentExpression, err := mapMatchSpecificationFromString(current); err == nil && reflect.DeepEqual(currentExpression, matchSpecification) {
+			normalized, err := tagfilter.Normalize(current)
+			return &normalized, err
+		}
+	}
+	tagFilter, err := matchSpecification.ToTagFilter()
+	if err != nil {
+		return nil, err
+	}
+	return tagfilter.MapTagFilterToNormalizedString(tagFilter)
+}
+
+func (r *syntheticCallsSettingsResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.SyntheticCallsSettings, error) {
+	customRulesState := d.Get(SyntheticCallsSettingsFieldCustomRule).([]interface{})
+	customRules := make([]restapi.SyntheticCallRule, len(customRulesState))
+	for i, v := range customRulesState {
+		rule := v.(map[string]interface{})
+		matchSpecification, err := mapMatchSpecificationFromString(rule[SyntheticCallsSettingsFieldCustomRuleMatchSpecification].(string))
+		if err != nil {
+			return nil, err
+		}
+		var description *string
+		if value, ok := rule[SyntheticCallsSettingsFieldCustomRuleDescription]; ok && len(value.(string)) > 0 {
+			descriptionValue := value.(string)
+			description = &descriptionValue
+		}
+		customRules[i] = restapi.SyntheticCallRule{
+			Name:               rule[SyntheticCallsSettingsFieldCustomRuleName].(string),
+			Description:        description,
+			Enabled:            rule[SyntheticCallsSettingsFieldCustomRuleEnabled].(bool),
+			MatchSpecification: matchSpecification,
+		}
+	}
+	return &restapi.SyntheticCallsSettings{
+		CustomRules:         customRules,
+		DefaultRulesEnabled: d.Get(SyntheticCallsSettingsFieldDefaultRulesEnabled).(bool),
+	}, nil
+}
+
+func mapMatchSpecificationFromString(input string) (*restapi.MatchExpression, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return restapi.NewMatchExpressionFromTagFilter(mapper.ToAPIModel(expr))
+}
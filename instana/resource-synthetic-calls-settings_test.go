@@ -0,0 +1,313 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+func TestSyntheticCallsSettings(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaSyntheticCallsSettings + ".example"
+	inst := &syntheticCallsSettingsTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewSyntheticCallsSettingsResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type syntheticCallsSettingsTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.SyntheticCallsSettings]
+}
+
+var syntheticCallsSettingsTerraformTemplate = `
+resource "instana_synthetic_calls_settings" "example" {
+	default_rules_enabled = %t
+
+	custom_rule {
+		name                = "health checks"
+		description         = "calls of the health check endpoint"
+		match_specification = "call.http.path STARTS_WITH '%s' OR service.name EQUALS 'probe'"
+	}
+}
+`
+
+func (test *syntheticCallsSettingsTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaSyntheticCallsSettings), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaSyntheticCallsSettings), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaSyntheticCallsSettings), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaSyntheticCallsSettings), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaSyntheticCallsSettings), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should keep value types of match specification in terraform state when match expression is unchanged", ResourceInstanaSyntheticCallsSettings), test.createTestShouldKeepValueTypesOfMatchSpecificationInTerraformStateWhenMatchExpressionIsUnchanged())
+	t.Run(fmt.Sprintf("%s should replace match specification in terraform state when match expression changed", ResourceInstanaSyntheticCallsSettings), test.createTestShouldReplaceMatchSpecificationInTerraformStateWhenMatchExpressionChanged())
+	t.Run(fmt.Sprintf("%s should fail to update terraform state when match specification is invalid", ResourceInstanaSyntheticCallsSettings), test.createTestShouldFailToUpdateTerraformResourceStateWhenMatchSpecificationIsInvalid())
+	t.Run(fmt.Sprintf("%s should suppress diff of match specification when only value types differ", ResourceInstanaSyntheticCallsSettings), test.createTestShouldSuppressDiffOfMatchSpecificationWhenOnlyValueTypesDiffer())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaSyntheticCallsSettings), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state to model when match specification uses tag keys", ResourceInstanaSyntheticCallsSettings), test.createTestShouldFailToMapTerraformResourceStateToModelWhenMatchSpecificationUsesTagKeys())
+}
+
+func (test *syntheticCallsSettingsTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		defaults := restapi.SyntheticCallsSettings{CustomRules: []restapi.SyntheticCallRule{}, DefaultRulesEnabled: true}
+		current := defaults
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPut, restapi.SyntheticCallsSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			settings := restapi.SyntheticCallsSettings{}
+			err := json.NewDecoder(r.Body).Decode(&settings)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mutex.Lock()
+			current = settings
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.SyntheticCallsSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			current = defaults
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.SyntheticCallsSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(current)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(httpServer.GetPort(), true, "/health"),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.SyntheticCallsSettingsID),
+				test.createIntegrationTestStep(httpServer.GetPort(), false, "/status"),
+				testStepImportWithCustomID(test.terraformResourceInstanceName, restapi.SyntheticCallsSettingsID),
+			},
+		})
+
+		require.Equal(t, 1, httpServer.GetCallCount(http.MethodDelete, restapi.SyntheticCallsSettingsResourcePath))
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createIntegrationTestStep(httpPort int, defaultRulesEnabled bool, path string) resource.TestStep {
+	customRulePattern := fmt.Sprintf("%s.0.%s", SyntheticCallsSettingsFieldCustomRule, "%s")
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(syntheticCallsSettingsTerraformTemplate, defaultRulesEnabled, path), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", restapi.SyntheticCallsSettingsID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticCallsSettingsFieldDefaultRulesEnabled, fmt.Sprintf("%t", defaultRulesEnabled)),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticCallsSettingsFieldCustomRule+".#", "1"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(customRulePattern, SyntheticCallsSettingsFieldCustomRuleName), "health checks"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(customRulePattern, SyntheticCallsSettingsFieldCustomRuleDescription), "calls of the health check endpoint"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(customRulePattern, SyntheticCallsSettingsFieldCustomRuleEnabled), "true"),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf(customRulePattern, SyntheticCallsSettingsFieldCustomRuleMatchSpecification), fmt.Sprintf("(call.http.path@dest STARTS_WITH '%s' OR service.name@dest EQUALS 'probe')", path)),
+		),
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_synthetic_calls_settings", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createModel() *restapi.SyntheticCallsSettings {
+	return &restapi.SyntheticCallsSettings{
+		DefaultRulesEnabled: true,
+		CustomRules: []restapi.SyntheticCallRule{
+			{
+				Name:        "health checks",
+				Description: utils.StringPtr("calls of the health check endpoint"),
+				Enabled:     true,
+				MatchSpecification: &restapi.MatchExpression{
+					Type:        restapi.MatchExpressionTypeBinaryOperator,
+					Conjunction: test.logicalOperatorPtr(restapi.LogicalOr),
+					Left:        test.leafMatchExpression("call.http.path", restapi.StartsWithOperator, "/health"),
+					Right:       test.leafMatchExpression("service.name", restapi.EqualsOperator, "probe"),
+				},
+			},
+		},
+	}
+}
+
+func (test *syntheticCallsSettingsTest) leafMatchExpression(key string, operator restapi.ExpressionOperator, value string) *restapi.MatchExpression {
+	entity := restapi.TagFilterEntityDestination
+	return &restapi.MatchExpression{
+		Type:     restapi.MatchExpressionTypeLeaf,
+		Entity:   &entity,
+		Key:      &key,
+		Operator: &operator,
+		Value:    &value,
+	}
+}
+
+func (test *syntheticCallsSettingsTest) logicalOperatorPtr(operator restapi.LogicalOperatorType) *restapi.LogicalOperatorType {
+	return &operator
+}
+
+func (test *syntheticCallsSettingsTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		model := test.createModel()
+		model.CustomRules[0].MatchSpecification.Right = test.leafMatchExpression("call.http.status", restapi.EqualsOperator, "200")
+		model.DefaultRules = []restapi.SyntheticCallRule{{Name: "default", Enabled: true}}
+
+		testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, model)
+
+		require.NoError(t, err)
+		require.Equal(t, restapi.SyntheticCallsSettingsID, resourceData.Id())
+		require.True(t, resourceData.Get(SyntheticCallsSettingsFieldDefaultRulesEnabled).(bool))
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				SyntheticCallsSettingsFieldCustomRuleName:               "health checks",
+				SyntheticCallsSettingsFieldCustomRuleDescription:        "calls of the health check endpoint",
+				SyntheticCallsSettingsFieldCustomRuleEnabled:            true,
+				SyntheticCallsSettingsFieldCustomRuleMatchSpecification: "(call.http.path@dest STARTS_WITH '/health' OR call.http.status@dest EQUALS '200')",
+			},
+		}, resourceData.Get(SyntheticCallsSettingsFieldCustomRule))
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createTestShouldKeepValueTypesOfMatchSpecificationInTerraformStateWhenMatchExpressionIsUnchanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		model := test.createModel()
+		model.CustomRules[0].MatchSpecification.Right = test.leafMatchExpression("call.http.status", restapi.EqualsOperator, "200")
+
+		testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, SyntheticCallsSettingsFieldCustomRule, []interface{}{
+			map[string]interface{}{
+				SyntheticCallsSettingsFieldCustomRuleName:               "health checks",
+				SyntheticCallsSettingsFieldCustomRuleMatchSpecification: "call.http.path STARTS_WITH '/health' OR call.http.status EQUALS 200",
+			},
+		})
+
+		err := test.resourceHandle.UpdateState(resourceData, model)
+
+		require.NoError(t, err)
+		require.Equal(t, "(call.http.path@dest STARTS_WITH '/health' OR call.http.status@dest EQUALS 200)", resourceData.Get(SyntheticCallsSettingsFieldCustomRule+".0."+SyntheticCallsSettingsFieldCustomRuleMatchSpecification))
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createTestShouldReplaceMatchSpecificationInTerraformStateWhenMatchExpressionChanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		model := test.createModel()
+		model.CustomRules[0].MatchSpecification.Right = test.leafMatchExpression("call.http.status", restapi.EqualsOperator, "500")
+
+		testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, SyntheticCallsSettingsFieldCustomRule, []interface{}{
+			map[string]interface{}{
+				SyntheticCallsSettingsFieldCustomRuleName:               "health checks",
+				SyntheticCallsSettingsFieldCustomRuleMatchSpecification: "call.http.path STARTS_WITH '/health' OR call.http.status EQUALS 200",
+			},
+		})
+
+		err := test.resourceHandle.UpdateState(resourceData, model)
+
+		require.NoError(t, err)
+		require.Equal(t, "(call.http.path@dest STARTS_WITH '/health' OR call.http.status@dest EQUALS '500')", resourceData.Get(SyntheticCallsSettingsFieldCustomRule+".0."+SyntheticCallsSettingsFieldCustomRuleMatchSpecification))
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createTestShouldSuppressDiffOfMatchSpecificationWhenOnlyValueTypesDiffer() func(t *testing.T) {
+	return func(t *testing.T) {
+		customRuleSchema := test.resourceHandle.MetaData().Schema[SyntheticCallsSettingsFieldCustomRule].Elem.(*schema.Resource)
+		suppressFunc := customRuleSchema.Schema[SyntheticCallsSettingsFieldCustomRuleMatchSpecification].DiffSuppressFunc
+		key := SyntheticCallsSettingsFieldCustomRule + ".0." + SyntheticCallsSettingsFieldCustomRuleMatchSpecification
+
+		require.True(t, suppressFunc(key, "call.http.status@dest EQUALS '200'", "call.http.status EQUALS 200", nil))
+		require.True(t, suppressFunc(key, "call.erroneous@dest EQUALS 'true'", "call.erroneous EQUALS true", nil))
+		require.True(t, suppressFunc(key, "call.http.status@dest EQUALS 200", "call.http.status EQUALS 200", nil))
+		require.False(t, suppressFunc(key, "call.http.status@dest EQUALS '200'", "call.http.status EQUALS 500", nil))
+		require.False(t, suppressFunc(key, "call.http.status@dest EQUALS '200'", "invalid expression", nil))
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createTestShouldFailToUpdateTerraformResourceStateWhenMatchSpecificationIsInvalid() func(t *testing.T) {
+	return func(t *testing.T) {
+		model := test.createModel()
+		model.CustomRules[0].MatchSpecification = &restapi.MatchExpression{Type: restapi.MatchExpressionType("invalid")}
+
+		testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, model)
+
+		require.Error(t, err)
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, SyntheticCallsSettingsFieldDefaultRulesEnabled, true)
+		setValueOnResourceData(t, resourceData, SyntheticCallsSettingsFieldCustomRule, []interface{}{
+			map[string]interface{}{
+				SyntheticCallsSettingsFieldCustomRuleName:               "health checks",
+				SyntheticCallsSettingsFieldCustomRuleDescription:        "calls of the health check endpoint",
+				SyntheticCallsSettingsFieldCustomRuleEnabled:            true,
+				SyntheticCallsSettingsFieldCustomRuleMatchSpecification: "call.http.path STARTS_WITH '/health' OR call.http.status EQUALS '200'",
+			},
+		})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		expected := test.createModel()
+		expected.CustomRules[0].MatchSpecification.Right = test.leafMatchExpression("call.http.status", restapi.EqualsOperator, "200")
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+	}
+}
+
+func (test *syntheticCallsSettingsTest) createTestShouldFailToMapTerraformResourceStateToModelWhenMatchSpecificationUsesTagKeys() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticCallsSettings](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, SyntheticCallsSettingsFieldCustomRule, []interface{}{
+			map[string]interface{}{
+				SyntheticCallsSettingsFieldCustomRuleName:               "invalid",
+				SyntheticCallsSettingsFieldCustomRuleMatchSpecification: "call.http.header:'user-agent' CONTAINS 'probe'",
+			},
+		})
+
+		_, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+	}
+}
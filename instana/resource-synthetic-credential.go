@@ -0,0 +1,100 @@
+package instana
+
+import (
+	"regexp"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaSyntheticCredential the name of the terraform-provider-instana resource to manage synthetic credentials
+const ResourceInstanaSyntheticCredential = "instana_synthetic_credential"
+
+//nolint:gosec
+const (
+	//SyntheticCredentialFieldName constant value for the schema field name
+	SyntheticCredentialFieldName = "name"
+	//SyntheticCredentialFieldValue constant value for the schema field value
+	SyntheticCredentialFieldValue = "value"
+)
+
+var syntheticCredentialNameFieldName = SyntheticCredentialFieldName
+
+// NewSyntheticCredentialResourceHandle creates the resource handle for synthetic credentials
+func NewSyntheticCredentialResourceHandle() ResourceHandle[*restapi.SyntheticCredential] {
+	return &syntheticCredentialResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaSyntheticCredential,
+			Schema: map[string]*schema.Schema{
+				SyntheticCredentialFieldName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 64),
+						validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`), "must start with a letter and can only contain letters, numbers and underscores"),
+					),
+					Description: "The name of the credential. The name is used to reference the credential in synthetic API scripts",
+				},
+				SyntheticCredentialFieldValue: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Sensitive:        true,
+					DiffSuppressFunc: suppressSyntheticCredentialValueDiffAfterImport,
+					Description:      "The value of the credential. The value is stored encrypted by Instana and cannot be queried after creation",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			ResourceIDField:  &syntheticCredentialNameFieldName,
+		},
+	}
+}
+
+// suppressSyntheticCredentialValueDiffAfterImport the value of an imported credential is not available in the state as it
+// cannot be queried from the Instana API. The diff is suppressed in this case to avoid a re-creation of the credential.
+func suppressSyntheticCredentialValueDiffAfterImport(_, old, _ string, d *schema.ResourceData) bool {
+	return len(old) == 0 && len(d.Id()) > 0
+}
+
+type syntheticCredentialResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *syntheticCredentialResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *syntheticCredentialResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *syntheticCredentialResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.SyntheticCredential] {
+	return api.SyntheticCredentials()
+}
+
+func (r *syntheticCredentialResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *syntheticCredentialResource) UpdateState(d *schema.ResourceData, credential *restapi.SyntheticCredential) error {
+	data := map[string]interface{}{
+		SyntheticCredentialFieldName: credential.Name,
+	}
+	//the Instana API only returns the names of the credentials. The value is kept as defined in the state
+	if len(credential.Value) > 0 {
+		data[SyntheticCredentialFieldValue] = credential.Value
+	}
+	d.SetId(credential.Name)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *syntheticCredentialResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.SyntheticCredential, error) {
+	return &restapi.SyntheticCredential{
+		Name:  d.Get(SyntheticCredentialFieldName).(string),
+		Value: d.Get(SyntheticCredentialFieldValue).(string),
+	}, nil
+}
@@ -0,0 +1,211 @@
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+)
+
+func TestSyntheticCredential(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaSyntheticCredential + ".example"
+	inst := &syntheticCredentialTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewSyntheticCredentialResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type syntheticCredentialTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.SyntheticCredential]
+}
+
+const syntheticCredentialName = "my_credential"
+
+var syntheticCredentialTerraformTemplate = `
+resource "instana_synthetic_credential" "example" {
+	name  = "my_credential"
+	value = "secret-value"
+}
+`
+
+func (test *syntheticCredentialTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaSyntheticCredential), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaSyntheticCredential), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaSyntheticCredential), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaSyntheticCredential), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should force new resource on change and use name as resource id", ResourceInstanaSyntheticCredential), test.createTestResourceShouldForceNewResourceOnChangeAndUseNameAsResourceID())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaSyntheticCredential), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should keep value in state when model only contains the name", ResourceInstanaSyntheticCredential), test.createTestShouldKeepValueWhenModelOnlyContainsName())
+	t.Run(fmt.Sprintf("%s should suppress diff of value after import", ResourceInstanaSyntheticCredential), test.createTestShouldSuppressDiffOfValueAfterImport())
+	t.Run(fmt.Sprintf("%s should not suppress diff of value when value is available in state", ResourceInstanaSyntheticCredential), test.createTestShouldNotSuppressDiffOfValueWhenValueIsAvailableInState())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaSyntheticCredential), test.createTestShouldMapTerraformResourceStateToModel())
+	t.Run(fmt.Sprintf("%s should reject invalid credential names", ResourceInstanaSyntheticCredential), test.createTestShouldRejectInvalidCredentialNames())
+}
+
+func (test *syntheticCredentialTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		resourceRestAPIPath := restapi.SyntheticCredentialResourcePath
+		resourceInstanceRestAPIPath := resourceRestAPIPath + "/{name}"
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, resourceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodGet, resourceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(fmt.Sprintf("[\"other_credential\", \"%s\"]", syntheticCredentialName)))
+			if err != nil {
+				fmt.Printf("failed to write response; %s\n", err)
+			}
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				{
+					Config: appendProviderConfig(syntheticCredentialTerraformTemplate, httpServer.GetPort()),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", syntheticCredentialName),
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticCredentialFieldName, syntheticCredentialName),
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, SyntheticCredentialFieldValue, "secret-value"),
+					),
+				},
+				testStepImportWithCustomID(test.terraformResourceInstanceName, syntheticCredentialName, SyntheticCredentialFieldValue),
+			},
+		})
+	}
+}
+
+func (test *syntheticCredentialTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *syntheticCredentialTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *syntheticCredentialTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_synthetic_credential", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *syntheticCredentialTest) createTestResourceShouldForceNewResourceOnChangeAndUseNameAsResourceID() func(t *testing.T) {
+	return func(t *testing.T) {
+		metaData := test.resourceHandle.MetaData()
+
+		require.True(t, metaData.Schema[SyntheticCredentialFieldName].ForceNew)
+		require.True(t, metaData.Schema[SyntheticCredentialFieldValue].ForceNew)
+		require.Nil(t, NewTerraformResource(test.resourceHandle).ToSchemaResource().UpdateContext)
+		require.True(t, metaData.SkipIDGeneration)
+		require.NotNil(t, metaData.ResourceIDField)
+		require.Equal(t, SyntheticCredentialFieldName, *metaData.ResourceIDField)
+		require.True(t, metaData.Schema[SyntheticCredentialFieldValue].Sensitive)
+	}
+}
+
+func (test *syntheticCredentialTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		credential := &restapi.SyntheticCredential{
+			Name:  syntheticCredentialName,
+			Value: "secret-value",
+		}
+
+		testHelper := NewTestHelper[*restapi.SyntheticCredential](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, credential)
+
+		require.NoError(t, err)
+		require.Equal(t, syntheticCredentialName, resourceData.Id())
+		require.Equal(t, syntheticCredentialName, resourceData.Get(SyntheticCredentialFieldName))
+		require.Equal(t, "secret-value", resourceData.Get(SyntheticCredentialFieldValue))
+	}
+}
+
+func (test *syntheticCredentialTest) createTestShouldKeepValueWhenModelOnlyContainsName() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticCredential](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, SyntheticCredentialFieldValue, "secret-value")
+
+		err := test.resourceHandle.UpdateState(resourceData, &restapi.SyntheticCredential{Name: syntheticCredentialName})
+
+		require.NoError(t, err)
+		require.Equal(t, syntheticCredentialName, resourceData.Id())
+		require.Equal(t, syntheticCredentialName, resourceData.Get(SyntheticCredentialFieldName))
+		require.Equal(t, "secret-value", resourceData.Get(SyntheticCredentialFieldValue))
+	}
+}
+
+func (test *syntheticCredentialTest) createTestShouldSuppressDiffOfValueAfterImport() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticCredential](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId(syntheticCredentialName)
+
+		suppressFunc := test.resourceHandle.MetaData().Schema[SyntheticCredentialFieldValue].DiffSuppressFunc
+
+		require.True(t, suppressFunc(SyntheticCredentialFieldValue, "", "secret-value", resourceData))
+	}
+}
+
+func (test *syntheticCredentialTest) createTestShouldNotSuppressDiffOfValueWhenValueIsAvailableInState() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticCredential](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		suppressFunc := test.resourceHandle.MetaData().Schema[SyntheticCredentialFieldValue].DiffSuppressFunc
+
+		require.False(t, suppressFunc(SyntheticCredentialFieldValue, "", "secret-value", resourceData))
+
+		resourceData.SetId(syntheticCredentialName)
+
+		require.False(t, suppressFunc(SyntheticCredentialFieldValue, "old-value", "secret-value", resourceData))
+	}
+}
+
+func (test *syntheticCredentialTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SyntheticCredential](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		resourceData.SetId(syntheticCredentialName)
+		setValueOnResourceData(t, resourceData, SyntheticCredentialFieldName, syntheticCredentialName)
+		setValueOnResourceData(t, resourceData, SyntheticCredentialFieldValue, "secret-value")
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.SyntheticCredential{
+			Name:  syntheticCredentialName,
+			Value: "secret-value",
+		}, result)
+		require.Equal(t, syntheticCredentialName, result.GetIDForResourcePath())
+	}
+}
+
+func (test *syntheticCredentialTest) createTestShouldRejectInvalidCredentialNames() func(t *testing.T) {
+	return func(t *testing.T) {
+		nameSchema := test.resourceHandle.MetaData().Schema[SyntheticCredentialFieldName]
+
+		_, errs := nameSchema.ValidateFunc("valid_name_1", SyntheticCredentialFieldName)
+		require.Empty(t, errs)
+		_, errs = nameSchema.ValidateFunc("1_invalid", SyntheticCredentialFieldName)
+		require.NotEmpty(t, errs)
+		_, errs = nameSchema.ValidateFunc("invalid-name", SyntheticCredentialFieldName)
+		require.NotEmpty(t, errs)
+	}
+}
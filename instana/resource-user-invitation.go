@@ -0,0 +1,100 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaUserInvitation the name of the terraform-provider-instana resource to manage user invitations
+const ResourceInstanaUserInvitation = "instana_user_invitation"
+
+const (
+	//UserInvitationFieldEmail constant value for the schema field email
+	UserInvitationFieldEmail = "email"
+	//UserInvitationFieldGroupID constant value for the schema field group_id
+	UserInvitationFieldGroupID = "group_id"
+	//UserInvitationFieldAccepted constant value for the computed schema field accepted
+	UserInvitationFieldAccepted = "accepted"
+	//UserInvitationFieldUserID constant value for the computed schema field user_id
+	UserInvitationFieldUserID = "user_id"
+)
+
+// NewUserInvitationResourceHandle creates the resource handle for user invitations
+func NewUserInvitationResourceHandle() ResourceHandle[*restapi.UserInvitation] {
+	return &userInvitationResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaUserInvitation,
+			Schema: map[string]*schema.Schema{
+				UserInvitationFieldEmail: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The email address of the invited user",
+				},
+				UserInvitationFieldGroupID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the RBAC group the user is invited to",
+				},
+				UserInvitationFieldAccepted: {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Flag indicating that the user has accepted the invitation",
+				},
+				UserInvitationFieldUserID: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the user once the invitation has been accepted",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type userInvitationResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *userInvitationResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *userInvitationResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *userInvitationResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.UserInvitation] {
+	return api.UserInvitations()
+}
+
+func (r *userInvitationResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *userInvitationResource) UpdateState(d *schema.ResourceData, invitation *restapi.UserInvitation) error {
+	data := map[string]interface{}{
+		UserInvitationFieldEmail:    invitation.Email,
+		UserInvitationFieldAccepted: invitation.Accepted,
+		UserInvitationFieldUserID:   invitation.UserID,
+	}
+	//the group of accepted invitations is no longer available; the configured group is kept to avoid a re-creation
+	if !invitation.Accepted {
+		data[UserInvitationFieldGroupID] = invitation.GroupID
+	}
+	d.SetId(invitation.Email)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *userInvitationResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.UserInvitation, error) {
+	return &restapi.UserInvitation{
+		Email:   d.Get(UserInvitationFieldEmail).(string),
+		GroupID: d.Get(UserInvitationFieldGroupID).(string),
+	}, nil
+}
@@ -0,0 +1,199 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestUserInvitation(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaUserInvitation + ".example"
+	inst := &userInvitationTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewUserInvitationResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type userInvitationTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.UserInvitation]
+}
+
+var userInvitationTerraformTemplate = `
+resource "instana_user_invitation" "example" {
+	email    = "user@example.com"
+	group_id = "group-1"
+}
+`
+
+const userInvitationEmail = "user@example.com"
+
+func (test *userInvitationTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaUserInvitation), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaUserInvitation), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaUserInvitation), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaUserInvitation), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from pending invitation", ResourceInstanaUserInvitation), test.createTestShouldUpdateTerraformResourceStateFromPendingInvitation())
+	t.Run(fmt.Sprintf("%s should keep group in terraform state when invitation is accepted", ResourceInstanaUserInvitation), test.createTestShouldKeepGroupInTerraformStateWhenInvitationIsAccepted())
+	t.Run(fmt.Sprintf("%s should map terraform state to model", ResourceInstanaUserInvitation), test.createTestShouldMapTerraformResourceStateToModel())
+}
+
+func (test *userInvitationTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		var mutex sync.Mutex
+		overview := &restapi.UsersOverview{Users: []restapi.User{}, Invitations: []restapi.Invitation{}}
+		revoked := false
+
+		writeJSON := func(w http.ResponseWriter, r *http.Request, data interface{}) {
+			w.Header().Set(contentType, r.Header.Get(contentType))
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(data)
+			if err != nil {
+				fmt.Printf("failed to encode json; %s\n", err)
+			}
+		}
+
+		httpServer := testutils.NewTestHTTPServer()
+		httpServer.AddRoute(http.MethodPost, restapi.InvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			invitations := make([]restapi.Invitation, 0)
+			err := json.NewDecoder(r.Body).Decode(&invitations)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mutex.Lock()
+			overview.Invitations = append(overview.Invitations, invitations...)
+			mutex.Unlock()
+			writeJSON(w, r, restapi.InvitationResponse{InvitationResults: []restapi.InvitationResult{{UserEmail: userInvitationEmail, InvitationStatus: restapi.InvitationStatusSuccess}}})
+		})
+		httpServer.AddRoute(http.MethodDelete, restapi.InvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			revoked = r.URL.Query().Get("email") == userInvitationEmail
+			overview.Invitations = []restapi.Invitation{}
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		})
+		httpServer.AddRoute(http.MethodGet, restapi.UsersOverviewResourcePath, func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			writeJSON(w, r, overview)
+		})
+		httpServer.Start()
+		defer httpServer.Close()
+
+		acceptInvitation := func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+			overview.Invitations = []restapi.Invitation{}
+			overview.Users = []restapi.User{{ID: "user-id", Email: userInvitationEmail, FullName: "user"}}
+		}
+
+		config := appendProviderConfig(userInvitationTerraformTemplate, httpServer.GetPort())
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", userInvitationEmail),
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, UserInvitationFieldEmail, userInvitationEmail),
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, UserInvitationFieldGroupID, "group-1"),
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, UserInvitationFieldAccepted, "false"),
+					),
+				},
+				testStepImport(test.terraformResourceInstanceName),
+				{
+					PreConfig: acceptInvitation,
+					Config:    config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", userInvitationEmail),
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, UserInvitationFieldGroupID, "group-1"),
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, UserInvitationFieldAccepted, "true"),
+						resource.TestCheckResourceAttr(test.terraformResourceInstanceName, UserInvitationFieldUserID, "user-id"),
+					),
+				},
+			},
+		})
+
+		require.False(t, revoked)
+		require.Len(t, overview.Users, 1)
+	}
+}
+
+func (test *userInvitationTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *userInvitationTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *userInvitationTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_user_invitation", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *userInvitationTest) createTestShouldUpdateTerraformResourceStateFromPendingInvitation() func(t *testing.T) {
+	return func(t *testing.T) {
+		invitation := &restapi.UserInvitation{Email: userInvitationEmail, GroupID: "group-1"}
+
+		testHelper := NewTestHelper[*restapi.UserInvitation](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, invitation)
+
+		require.NoError(t, err)
+		require.Equal(t, userInvitationEmail, resourceData.Id())
+		require.Equal(t, userInvitationEmail, resourceData.Get(UserInvitationFieldEmail))
+		require.Equal(t, "group-1", resourceData.Get(UserInvitationFieldGroupID))
+		require.False(t, resourceData.Get(UserInvitationFieldAccepted).(bool))
+		require.Empty(t, resourceData.Get(UserInvitationFieldUserID))
+	}
+}
+
+func (test *userInvitationTest) createTestShouldKeepGroupInTerraformStateWhenInvitationIsAccepted() func(t *testing.T) {
+	return func(t *testing.T) {
+		userID := "user-id"
+		invitation := &restapi.UserInvitation{Email: userInvitationEmail, Accepted: true, UserID: &userID}
+
+		testHelper := NewTestHelper[*restapi.UserInvitation](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, UserInvitationFieldGroupID, "group-1")
+
+		err := test.resourceHandle.UpdateState(resourceData, invitation)
+
+		require.NoError(t, err)
+		require.Equal(t, "group-1", resourceData.Get(UserInvitationFieldGroupID))
+		require.True(t, resourceData.Get(UserInvitationFieldAccepted).(bool))
+		require.Equal(t, userID, resourceData.Get(UserInvitationFieldUserID))
+	}
+}
+
+func (test *userInvitationTest) createTestShouldMapTerraformResourceStateToModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.UserInvitation](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, UserInvitationFieldEmail, userInvitationEmail)
+		setValueOnResourceData(t, resourceData, UserInvitationFieldGroupID, "group-1")
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.UserInvitation{Email: userInvitationEmail, GroupID: "group-1"}, result)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
 	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,6 +21,8 @@ const (
 	WebsiteAlertConfigFieldWebsiteID = "website_id"
 	//WebsiteAlertConfigFieldDescription constant value for field description of resource instana_website_alert_config
 	WebsiteAlertConfigFieldDescription = "description"
+	//WebsiteAlertConfigFieldEnabled constant value for field enabled of resource instana_website_alert_config
+	WebsiteAlertConfigFieldEnabled = "enabled"
 	//WebsiteAlertConfigFieldGranularity constant value for field granularity of resource instana_website_alert_config
 	WebsiteAlertConfigFieldGranularity = "granularity"
 	//WebsiteAlertConfigFieldName constant value for field name of resource instana_website_alert_config
@@ -273,6 +277,12 @@ var (
 		Default:     false,
 		Description: "Optional flag to indicate whether also an Incident is triggered or not. The default is false",
 	}
+	websiteAlertConfigSchemaEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Optional flag to indicate whether the website alert config is enabled or not. Changes of this flag are applied via the enable/disable endpoints without rewriting the full configuration. The default is true",
+	}
 	websiteAlertConfigSchemaWebsiteID = &schema.Schema{
 		Type:         schema.TypeString,
 		Required:     true,
@@ -324,11 +334,13 @@ var websiteAlertConfigResourceSchema = map[string]*schema.Schema{
 	WebsiteAlertConfigFieldAlertChannelIDs: websiteAlertConfigSchemaAlertChannelIDs,
 	DefaultCustomPayloadFieldsName:         buildCustomPayloadFields(),
 	WebsiteAlertConfigFieldDescription:     websiteAlertConfigSchemaDescription,
+	WebsiteAlertConfigFieldEnabled:         websiteAlertConfigSchemaEnabled,
 	WebsiteAlertConfigFieldGranularity:     websiteAlertConfigSchemaGranularity,
 	WebsiteAlertConfigFieldName:            websiteAlertConfigSchemaName,
 	WebsiteAlertConfigFieldRule:            websiteAlertConfigSchemaRule,
 	WebsiteAlertConfigFieldSeverity:        websiteAlertConfigSchemaSeverity,
 	WebsiteAlertConfigFieldTagFilter:       websiteAlertConfigSchemaTagFilter,
+	ResourceFieldRestoreVersion:            restoreVersionSchema,
 	ResourceFieldThreshold:                 thresholdSchema,
 	WebsiteAlertConfigFieldTimeThreshold:   websiteAlertConfigSchemaTimeThreshold,
 	WebsiteAlertConfigFieldTriggering:      websiteAlertConfigSchemaTriggering,
@@ -340,9 +352,10 @@ func NewWebsiteAlertConfigResourceHandle() ResourceHandle[*restapi.WebsiteAlertC
 	return &websiteAlertConfigResource{
 		metaData: ResourceMetaData{
 			ResourceName:     ResourceInstanaWebsiteAlertConfig,
-			Schema:           websiteAlertConfigResourceSchema,
+			Schema:           withBaselineRefreshTriggerSchema(websiteAlertConfigResourceSchema),
 			SkipIDGeneration: true,
 			SchemaVersion:    1,
+			CustomizeDiff:    validateRestoreVersionChange,
 		},
 	}
 }
@@ -373,6 +386,31 @@ func (r *websiteAlertConfigResource) SetComputedFields(_ *schema.ResourceData) e
 	return nil
 }
 
+// Update implementation of the interface UpdateHandler. When the restore version has changed the referenced version is restored instead of rewriting the configuration. When only the enabled flag and/or the baseline refresh trigger have changed the full configuration is not rewritten. Changes of the enabled flag are applied via the dedicated enable/disable endpoints
+func (r *websiteAlertConfigResource) Update(d *schema.ResourceData, api restapi.InstanaAPI, config *restapi.WebsiteAlertConfig) (*restapi.WebsiteAlertConfig, error) {
+	restResourceProvider := func() restapi.AlertConfigRestResource[*restapi.WebsiteAlertConfig] { return api.WebsiteAlertConfig() }
+	if restored, ok, err := restoreVersionOnChange(d, config, restResourceProvider); ok {
+		return restored, err
+	}
+	if d.HasChangesExcept(WebsiteAlertConfigFieldEnabled, ResourceFieldBaselineRefreshTrigger, ResourceFieldRestoreVersion) {
+		return api.WebsiteAlertConfig().Update(config)
+	}
+	if d.HasChange(WebsiteAlertConfigFieldEnabled) {
+		return api.WebsiteAlertConfig().UpdateEnabledState(config)
+	}
+	return config, nil
+}
+
+// PostUpdate triggers the recalculation of the historic baseline when the baseline refresh trigger has changed and returns the refreshed alert configuration
+func (r *websiteAlertConfigResource) PostUpdate(d *schema.ResourceData, api restapi.InstanaAPI, config *restapi.WebsiteAlertConfig) (*restapi.WebsiteAlertConfig, error) {
+	return refreshBaselineOnTriggerChange(d, config, api.WebsiteAlertConfig)
+}
+
+// PostApply implementation of the interface PostApplyHook. Reports a warning when a version of the alert configuration has been restored
+func (r *websiteAlertConfigResource) PostApply(d *schema.ResourceData, _ restapi.InstanaAPI, _ *restapi.WebsiteAlertConfig) diag.Diagnostics {
+	return restoreVersionWarning(d)
+}
+
 func (r *websiteAlertConfigResource) UpdateState(d *schema.ResourceData, config *restapi.WebsiteAlertConfig) error {
 	severity, err := ConvertSeverityFromInstanaAPIToTerraformRepresentation(config.Severity)
 	if err != nil {
@@ -391,6 +429,7 @@ func (r *websiteAlertConfigResource) UpdateState(d *schema.ResourceData, config
 		WebsiteAlertConfigFieldAlertChannelIDs: config.AlertChannelIDs,
 		DefaultCustomPayloadFieldsName:         mapCustomPayloadFieldsToSchema(config),
 		WebsiteAlertConfigFieldDescription:     config.Description,
+		WebsiteAlertConfigFieldEnabled:         config.Enabled == nil || *config.Enabled,
 		WebsiteAlertConfigFieldGranularity:     config.Granularity,
 		WebsiteAlertConfigFieldName:            config.Name,
 		WebsiteAlertConfigFieldRule:            r.mapRuleToSchema(config),
@@ -498,6 +537,7 @@ func (r *websiteAlertConfigResource) MapStateToDataObject(d *schema.ResourceData
 		AlertChannelIDs:       ReadStringSetParameterFromResource(d, WebsiteAlertConfigFieldAlertChannelIDs),
 		CustomerPayloadFields: customPayloadFields,
 		Description:           d.Get(WebsiteAlertConfigFieldDescription).(string),
+		Enabled:               utils.BoolPtr(d.Get(WebsiteAlertConfigFieldEnabled).(bool)),
 		Granularity:           restapi.Granularity(d.Get(WebsiteAlertConfigFieldGranularity).(int)),
 		Name:                  d.Get(WebsiteMonitoringConfigFieldName).(string),
 		Rule:                  *r.mapRuleFromSchema(d),
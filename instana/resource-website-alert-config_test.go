@@ -3,15 +3,20 @@ package instana_test
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 )
@@ -149,6 +154,153 @@ func (test *websiteAlertConfigTest) run(t *testing.T) {
 	t.Run(fmt.Sprintf("%s should fail to map state to model when severity is invalid", ResourceInstanaWebsiteAlertConfig), test.createTestCaseShouldFailToMapTerraformResourceStateToModelWhenSeverityIsNotValid())
 	t.Run(fmt.Sprintf("%s should fail to map state to model when tag filter expression is invalid", ResourceInstanaWebsiteAlertConfig), test.createTestCaseShouldFailToMapTerraformResourceStateToModelWhenTagFilterIsNotValid())
 	t.Run(fmt.Sprintf("%s should return errr when converting state to data model and custom field is not valid", ResourceInstanaWebsiteAlertConfig), test.shouldReturnErrorWhenConvertingStateToDataModelAndCustomFieldIsNotValid)
+	t.Run(fmt.Sprintf("%s should only enable or disable alert config when only enabled flag changed", ResourceInstanaWebsiteAlertConfig), test.createTestShouldOnlyEnableOrDisableWhenOnlyEnabledFlagChanged())
+	t.Run(fmt.Sprintf("%s should execute full update of alert config when other fields changed", ResourceInstanaWebsiteAlertConfig), test.createTestShouldExecuteFullUpdateWhenOtherFieldsChanged())
+	t.Run(fmt.Sprintf("%s should not update alert config when only baseline refresh trigger changed", ResourceInstanaWebsiteAlertConfig), test.createTestShouldNotUpdateWhenOnlyBaselineRefreshTriggerChanged())
+	t.Run(fmt.Sprintf("%s should update baseline after update when baseline refresh trigger changed", ResourceInstanaWebsiteAlertConfig), test.createTestShouldUpdateBaselineWhenBaselineRefreshTriggerChanged())
+	t.Run(fmt.Sprintf("%s should not update baseline after update when baseline refresh trigger did not change", ResourceInstanaWebsiteAlertConfig), test.createTestShouldNotUpdateBaselineWhenBaselineRefreshTriggerDidNotChange())
+	t.Run(fmt.Sprintf("%s should return error when baseline update fails", ResourceInstanaWebsiteAlertConfig), test.createTestShouldReturnErrorWhenBaselineUpdateFails())
+	t.Run(fmt.Sprintf("%s should restore version when restore version changed", ResourceInstanaWebsiteAlertConfig), test.createTestShouldRestoreVersionWhenRestoreVersionChanged())
+	t.Run(fmt.Sprintf("%s should report warning after restore of version", ResourceInstanaWebsiteAlertConfig), test.createTestShouldReportWarningAfterRestoreOfVersion())
+}
+
+func (test *websiteAlertConfigTest) createTestShouldOnlyEnableOrDisableWhenOnlyEnabledFlagChanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			resourceData := createResourceDataWithChangesForResourceHandle(t, test.resourceHandle, "test-id", map[string]interface{}{
+				WebsiteAlertConfigFieldName:    "name",
+				WebsiteAlertConfigFieldEnabled: true,
+			}, map[string]interface{}{
+				WebsiteAlertConfigFieldName:    "name",
+				WebsiteAlertConfigFieldEnabled: false,
+			})
+			config := &restapi.WebsiteAlertConfig{ID: "test-id", Enabled: utils.BoolPtr(false)}
+			refreshedConfig := &restapi.WebsiteAlertConfig{ID: "test-id", Name: "refreshed", Enabled: utils.BoolPtr(false)}
+			mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.WebsiteAlertConfig](ctrl)
+
+			mockInstanaAPI.EXPECT().WebsiteAlertConfig().Return(mockRestResource).Times(1)
+			mockRestResource.EXPECT().UpdateEnabledState(config).Return(refreshedConfig, nil).Times(1)
+
+			sut := test.resourceHandle.(UpdateHandler[*restapi.WebsiteAlertConfig])
+			result, err := sut.Update(resourceData, providerMeta.InstanaAPI, config)
+
+			require.NoError(t, err)
+			require.Equal(t, refreshedConfig, result)
+		})
+	}
+}
+
+func (test *websiteAlertConfigTest) createTestShouldExecuteFullUpdateWhenOtherFieldsChanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			resourceData := createResourceDataWithChangesForResourceHandle(t, test.resourceHandle, "test-id", map[string]interface{}{
+				WebsiteAlertConfigFieldName:    "name",
+				WebsiteAlertConfigFieldEnabled: true,
+			}, map[string]interface{}{
+				WebsiteAlertConfigFieldName:    "updated",
+				WebsiteAlertConfigFieldEnabled: false,
+			})
+			config := &restapi.WebsiteAlertConfig{ID: "test-id", Name: "name", Enabled: utils.BoolPtr(false)}
+			updatedConfig := &restapi.WebsiteAlertConfig{ID: "test-id", Name: "updated", Enabled: utils.BoolPtr(false)}
+			mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.WebsiteAlertConfig](ctrl)
+
+			mockInstanaAPI.EXPECT().WebsiteAlertConfig().Return(mockRestResource).Times(1)
+			mockRestResource.EXPECT().Update(config).Return(updatedConfig, nil).Times(1)
+
+			sut := test.resourceHandle.(UpdateHandler[*restapi.WebsiteAlertConfig])
+			result, err := sut.Update(resourceData, providerMeta.InstanaAPI, config)
+
+			require.NoError(t, err)
+			require.Equal(t, updatedConfig, result)
+		})
+	}
+}
+
+func (test *websiteAlertConfigTest) createTestShouldNotUpdateWhenOnlyBaselineRefreshTriggerChanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			resourceData := createResourceDataWithChangesForResourceHandle(t, test.resourceHandle, "test-id", map[string]interface{}{
+				WebsiteAlertConfigFieldName:         "name",
+				ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "value"},
+			}, map[string]interface{}{
+				WebsiteAlertConfigFieldName:         "name",
+				ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "other"},
+			})
+			config := &restapi.WebsiteAlertConfig{ID: "test-id", Name: "name", Enabled: utils.BoolPtr(true)}
+
+			sut := test.resourceHandle.(UpdateHandler[*restapi.WebsiteAlertConfig])
+			result, err := sut.Update(resourceData, providerMeta.InstanaAPI, config)
+
+			require.NoError(t, err)
+			require.Equal(t, config, result)
+		})
+	}
+}
+
+func (test *websiteAlertConfigTest) createTestShouldUpdateBaselineWhenBaselineRefreshTriggerChanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			resourceData := testHelper.CreateResourceDataForResourceHandle(test.resourceHandle, map[string]interface{}{
+				ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "value"},
+			})
+			updatedConfig := &restapi.WebsiteAlertConfig{ID: "test-id", Name: "updated"}
+			refreshedConfig := &restapi.WebsiteAlertConfig{ID: "test-id", Name: "refreshed"}
+			mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.WebsiteAlertConfig](ctrl)
+
+			mockInstanaAPI.EXPECT().WebsiteAlertConfig().Return(mockRestResource).Times(1)
+			mockRestResource.EXPECT().UpdateBaseline("test-id").Return(nil).Times(1)
+			mockRestResource.EXPECT().GetOne("test-id").Return(refreshedConfig, nil).Times(1)
+
+			sut := test.resourceHandle.(PostUpdateHook[*restapi.WebsiteAlertConfig])
+			result, err := sut.PostUpdate(resourceData, providerMeta.InstanaAPI, updatedConfig)
+
+			require.NoError(t, err)
+			require.Equal(t, refreshedConfig, result)
+		})
+	}
+}
+
+func (test *websiteAlertConfigTest) createTestShouldNotUpdateBaselineWhenBaselineRefreshTriggerDidNotChange() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+			updatedConfig := &restapi.WebsiteAlertConfig{ID: "test-id", Name: "updated"}
+
+			sut := test.resourceHandle.(PostUpdateHook[*restapi.WebsiteAlertConfig])
+			result, err := sut.PostUpdate(resourceData, providerMeta.InstanaAPI, updatedConfig)
+
+			require.NoError(t, err)
+			require.Equal(t, updatedConfig, result)
+		})
+	}
+}
+
+func (test *websiteAlertConfigTest) createTestShouldReturnErrorWhenBaselineUpdateFails() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			resourceData := testHelper.CreateResourceDataForResourceHandle(test.resourceHandle, map[string]interface{}{
+				ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "value"},
+			})
+			updatedConfig := &restapi.WebsiteAlertConfig{ID: "test-id", Name: "updated"}
+			expectedError := errors.New("test")
+			mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.WebsiteAlertConfig](ctrl)
+
+			mockInstanaAPI.EXPECT().WebsiteAlertConfig().Return(mockRestResource).Times(1)
+			mockRestResource.EXPECT().UpdateBaseline("test-id").Return(expectedError).Times(1)
+
+			sut := test.resourceHandle.(PostUpdateHook[*restapi.WebsiteAlertConfig])
+			_, err := sut.PostUpdate(resourceData, providerMeta.InstanaAPI, updatedConfig)
+
+			require.Error(t, err)
+			require.Equal(t, expectedError, err)
+		})
+	}
 }
 
 func (test *websiteAlertConfigTest) createIntegrationTest() func(t *testing.T) {
@@ -225,6 +377,7 @@ func (test *websiteAlertConfigTest) createIntegrationTestStep(httpPort int, iter
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldDescription, "test-alert-description"),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldTriggering, falseAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldEnabled, trueAsString),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id-1"),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldAlertChannelIDs+".1", "alert-channel-id-2"),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldGranularity, "600000"),
@@ -557,6 +710,7 @@ func (test *websiteAlertConfigTest) createTestShouldUpdateTerraformResourceState
 			Threshold:           thresholdTestPair.input,
 			TimeThreshold:       timeThresholdTestPair.input,
 			Triggering:          true,
+			Enabled:             utils.BoolPtr(false),
 		}
 
 		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
@@ -589,6 +743,7 @@ func (test *websiteAlertConfigTest) createTestShouldUpdateTerraformResourceState
 		test.requireWebsiteAlertConfigThresholdSetOnSchema(t, thresholdTestPair.expected, resourceData)
 		require.Equal(t, timeThresholdTestPair.expected, resourceData.Get(WebsiteAlertConfigFieldTimeThreshold))
 		require.True(t, resourceData.Get(WebsiteAlertConfigFieldTriggering).(bool))
+		require.False(t, resourceData.Get(WebsiteAlertConfigFieldEnabled).(bool))
 	}
 }
 
@@ -941,6 +1096,7 @@ func (test *websiteAlertConfigTest) createTestShouldMapTerraformResourceStateToM
 			Threshold:           thresholdTestPair.expected,
 			TimeThreshold:       timeThresholdTestPair.expected,
 			Triggering:          true,
+			Enabled:             utils.BoolPtr(false),
 		}
 
 		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
@@ -968,6 +1124,7 @@ func (test *websiteAlertConfigTest) createTestShouldMapTerraformResourceStateToM
 		setValueOnResourceData(t, resourceData, ResourceFieldThreshold, thresholdTestPair.input)
 		setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldTimeThreshold, timeThresholdTestPair.input)
 		setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldTriggering, true)
+		setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldEnabled, false)
 		resourceData.SetId(websiteAlertConfigID)
 
 		result, err := sut.MapStateToDataObject(resourceData)
@@ -1033,3 +1190,48 @@ func (test *websiteAlertConfigTest) shouldReturnErrorWhenConvertingStateToDataMo
 	require.Error(t, err)
 	require.ErrorContains(t, err, "either a static string value or a dynamic value must")
 }
+
+func (test *websiteAlertConfigTest) createTestShouldRestoreVersionWhenRestoreVersionChanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+		testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+			resourceData := createResourceDataWithChangesForResourceHandle(t, test.resourceHandle, "test-id", map[string]interface{}{
+				WebsiteAlertConfigFieldName: "name",
+			}, map[string]interface{}{
+				WebsiteAlertConfigFieldName: "name",
+				ResourceFieldRestoreVersion: 1700000001000,
+			})
+			config := &restapi.WebsiteAlertConfig{ID: "test-id", Name: "name", Enabled: utils.BoolPtr(true)}
+			restoredConfig := &restapi.WebsiteAlertConfig{ID: "test-id", Name: "restored", Enabled: utils.BoolPtr(true)}
+			mockRestResource := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.WebsiteAlertConfig](ctrl)
+
+			mockInstanaAPI.EXPECT().WebsiteAlertConfig().Return(mockRestResource).Times(1)
+			mockRestResource.EXPECT().RestoreVersion("test-id", int64(1700000001000)).Return(nil).Times(1)
+			mockRestResource.EXPECT().GetOne("test-id").Return(restoredConfig, nil).Times(1)
+
+			sut := test.resourceHandle.(UpdateHandler[*restapi.WebsiteAlertConfig])
+			result, err := sut.Update(resourceData, providerMeta.InstanaAPI, config)
+
+			require.NoError(t, err)
+			require.Equal(t, restoredConfig, result)
+		})
+	}
+}
+
+func (test *websiteAlertConfigTest) createTestShouldReportWarningAfterRestoreOfVersion() func(t *testing.T) {
+	return func(t *testing.T) {
+		resourceData := createResourceDataWithChangesForResourceHandle(t, test.resourceHandle, "test-id", map[string]interface{}{
+			WebsiteAlertConfigFieldName: "name",
+		}, map[string]interface{}{
+			WebsiteAlertConfigFieldName: "name",
+			ResourceFieldRestoreVersion: 1700000001000,
+		})
+
+		sut := test.resourceHandle.(PostApplyHook[*restapi.WebsiteAlertConfig])
+		result := sut.PostApply(resourceData, nil, &restapi.WebsiteAlertConfig{ID: "test-id"})
+
+		require.Len(t, result, 1)
+		require.Equal(t, diag.Warning, result[0].Severity)
+		require.Equal(t, "alert configuration test-id has been restored to version 1700000001000", result[0].Summary)
+	}
+}
@@ -48,8 +48,11 @@ func NewWebsiteMonitoringConfigResourceHandle() ResourceHandle[*restapi.WebsiteM
 		metaData: ResourceMetaData{
 			ResourceName: ResourceInstanaWebsiteMonitoringConfig,
 			Schema: map[string]*schema.Schema{
-				WebsiteMonitoringConfigFieldName:    WebsiteMonitoringConfigSchemaName,
-				WebsiteMonitoringConfigFieldAppName: WebsiteMonitoringConfigSchemaAppName,
+				WebsiteMonitoringConfigFieldName:              WebsiteMonitoringConfigSchemaName,
+				WebsiteMonitoringConfigFieldAppName:           WebsiteMonitoringConfigSchemaAppName,
+				MonitoringPrivacySettingsFieldGeoLocation:     monitoringPrivacySettingsSchemaGeoLocation,
+				MonitoringPrivacySettingsFieldIPMasking:       monitoringPrivacySettingsSchemaIPMasking,
+				MonitoringPrivacySettingsFieldGeoMappingRules: monitoringPrivacySettingsSchemaGeoMappingRules,
 			},
 			SchemaVersion: 1,
 		},
@@ -78,22 +81,35 @@ func (r *websiteMonitoringConfigResource) GetRestResource(api restapi.InstanaAPI
 	return api.WebsiteMonitoringConfig()
 }
 
+// PostRead reads the privacy settings of the website. Only the settings which are configured in the terraform state are
+// requested from the API to avoid unnecessary API calls.
+func (r *websiteMonitoringConfigResource) PostRead(d *schema.ResourceData, api restapi.InstanaAPI, config *restapi.WebsiteMonitoringConfig) (*restapi.WebsiteMonitoringConfig, error) {
+	var err error
+	config.MonitoringPrivacySettings, err = api.WebsiteMonitoringConfig().GetPrivacySettings(config.ID, selectMonitoringPrivacySettingsFromState(d))
+	if err != nil {
+		return config, err
+	}
+	return config, nil
+}
+
 func (r *websiteMonitoringConfigResource) SetComputedFields(_ *schema.ResourceData) error {
 	return nil
 }
 
 func (r *websiteMonitoringConfigResource) UpdateState(d *schema.ResourceData, config *restapi.WebsiteMonitoringConfig) error {
+	data := mapMonitoringPrivacySettingsToState(config.MonitoringPrivacySettings)
+	data[WebsiteMonitoringConfigFieldName] = config.Name
+	data[WebsiteMonitoringConfigFieldAppName] = config.AppName
+
 	d.SetId(config.ID)
-	return tfutils.UpdateState(d, map[string]interface{}{
-		WebsiteMonitoringConfigFieldName:    config.Name,
-		WebsiteMonitoringConfigFieldAppName: config.AppName,
-	})
+	return tfutils.UpdateState(d, data)
 }
 
 func (r *websiteMonitoringConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.WebsiteMonitoringConfig, error) {
 	return &restapi.WebsiteMonitoringConfig{
-		ID:   d.Id(),
-		Name: d.Get(WebsiteMonitoringConfigFieldName).(string),
+		ID:                        d.Id(),
+		Name:                      d.Get(WebsiteMonitoringConfigFieldName).(string),
+		MonitoringPrivacySettings: mapMonitoringPrivacySettingsFromState(d),
 	}, nil
 }
 
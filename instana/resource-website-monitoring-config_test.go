@@ -2,22 +2,38 @@ package instana_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
 	"github.com/gorilla/mux"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
 )
 
 const websiteMonitoringConfigTerraformTemplate = `
 resource "instana_website_monitoring_config" "example_website_monitoring_config" {
 	name = "name %d"
+
+	geo_location {
+		geo_detail_removal = "REMOVE_COORDINATES"
+	}
+
+	ip_masking {
+		mode = "STRICT"
+	}
+
+	geo_mapping_rules {
+		csv = "10.%d.0.0/16,Europe,Germany"
+	}
 }
 `
 
@@ -35,24 +51,41 @@ func TestCRUDOfWebsiteMonitoringConfiguration(t *testing.T) {
 		ProviderFactories: testProviderFactory,
 		Steps: []resource.TestStep{
 			{
-				Config: appendProviderConfig(fmt.Sprintf(websiteMonitoringConfigTerraformTemplate, 0), server.GetPort()),
+				Config: appendProviderConfig(fmt.Sprintf(websiteMonitoringConfigTerraformTemplate, 0, 0), server.GetPort()),
 				Check:  resource.ComposeTestCheckFunc(createWebsiteMonitoringConfigTestCheckFunctions(0)...),
 			},
-			testStepImport(websiteMonitoringConfigDefinition),
+			testStepImportWebsiteMonitoringConfig(),
 			{
-				Config: appendProviderConfig(fmt.Sprintf(websiteMonitoringConfigTerraformTemplate, 1), server.GetPort()),
+				Config: appendProviderConfig(fmt.Sprintf(websiteMonitoringConfigTerraformTemplate, 1, 1), server.GetPort()),
 				Check:  resource.ComposeTestCheckFunc(createWebsiteMonitoringConfigTestCheckFunctions(1)...),
 			},
-			testStepImport(websiteMonitoringConfigDefinition),
+			testStepImportWebsiteMonitoringConfig(),
 		},
 	})
 }
 
+// testStepImportWebsiteMonitoringConfig privacy settings are only read when they are configured in the state and are therefore not available after the import
+func testStepImportWebsiteMonitoringConfig() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      websiteMonitoringConfigDefinition,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			MonitoringPrivacySettingsFieldGeoLocation,
+			MonitoringPrivacySettingsFieldIPMasking,
+			MonitoringPrivacySettingsFieldGeoMappingRules,
+		},
+	}
+}
+
 func createWebsiteMonitoringConfigTestCheckFunctions(iteration int) []resource.TestCheckFunc {
 	testCheckFunctions := []resource.TestCheckFunc{
 		resource.TestCheckResourceAttrSet(websiteMonitoringConfigDefinition, "id"),
 		resource.TestCheckResourceAttr(websiteMonitoringConfigDefinition, WebsiteMonitoringConfigFieldName, fmt.Sprintf("name %d", iteration)),
 		resource.TestCheckResourceAttr(websiteMonitoringConfigDefinition, WebsiteMonitoringConfigFieldAppName, fmt.Sprintf("name %d", iteration)),
+		resource.TestCheckResourceAttr(websiteMonitoringConfigDefinition, fmt.Sprintf("%s.0.%s", MonitoringPrivacySettingsFieldGeoLocation, MonitoringPrivacySettingsFieldGeoDetailRemoval), "REMOVE_COORDINATES"),
+		resource.TestCheckResourceAttr(websiteMonitoringConfigDefinition, fmt.Sprintf("%s.0.%s", MonitoringPrivacySettingsFieldIPMasking, MonitoringPrivacySettingsFieldIPMaskingMode), "STRICT"),
+		resource.TestCheckResourceAttr(websiteMonitoringConfigDefinition, fmt.Sprintf("%s.0.%s", MonitoringPrivacySettingsFieldGeoMappingRules, MonitoringPrivacySettingsFieldGeoMappingRulesCSV), fmt.Sprintf("10.%d.0.0/16,Europe,Germany", iteration)),
 	}
 	return testCheckFunctions
 }
@@ -62,8 +95,9 @@ func newWebsiteMonitoringConfigTestServer() *websiteMonitoringConfigTestServer {
 }
 
 type websiteMonitoringConfigTestServer struct {
-	httpServer  testutils.TestHTTPServer
-	serverState *restapi.WebsiteMonitoringConfig
+	httpServer      testutils.TestHTTPServer
+	serverState     *restapi.WebsiteMonitoringConfig
+	privacySettings map[string]string
 }
 
 func (s *websiteMonitoringConfigTestServer) Start() {
@@ -72,6 +106,15 @@ func (s *websiteMonitoringConfigTestServer) Start() {
 	s.httpServer.AddRoute(http.MethodPut, websiteMonitoringConfigApiPath, s.onPut)
 	s.httpServer.AddRoute(http.MethodDelete, websiteMonitoringConfigApiPath, testutils.EchoHandlerFunc)
 	s.httpServer.AddRoute(http.MethodGet, websiteMonitoringConfigApiPath, s.onGet)
+	s.privacySettings = map[string]string{
+		"geo-location":      `{"geoDetailRemoval":"NO_REMOVAL"}`,
+		"ip-masking":        `{"ipMasking":"DEFAULT"}`,
+		"geo-mapping-rules": "",
+	}
+	for subResource := range s.privacySettings {
+		s.httpServer.AddRoute(http.MethodPut, websiteMonitoringConfigApiPath+"/"+subResource, s.onPutPrivacySetting(subResource))
+		s.httpServer.AddRoute(http.MethodGet, websiteMonitoringConfigApiPath+"/"+subResource, s.onGetPrivacySetting(subResource))
+	}
 	s.httpServer.Start()
 }
 
@@ -163,6 +206,28 @@ func (s *websiteMonitoringConfigTestServer) onGet(w http.ResponseWriter, r *http
 	}
 }
 
+func (s *websiteMonitoringConfigTestServer) onPutPrivacySetting(subResource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.privacySettings[subResource] = string(data)
+		s.onGetPrivacySetting(subResource)(w, r)
+	}
+}
+
+func (s *websiteMonitoringConfigTestServer) onGetPrivacySetting(subResource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(s.privacySettings[subResource]))
+		if err != nil {
+			fmt.Printf("failed to write response; %s\n", err)
+		}
+	}
+}
+
 func (s *websiteMonitoringConfigTestServer) Close() {
 	if s.httpServer != nil {
 		s.httpServer.Close()
@@ -177,6 +242,9 @@ func TestResourceWebsiteMonitoringConfigDefinition(t *testing.T) {
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteMonitoringConfigFieldName)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(WebsiteMonitoringConfigFieldAppName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(MonitoringPrivacySettingsFieldGeoLocation)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(MonitoringPrivacySettingsFieldIPMasking)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(MonitoringPrivacySettingsFieldGeoMappingRules)
 }
 
 func TestShouldUpdateResourceStateForWebsiteMonitoringConfig(t *testing.T) {
@@ -197,6 +265,33 @@ func TestShouldUpdateResourceStateForWebsiteMonitoringConfig(t *testing.T) {
 	require.Equal(t, "id", resourceData.Id(), "id should be equal")
 	require.Equal(t, resourceName, resourceData.Get(WebsiteMonitoringConfigFieldName))
 	require.Equal(t, appname, resourceData.Get(WebsiteMonitoringConfigFieldAppName))
+	require.Empty(t, resourceData.Get(MonitoringPrivacySettingsFieldGeoLocation))
+	require.Empty(t, resourceData.Get(MonitoringPrivacySettingsFieldIPMasking))
+	require.Empty(t, resourceData.Get(MonitoringPrivacySettingsFieldGeoMappingRules))
+}
+
+func TestShouldUpdateResourceStateWithPrivacySettingsForWebsiteMonitoringConfig(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	geoMappingRules := "10.0.0.0/8,Europe,Germany\r\n"
+	data := restapi.WebsiteMonitoringConfig{
+		ID:      "id",
+		Name:    resourceName,
+		AppName: "appname",
+		MonitoringPrivacySettings: restapi.MonitoringPrivacySettings{
+			GeoLocation:     &restapi.GeoLocationConfiguration{GeoDetailRemoval: restapi.GeoDetailRemovalNoRemoval},
+			IPMasking:       &restapi.IPMaskingConfiguration{IPMasking: restapi.IPMaskingDefault},
+			GeoMappingRules: &geoMappingRules,
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldGeoDetailRemoval: "NO_REMOVAL"}}, resourceData.Get(MonitoringPrivacySettingsFieldGeoLocation))
+	require.Equal(t, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldIPMaskingMode: "DEFAULT"}}, resourceData.Get(MonitoringPrivacySettingsFieldIPMasking))
+	require.Equal(t, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldGeoMappingRulesCSV: "10.0.0.0/8,Europe,Germany"}}, resourceData.Get(MonitoringPrivacySettingsFieldGeoMappingRules))
 }
 
 func TestShouldConvertStateOfWebsiteMonitoringConfigToDataModel(t *testing.T) {
@@ -212,6 +307,67 @@ func TestShouldConvertStateOfWebsiteMonitoringConfigToDataModel(t *testing.T) {
 	require.IsType(t, &restapi.WebsiteMonitoringConfig{}, model)
 	require.Equal(t, "id", model.GetIDForResourcePath())
 	require.Equal(t, resourceName, model.Name)
+	require.Equal(t, restapi.MonitoringPrivacySettings{}, model.MonitoringPrivacySettings)
+}
+
+func TestShouldConvertStateOfWebsiteMonitoringConfigWithPrivacySettingsToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, WebsiteMonitoringConfigFieldName, "name")
+	setValueOnResourceData(t, resourceData, MonitoringPrivacySettingsFieldGeoLocation, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldGeoDetailRemoval: "REMOVE_ALL"}})
+	setValueOnResourceData(t, resourceData, MonitoringPrivacySettingsFieldIPMasking, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldIPMaskingMode: "REMOVE_ALL_DETAILS"}})
+	setValueOnResourceData(t, resourceData, MonitoringPrivacySettingsFieldGeoMappingRules, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldGeoMappingRulesCSV: "csv"}})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	geoMappingRules := "csv"
+	require.Equal(t, restapi.MonitoringPrivacySettings{
+		GeoLocation:     &restapi.GeoLocationConfiguration{GeoDetailRemoval: restapi.GeoDetailRemovalRemoveAll},
+		IPMasking:       &restapi.IPMaskingConfiguration{IPMasking: restapi.IPMaskingRemoveAllDetails},
+		GeoMappingRules: &geoMappingRules,
+	}, model.MonitoringPrivacySettings)
+}
+
+func TestShouldOnlyReadPrivacySettingsOfWebsiteMonitoringConfigWhichAreConfiguredInState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		setValueOnResourceData(t, resourceData, MonitoringPrivacySettingsFieldIPMasking, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldIPMaskingMode: "STRICT"}})
+		config := &restapi.WebsiteMonitoringConfig{ID: "id", Name: resourceName}
+		privacySettings := restapi.MonitoringPrivacySettings{IPMasking: &restapi.IPMaskingConfiguration{IPMasking: restapi.IPMaskingStrict}}
+		mockRestResource := mocks.NewMockWebsiteMonitoringConfigRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().WebsiteMonitoringConfig().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetPrivacySettings("id", restapi.MonitoringPrivacySettingsSelection{IPMasking: true}).Return(privacySettings, nil).Times(1)
+
+		sut := resourceHandle.(PostReadHook[*restapi.WebsiteMonitoringConfig])
+		result, err := sut.PostRead(resourceData, providerMeta.InstanaAPI, config)
+
+		require.NoError(t, err)
+		require.Equal(t, privacySettings, result.MonitoringPrivacySettings)
+	})
+}
+
+func TestShouldReturnErrorWhenPrivacySettingsOfWebsiteMonitoringConfigCannotBeRead(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		expectedError := errors.New("test")
+		mockRestResource := mocks.NewMockWebsiteMonitoringConfigRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().WebsiteMonitoringConfig().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().GetPrivacySettings("id", restapi.MonitoringPrivacySettingsSelection{}).Return(restapi.MonitoringPrivacySettings{}, expectedError).Times(1)
+
+		sut := resourceHandle.(PostReadHook[*restapi.WebsiteMonitoringConfig])
+		_, err := sut.PostRead(resourceData, providerMeta.InstanaAPI, &restapi.WebsiteMonitoringConfig{ID: "id"})
+
+		require.ErrorIs(t, err, expectedError)
+	})
 }
 
 func TestWebsiteMonitoringConfigkShouldHaveSchemaVersionZero(t *testing.T) {
@@ -0,0 +1,210 @@
+package instana
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaWebsiteSourceMapConfig the name of the terraform-provider-instana resource to manage the source map files of source map upload configurations of websites
+const ResourceInstanaWebsiteSourceMapConfig = "instana_website_sourcemap_config"
+
+const (
+	//WebsiteSourceMapConfigFieldWebsiteID constant value for the schema field website_id
+	WebsiteSourceMapConfigFieldWebsiteID = "website_id"
+	//WebsiteSourceMapConfigFieldSourceMapConfigID constant value for the schema field sourcemap_config_id
+	WebsiteSourceMapConfigFieldSourceMapConfigID = "sourcemap_config_id"
+	//WebsiteSourceMapConfigFieldSourceMap constant value for the schema field source_map
+	WebsiteSourceMapConfigFieldSourceMap = "source_map"
+	//WebsiteSourceMapConfigFieldSourceMapFile constant value for the schema field source_map.file
+	WebsiteSourceMapConfigFieldSourceMapFile = "file"
+	//WebsiteSourceMapConfigFieldSourceMapURL constant value for the schema field source_map.url
+	WebsiteSourceMapConfigFieldSourceMapURL = "url"
+	//WebsiteSourceMapConfigFieldSourceMapFileFormat constant value for the schema field source_map.file_format
+	WebsiteSourceMapConfigFieldSourceMapFileFormat = "file_format"
+	//WebsiteSourceMapConfigFieldSourceMapHash constant value for the computed schema field source_map_hash
+	WebsiteSourceMapConfigFieldSourceMapHash = "source_map_hash"
+)
+
+var (
+	websiteSourceMapConfigSchemaWebsiteID = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "The ID of the website the source map upload configuration belongs to",
+	}
+	websiteSourceMapConfigSchemaSourceMapConfigID = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "The ID of the existing source map upload configuration the source map files are uploaded to",
+	}
+	websiteSourceMapConfigSchemaSourceMap = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MinItems:    0,
+		MaxItems:    500,
+		Description: "The local source map files which are uploaded to the source map upload configuration. Any change of the files or their content clears the uploaded source map files and uploads them again",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				WebsiteSourceMapConfigFieldSourceMapFile: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The path of the local source map file",
+				},
+				WebsiteSourceMapConfigFieldSourceMapURL: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The URL of the minified script the source map file belongs to",
+				},
+				WebsiteSourceMapConfigFieldSourceMapFileFormat: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The optional format of the source map file",
+				},
+			},
+		},
+	}
+	websiteSourceMapConfigSchemaSourceMapHash = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The SHA-256 hash of the uploaded source map files. A change of the hash uploads the source map files again",
+	}
+)
+
+// NewWebsiteSourceMapConfigResourceHandle creates the resource handle for the source map files of source map upload configurations of websites
+func NewWebsiteSourceMapConfigResourceHandle() ResourceHandle[*restapi.SourceMapUploadConfig] {
+	return &websiteSourceMapConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaWebsiteSourceMapConfig,
+			Schema: map[string]*schema.Schema{
+				WebsiteSourceMapConfigFieldWebsiteID:         websiteSourceMapConfigSchemaWebsiteID,
+				WebsiteSourceMapConfigFieldSourceMapConfigID: websiteSourceMapConfigSchemaSourceMapConfigID,
+				WebsiteSourceMapConfigFieldSourceMap:         websiteSourceMapConfigSchemaSourceMap,
+				WebsiteSourceMapConfigFieldSourceMapHash:     websiteSourceMapConfigSchemaSourceMapHash,
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			CustomizeDiff:    customizeWebsiteSourceMapConfigDiff,
+		},
+	}
+}
+
+type websiteSourceMapConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *websiteSourceMapConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *websiteSourceMapConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *websiteSourceMapConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.SourceMapUploadConfig] {
+	return api.SourceMapUploadConfigs()
+}
+
+func (r *websiteSourceMapConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *websiteSourceMapConfigResource) UpdateState(d *schema.ResourceData, config *restapi.SourceMapUploadConfig) error {
+	d.SetId(config.GetIDForResourcePath())
+	return tfutils.UpdateState(d, map[string]interface{}{
+		WebsiteSourceMapConfigFieldWebsiteID:         config.WebsiteID,
+		WebsiteSourceMapConfigFieldSourceMapConfigID: config.ID,
+	})
+}
+
+func (r *websiteSourceMapConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.SourceMapUploadConfig, error) {
+	config := &restapi.SourceMapUploadConfig{
+		ID:        d.Get(WebsiteSourceMapConfigFieldSourceMapConfigID).(string),
+		WebsiteID: d.Get(WebsiteSourceMapConfigFieldWebsiteID).(string),
+	}
+	//source map files are only read when they are uploaded so that the uploaded files can be cleared when the local files do not exist anymore
+	if d.Id() != "" && !d.HasChanges(WebsiteSourceMapConfigFieldSourceMap, WebsiteSourceMapConfigFieldSourceMapHash) {
+		return config, nil
+	}
+	uploads, err := r.readSourceMapUploads(d.Get(WebsiteSourceMapConfigFieldSourceMap).([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	config.Uploads = uploads
+	return config, nil
+}
+
+func (r *websiteSourceMapConfigResource) readSourceMapUploads(sourceMaps []interface{}) ([]restapi.SourceMapUpload, error) {
+	result := make([]restapi.SourceMapUpload, len(sourceMaps))
+	for i, v := range sourceMaps {
+		sourceMap := v.(map[string]interface{})
+		file := sourceMap[WebsiteSourceMapConfigFieldSourceMapFile].(string)
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read source map file %s; %w", file, err)
+		}
+		result[i] = restapi.SourceMapUpload{
+			FileName: filepath.Base(file),
+			Content:  content,
+			URL:      sourceMap[WebsiteSourceMapConfigFieldSourceMapURL].(string),
+		}
+		if fileFormat, ok := sourceMap[WebsiteSourceMapConfigFieldSourceMapFileFormat].(string); ok && fileFormat != "" {
+			result[i].FileFormat = &fileFormat
+		}
+	}
+	return result, nil
+}
+
+// customizeWebsiteSourceMapConfigDiff calculates the hash of the configured local source map files so that the source
+// map files are uploaded again when the content of the files has changed. As the hash is empty after an import, the
+// source map files are uploaded again on the next apply
+func customizeWebsiteSourceMapConfigDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(WebsiteSourceMapConfigFieldSourceMap) {
+		return d.SetNewComputed(WebsiteSourceMapConfigFieldSourceMapHash)
+	}
+	hash, err := calculateSourceMapHash(d.Get(WebsiteSourceMapConfigFieldSourceMap).([]interface{}))
+	if err != nil {
+		return err
+	}
+	if d.Get(WebsiteSourceMapConfigFieldSourceMapHash).(string) == hash {
+		return nil
+	}
+	return d.SetNew(WebsiteSourceMapConfigFieldSourceMapHash, hash)
+}
+
+func calculateSourceMapHash(sourceMaps []interface{}) (string, error) {
+	if len(sourceMaps) == 0 {
+		return "", nil
+	}
+	hash := sha256.New()
+	for _, v := range sourceMaps {
+		sourceMap := v.(map[string]interface{})
+		file := sourceMap[WebsiteSourceMapConfigFieldSourceMapFile].(string)
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read source map file %s; %w", file, err)
+		}
+		hash.Write([]byte(sourceMap[WebsiteSourceMapConfigFieldSourceMapURL].(string)))
+		hash.Write([]byte{0})
+		if fileFormat, ok := sourceMap[WebsiteSourceMapConfigFieldSourceMapFileFormat].(string); ok {
+			hash.Write([]byte(fileFormat))
+		}
+		hash.Write([]byte{0})
+		hash.Write(content)
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,375 @@
+package instana_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestWebsiteSourceMapConfig(t *testing.T) {
+	terraformResourceInstanceName := ResourceInstanaWebsiteSourceMapConfig + ".example"
+	inst := &websiteSourceMapConfigTest{
+		terraformResourceInstanceName: terraformResourceInstanceName,
+		resourceHandle:                NewWebsiteSourceMapConfigResourceHandle(),
+	}
+	inst.run(t)
+}
+
+type websiteSourceMapConfigTest struct {
+	terraformResourceInstanceName string
+	resourceHandle                ResourceHandle[*restapi.SourceMapUploadConfig]
+}
+
+var websiteSourceMapConfigTerraformTemplate = `
+resource "instana_website_sourcemap_config" "example" {
+	website_id          = "website-id"
+	sourcemap_config_id = "sourcemap-config-id"
+
+	source_map {
+		file        = "%s"
+		url         = "https://example.com/main.js"
+		file_format = "JS_MAP"
+	}
+}
+`
+
+const (
+	websiteSourceMapConfigTestWebsiteID = "website-id"
+	websiteSourceMapConfigTestConfigID  = "sourcemap-config-id"
+)
+
+func (test *websiteSourceMapConfigTest) run(t *testing.T) {
+	t.Run(fmt.Sprintf("CRUD integration test of %s", ResourceInstanaWebsiteSourceMapConfig), test.createIntegrationTest())
+	t.Run(fmt.Sprintf("%s should have schema version zero", ResourceInstanaWebsiteSourceMapConfig), test.createTestResourceShouldHaveSchemaVersionZero())
+	t.Run(fmt.Sprintf("%s should have no state upgrader", ResourceInstanaWebsiteSourceMapConfig), test.createTestResourceShouldHaveNoStateUpgrader())
+	t.Run(fmt.Sprintf("%s should have correct resouce name", ResourceInstanaWebsiteSourceMapConfig), test.createTestResourceShouldHaveCorrectResourceName())
+	t.Run(fmt.Sprintf("%s should update terraform state from model", ResourceInstanaWebsiteSourceMapConfig), test.createTestShouldUpdateTerraformResourceStateFromModel())
+	t.Run(fmt.Sprintf("%s should map terraform state to model including source map files on creation", ResourceInstanaWebsiteSourceMapConfig), test.createTestShouldMapTerraformResourceStateToModelOnCreation())
+	t.Run(fmt.Sprintf("%s should map terraform state to model without reading source map files when source maps are unchanged", ResourceInstanaWebsiteSourceMapConfig), test.createTestShouldMapTerraformResourceStateToModelWithoutSourceMapFilesWhenSourceMapsAreUnchanged())
+	t.Run(fmt.Sprintf("%s should map terraform state to model including source map files when source maps are changed", ResourceInstanaWebsiteSourceMapConfig), test.createTestShouldMapTerraformResourceStateToModelIncludingSourceMapFilesWhenSourceMapsAreChanged())
+	t.Run(fmt.Sprintf("%s should fail to map terraform state to model when source map file does not exist", ResourceInstanaWebsiteSourceMapConfig), test.createTestShouldFailToMapTerraformResourceStateToModelWhenSourceMapFileDoesNotExist())
+	t.Run(fmt.Sprintf("%s should update source map hash in place when content of source map file changed", ResourceInstanaWebsiteSourceMapConfig), test.createTestShouldUpdateSourceMapHashInPlaceWhenContentOfSourceMapFileChanged())
+	t.Run(fmt.Sprintf("%s should update source map hash in place when source map hash is empty after import", ResourceInstanaWebsiteSourceMapConfig), test.createTestShouldUpdateSourceMapHashInPlaceWhenSourceMapHashIsEmptyAfterImport())
+	t.Run(fmt.Sprintf("%s should not change source map hash when content of source map file is unchanged", ResourceInstanaWebsiteSourceMapConfig), test.createTestShouldNotChangeSourceMapHashWhenContentOfSourceMapFileIsUnchanged())
+}
+
+func (test *websiteSourceMapConfigTest) createIntegrationTest() func(t *testing.T) {
+	return func(t *testing.T) {
+		sourceMapFile := filepath.Join(t.TempDir(), "main.js.map")
+		writeSourceMapFile(t, sourceMapFile, `{"version":3,"mappings":"AAAA"}`)
+
+		server := &websiteSourceMapConfigTestServer{httpServer: testutils.NewTestHTTPServer()}
+		server.start()
+		defer server.httpServer.Close()
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: testProviderFactory,
+			Steps: []resource.TestStep{
+				test.createIntegrationTestStep(server.httpServer.GetPort(), sourceMapFile, server.expectUploads(1, 1)),
+				test.createImportTestStep(),
+				{
+					PreConfig: func() {
+						writeSourceMapFile(t, sourceMapFile, `{"version":3,"mappings":"BBBB"}`)
+					},
+					Config: test.createConfig(server.httpServer.GetPort(), sourceMapFile),
+					Check:  server.expectUploads(2, 2),
+				},
+				test.createImportTestStep(),
+			},
+		})
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createConfig(httpPort int, sourceMapFile string) string {
+	return appendProviderConfig(fmt.Sprintf(websiteSourceMapConfigTerraformTemplate, filepath.ToSlash(sourceMapFile)), httpPort)
+}
+
+func (test *websiteSourceMapConfigTest) createIntegrationTestStep(httpPort int, sourceMapFile string, additionalCheck resource.TestCheckFunc) resource.TestStep {
+	return resource.TestStep{
+		Config: test.createConfig(httpPort, sourceMapFile),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, "id", websiteSourceMapConfigTestWebsiteID+"/"+websiteSourceMapConfigTestConfigID),
+			resource.TestCheckResourceAttrSet(test.terraformResourceInstanceName, WebsiteSourceMapConfigFieldSourceMapHash),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteSourceMapConfigFieldWebsiteID, websiteSourceMapConfigTestWebsiteID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteSourceMapConfigFieldSourceMapConfigID, websiteSourceMapConfigTestConfigID),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, fmt.Sprintf("%s.0.%s", WebsiteSourceMapConfigFieldSourceMap, WebsiteSourceMapConfigFieldSourceMapURL), "https://example.com/main.js"),
+			additionalCheck,
+		),
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createImportTestStep() resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            test.terraformResourceInstanceName,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{WebsiteSourceMapConfigFieldSourceMap, WebsiteSourceMapConfigFieldSourceMapHash},
+	}
+}
+
+func writeSourceMapFile(t *testing.T, file string, content string) {
+	require.NoError(t, os.WriteFile(file, []byte(content), 0600))
+}
+
+type websiteSourceMapConfigTestServer struct {
+	httpServer testutils.TestHTTPServer
+	metadata   []restapi.SourceMapFileMeta
+	cleared    int
+	uploaded   int
+}
+
+func (s *websiteSourceMapConfigTestServer) start() {
+	instancePath := restapi.WebsiteMonitoringConfigResourcePath + "/{websiteId}/sourcemap-upload/{id}"
+	s.httpServer.AddRoute(http.MethodPut, instancePath+"/form", func(w http.ResponseWriter, r *http.Request) {
+		if _, _, err := r.FormFile("sourceMap"); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.uploaded++
+		s.metadata = append(s.metadata, restapi.SourceMapFileMeta{Format: r.FormValue("fileFormat"), Type: "JS_MAP", URL: r.FormValue("url")})
+		s.writeJSON(w, &restapi.SourceMapUploadConfig{ID: mux.Vars(r)["id"], Metadata: s.metadata})
+	})
+	s.httpServer.AddRoute(http.MethodPut, instancePath+"/clear", func(w http.ResponseWriter, r *http.Request) {
+		s.cleared++
+		s.metadata = []restapi.SourceMapFileMeta{}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.httpServer.Start()
+}
+
+func (s *websiteSourceMapConfigTestServer) expectUploads(cleared int, uploaded int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if s.cleared != cleared || s.uploaded != uploaded {
+			return fmt.Errorf("expected source map files to be cleared %d and uploaded %d times but they were cleared %d and uploaded %d times", cleared, uploaded, s.cleared, s.uploaded)
+		}
+		return nil
+	}
+}
+
+func (s *websiteSourceMapConfigTestServer) writeJSON(w http.ResponseWriter, data interface{}) {
+	w.Header().Set(contentType, "application/json")
+	w.WriteHeader(http.StatusOK)
+	err := json.NewEncoder(w).Encode(data)
+	if err != nil {
+		fmt.Printf("failed to encode json; %s\n", err)
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestResourceShouldHaveSchemaVersionZero() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, 0, test.resourceHandle.MetaData().SchemaVersion)
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestResourceShouldHaveNoStateUpgrader() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Empty(t, test.resourceHandle.StateUpgraders())
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestResourceShouldHaveCorrectResourceName() func(t *testing.T) {
+	return func(t *testing.T) {
+		require.Equal(t, "instana_website_sourcemap_config", test.resourceHandle.MetaData().ResourceName)
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestShouldUpdateTerraformResourceStateFromModel() func(t *testing.T) {
+	return func(t *testing.T) {
+		config := &restapi.SourceMapUploadConfig{
+			ID:        websiteSourceMapConfigTestConfigID,
+			WebsiteID: websiteSourceMapConfigTestWebsiteID,
+		}
+
+		testHelper := NewTestHelper[*restapi.SourceMapUploadConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+
+		err := test.resourceHandle.UpdateState(resourceData, config)
+
+		require.NoError(t, err)
+		require.Equal(t, websiteSourceMapConfigTestWebsiteID+"/"+websiteSourceMapConfigTestConfigID, resourceData.Id())
+		require.Equal(t, websiteSourceMapConfigTestWebsiteID, resourceData.Get(WebsiteSourceMapConfigFieldWebsiteID))
+		require.Equal(t, websiteSourceMapConfigTestConfigID, resourceData.Get(WebsiteSourceMapConfigFieldSourceMapConfigID))
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestShouldMapTerraformResourceStateToModelOnCreation() func(t *testing.T) {
+	return func(t *testing.T) {
+		sourceMapFile := filepath.Join(t.TempDir(), "main.js.map")
+		writeSourceMapFile(t, sourceMapFile, "content")
+
+		testHelper := NewTestHelper[*restapi.SourceMapUploadConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, WebsiteSourceMapConfigFieldWebsiteID, websiteSourceMapConfigTestWebsiteID)
+		setValueOnResourceData(t, resourceData, WebsiteSourceMapConfigFieldSourceMapConfigID, websiteSourceMapConfigTestConfigID)
+		setValueOnResourceData(t, resourceData, WebsiteSourceMapConfigFieldSourceMap, []interface{}{
+			map[string]interface{}{
+				WebsiteSourceMapConfigFieldSourceMapFile:       sourceMapFile,
+				WebsiteSourceMapConfigFieldSourceMapURL:        "https://example.com/main.js",
+				WebsiteSourceMapConfigFieldSourceMapFileFormat: "JS_MAP",
+			},
+		})
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		fileFormat := "JS_MAP"
+		require.Equal(t, &restapi.SourceMapUploadConfig{
+			ID:        websiteSourceMapConfigTestConfigID,
+			WebsiteID: websiteSourceMapConfigTestWebsiteID,
+			Uploads: []restapi.SourceMapUpload{
+				{FileName: "main.js.map", Content: []byte("content"), URL: "https://example.com/main.js", FileFormat: &fileFormat},
+			},
+		}, result)
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestShouldMapTerraformResourceStateToModelWithoutSourceMapFilesWhenSourceMapsAreUnchanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		data := test.createSourceMapResourceData("/not/existing/main.js.map")
+		resourceData := createResourceDataWithChangesForResourceHandle(t, test.resourceHandle, websiteSourceMapConfigTestWebsiteID+"/"+websiteSourceMapConfigTestConfigID, data, data)
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.SourceMapUploadConfig{
+			ID:        websiteSourceMapConfigTestConfigID,
+			WebsiteID: websiteSourceMapConfigTestWebsiteID,
+		}, result)
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestShouldMapTerraformResourceStateToModelIncludingSourceMapFilesWhenSourceMapsAreChanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		sourceMapFile := filepath.Join(t.TempDir(), "main.js.map")
+		writeSourceMapFile(t, sourceMapFile, "content")
+		resourceData := createResourceDataWithChangesForResourceHandle(t, test.resourceHandle, websiteSourceMapConfigTestWebsiteID+"/"+websiteSourceMapConfigTestConfigID, test.createSourceMapResourceData("/not/existing/main.js.map"), test.createSourceMapResourceData(sourceMapFile))
+
+		result, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.NoError(t, err)
+		require.Equal(t, &restapi.SourceMapUploadConfig{
+			ID:        websiteSourceMapConfigTestConfigID,
+			WebsiteID: websiteSourceMapConfigTestWebsiteID,
+			Uploads: []restapi.SourceMapUpload{
+				{FileName: "main.js.map", Content: []byte("content"), URL: "https://example.com/main.js"},
+			},
+		}, result)
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createSourceMapResourceData(sourceMapFile string) map[string]interface{} {
+	return map[string]interface{}{
+		WebsiteSourceMapConfigFieldWebsiteID:         websiteSourceMapConfigTestWebsiteID,
+		WebsiteSourceMapConfigFieldSourceMapConfigID: websiteSourceMapConfigTestConfigID,
+		WebsiteSourceMapConfigFieldSourceMap: []interface{}{
+			map[string]interface{}{
+				WebsiteSourceMapConfigFieldSourceMapFile: sourceMapFile,
+				WebsiteSourceMapConfigFieldSourceMapURL:  "https://example.com/main.js",
+			},
+		},
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestShouldFailToMapTerraformResourceStateToModelWhenSourceMapFileDoesNotExist() func(t *testing.T) {
+	return func(t *testing.T) {
+		testHelper := NewTestHelper[*restapi.SourceMapUploadConfig](t)
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(test.resourceHandle)
+		setValueOnResourceData(t, resourceData, WebsiteSourceMapConfigFieldWebsiteID, websiteSourceMapConfigTestWebsiteID)
+		setValueOnResourceData(t, resourceData, WebsiteSourceMapConfigFieldSourceMap, []interface{}{
+			map[string]interface{}{
+				WebsiteSourceMapConfigFieldSourceMapFile: filepath.Join(t.TempDir(), "missing.js.map"),
+				WebsiteSourceMapConfigFieldSourceMapURL:  "https://example.com/main.js",
+			},
+		})
+
+		_, err := test.resourceHandle.MapStateToDataObject(resourceData)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "missing.js.map")
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestShouldUpdateSourceMapHashInPlaceWhenContentOfSourceMapFileChanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		sourceMapFile := filepath.Join(t.TempDir(), "main.js.map")
+		writeSourceMapFile(t, sourceMapFile, "content")
+
+		diff := test.calculateDiff(t, sourceMapFile, "outdated-hash")
+
+		require.NotNil(t, diff)
+		require.False(t, diff.RequiresNew())
+		require.NotEmpty(t, diff.Attributes[WebsiteSourceMapConfigFieldSourceMapHash].New)
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestShouldUpdateSourceMapHashInPlaceWhenSourceMapHashIsEmptyAfterImport() func(t *testing.T) {
+	return func(t *testing.T) {
+		sourceMapFile := filepath.Join(t.TempDir(), "main.js.map")
+		writeSourceMapFile(t, sourceMapFile, "content")
+
+		diff := test.calculateDiff(t, sourceMapFile, "")
+
+		require.NotNil(t, diff)
+		require.False(t, diff.RequiresNew())
+		require.NotEmpty(t, diff.Attributes[WebsiteSourceMapConfigFieldSourceMapHash].New)
+	}
+}
+
+func (test *websiteSourceMapConfigTest) createTestShouldNotChangeSourceMapHashWhenContentOfSourceMapFileIsUnchanged() func(t *testing.T) {
+	return func(t *testing.T) {
+		sourceMapFile := filepath.Join(t.TempDir(), "main.js.map")
+		writeSourceMapFile(t, sourceMapFile, "content")
+
+		initialDiff := test.calculateDiff(t, sourceMapFile, "outdated-hash")
+		hash := initialDiff.Attributes[WebsiteSourceMapConfigFieldSourceMapHash].New
+
+		diff := test.calculateDiff(t, sourceMapFile, hash)
+
+		require.Nil(t, diff)
+	}
+}
+
+func (test *websiteSourceMapConfigTest) calculateDiff(t *testing.T, sourceMapFile string, currentHash string) *terraform.InstanceDiff {
+	schemaResource := NewTerraformResource(test.resourceHandle).ToSchemaResource()
+	state := &terraform.InstanceState{
+		ID: websiteSourceMapConfigTestWebsiteID + "/" + websiteSourceMapConfigTestConfigID,
+		Attributes: map[string]string{
+			"id":                                 websiteSourceMapConfigTestWebsiteID + "/" + websiteSourceMapConfigTestConfigID,
+			WebsiteSourceMapConfigFieldWebsiteID: websiteSourceMapConfigTestWebsiteID,
+			WebsiteSourceMapConfigFieldSourceMapConfigID:                                                  websiteSourceMapConfigTestConfigID,
+			WebsiteSourceMapConfigFieldSourceMap + ".#":                                                   "1",
+			WebsiteSourceMapConfigFieldSourceMap + ".0." + WebsiteSourceMapConfigFieldSourceMapFile:       sourceMapFile,
+			WebsiteSourceMapConfigFieldSourceMap + ".0." + WebsiteSourceMapConfigFieldSourceMapURL:        "https://example.com/main.js",
+			WebsiteSourceMapConfigFieldSourceMap + ".0." + WebsiteSourceMapConfigFieldSourceMapFileFormat: "",
+			WebsiteSourceMapConfigFieldSourceMapHash:                                                      currentHash,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		WebsiteSourceMapConfigFieldWebsiteID:         websiteSourceMapConfigTestWebsiteID,
+		WebsiteSourceMapConfigFieldSourceMapConfigID: websiteSourceMapConfigTestConfigID,
+		WebsiteSourceMapConfigFieldSourceMap: []interface{}{
+			map[string]interface{}{
+				WebsiteSourceMapConfigFieldSourceMapFile: sourceMapFile,
+				WebsiteSourceMapConfigFieldSourceMapURL:  "https://example.com/main.js",
+			},
+		},
+	})
+
+	diff, err := schemaResource.Diff(context.Background(), state, config, nil)
+
+	require.NoError(t, err)
+	return diff
+}
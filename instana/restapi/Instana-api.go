@@ -29,17 +29,43 @@ type InstanaAPI interface {
 	BuiltinEventSpecifications() ReadOnlyRestResource[*BuiltinEventSpecification]
 	APITokens() RestResource[*APIToken]
 	ApplicationConfigs() RestResource[*ApplicationConfig]
-	ApplicationAlertConfigs() RestResource[*ApplicationAlertConfig]
-	GlobalApplicationAlertConfigs() RestResource[*ApplicationAlertConfig]
-	AlertingChannels() RestResource[*AlertingChannel]
+	ApplicationAlertConfigs() BaselineAwareAlertConfigRestResource[*ApplicationAlertConfig]
+	GlobalApplicationAlertConfigs() AlertConfigRestResource[*ApplicationAlertConfig]
+	AlertingChannels() AlertingChannelRestResource
 	AlertingConfigurations() RestResource[*AlertingConfiguration]
 	SliConfigs() RestResource[*SliConfig]
-	WebsiteMonitoringConfig() RestResource[*WebsiteMonitoringConfig]
-	WebsiteAlertConfig() RestResource[*WebsiteAlertConfig]
+	WebsiteMonitoringConfig() WebsiteMonitoringConfigRestResource
+	WebsiteAlertConfig() BaselineAwareAlertConfigRestResource[*WebsiteAlertConfig]
 	Groups() RestResource[*Group]
 	CustomDashboards() RestResource[*CustomDashboard]
 	SyntheticTest() RestResource[*SyntheticTest]
 	SyntheticLocation() ReadOnlyRestResource[*SyntheticLocation]
+	InfraAlertConfigs() RestResource[*InfraAlertConfig]
+	MobileAppAlertConfigs() BaselineAwareAlertConfigRestResource[*MobileAppAlertConfig]
+	GlobalSyntheticAlertConfigs() RestResource[*SyntheticAlertConfig]
+	SyntheticCredentials() RestResource[*SyntheticCredential]
+	MaintenanceWindowConfigs() RestResource[*MaintenanceWindowConfig]
+	Releases() RestResource[*Release]
+	ApdexConfigs() RestResource[*ApdexConfig]
+	ApdexReports() ApdexReportResource
+	ServiceConfigs() RestResource[*ServiceConfig]
+	ServiceConfigOrder() RestResource[*ServiceConfigOrder]
+	ManualServiceConfigs() ManualServiceConfigRestResource
+	HttpEndpointConfigs() RestResource[*HttpEndpointConfig]
+	MobileAppConfigs() RestResource[*MobileAppConfig]
+	SourceMapUploadConfigs() RestResource[*SourceMapUploadConfig]
+	CustomPayloadConfiguration() CustomPayloadConfigurationRestResource
+	IdpGroupMappings() RestResource[*IdpGroupMapping]
+	IdpSettings() RestResource[*IdpSettings]
+	GroupMemberships() RestResource[*GroupMembership]
+	GroupPermissionSets() RestResource[*GroupPermissionSet]
+	UserInvitations() RestResource[*UserInvitation]
+	Users() ReadOnlyRestResource[*User]
+	SessionSettings() RestResource[*SessionSettings]
+	SyntheticCallsSettings() RestResource[*SyntheticCallsSettings]
+	BuiltinEventStates() RestResource[*BuiltinEventState]
+	HostAgents() HostAgentResource
+	HostAgentConfigurations() RestResource[*HostAgentConfiguration]
 }
 
 // NewInstanaAPI creates a new instance of the instana API
@@ -73,18 +99,21 @@ func (api *baseInstanaAPI) ApplicationConfigs() RestResource[*ApplicationConfig]
 }
 
 // ApplicationAlertConfigs implementation of InstanaAPI interface
-func (api *baseInstanaAPI) ApplicationAlertConfigs() RestResource[*ApplicationAlertConfig] {
-	return NewCreatePOSTUpdatePOSTRestResource(ApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+func (api *baseInstanaAPI) ApplicationAlertConfigs() BaselineAwareAlertConfigRestResource[*ApplicationAlertConfig] {
+	restResource := NewCreatePOSTUpdatePOSTRestResource(ApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+	return NewBaselineAwareAlertConfigRestResource(ApplicationAlertConfigsResourcePath, restResource, api.client)
 }
 
 // GlobalApplicationAlertConfigs implementation of InstanaAPI interface
-func (api *baseInstanaAPI) GlobalApplicationAlertConfigs() RestResource[*ApplicationAlertConfig] {
-	return NewCreatePOSTUpdatePOSTRestResource(GlobalApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+func (api *baseInstanaAPI) GlobalApplicationAlertConfigs() AlertConfigRestResource[*ApplicationAlertConfig] {
+	restResource := NewCreatePOSTUpdatePOSTRestResource(GlobalApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+	return NewAlertConfigRestResource(GlobalApplicationAlertConfigsResourcePath, restResource, api.client)
 }
 
 // AlertingChannels implementation of InstanaAPI interface
-func (api *baseInstanaAPI) AlertingChannels() RestResource[*AlertingChannel] {
-	return NewCreatePUTUpdatePUTRestResource(AlertingChannelsResourcePath, NewDefaultJSONUnmarshaller(&AlertingChannel{}), api.client)
+func (api *baseInstanaAPI) AlertingChannels() AlertingChannelRestResource {
+	restResource := NewCreatePUTUpdatePUTRestResource(AlertingChannelsResourcePath, NewDefaultJSONUnmarshaller(&AlertingChannel{}), api.client)
+	return NewAlertingChannelRestResource(restResource, api.client)
 }
 
 // AlertingConfigurations implementation of InstanaAPI interface
@@ -96,16 +125,17 @@ func (api *baseInstanaAPI) SliConfigs() RestResource[*SliConfig] {
 	return NewCreatePOSTUpdateNotSupportedRestResource(SliConfigResourcePath, NewDefaultJSONUnmarshaller(&SliConfig{}), api.client)
 }
 
-func (api *baseInstanaAPI) WebsiteMonitoringConfig() RestResource[*WebsiteMonitoringConfig] {
+func (api *baseInstanaAPI) WebsiteMonitoringConfig() WebsiteMonitoringConfigRestResource {
 	return NewWebsiteMonitoringConfigRestResource(NewDefaultJSONUnmarshaller(&WebsiteMonitoringConfig{}), api.client)
 }
 
-func (api *baseInstanaAPI) WebsiteAlertConfig() RestResource[*WebsiteAlertConfig] {
-	return NewCreatePOSTUpdatePOSTRestResource(WebsiteAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&WebsiteAlertConfig{})), api.client)
+func (api *baseInstanaAPI) WebsiteAlertConfig() BaselineAwareAlertConfigRestResource[*WebsiteAlertConfig] {
+	restResource := NewCreatePOSTUpdatePOSTRestResource(WebsiteAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&WebsiteAlertConfig{})), api.client)
+	return NewBaselineAwareAlertConfigRestResource(WebsiteAlertConfigResourcePath, restResource, api.client)
 }
 
 func (api *baseInstanaAPI) Groups() RestResource[*Group] {
-	return NewCreatePOSTUpdatePUTRestResource(GroupsResourcePath, NewDefaultJSONUnmarshaller(&Group{}), api.client)
+	return NewGroupRestResource(api.client)
 }
 
 func (api *baseInstanaAPI) CustomDashboards() RestResource[*CustomDashboard] {
@@ -120,3 +150,134 @@ func (api *baseInstanaAPI) SyntheticTest() RestResource[*SyntheticTest] {
 func (api *baseInstanaAPI) SyntheticLocation() ReadOnlyRestResource[*SyntheticLocation] {
 	return NewReadOnlyRestResource(SyntheticLocationResourcePath, NewDefaultJSONUnmarshaller(&SyntheticLocation{}), api.client)
 }
+
+// InfraAlertConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) InfraAlertConfigs() RestResource[*InfraAlertConfig] {
+	return NewCreatePOSTUpdatePOSTRestResource(InfraAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&InfraAlertConfig{})), api.client)
+}
+
+// MobileAppAlertConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppAlertConfigs() BaselineAwareAlertConfigRestResource[*MobileAppAlertConfig] {
+	restResource := NewCreatePOSTUpdatePOSTRestResource(MobileAppAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&MobileAppAlertConfig{})), api.client)
+	return NewBaselineAwareAlertConfigRestResource(MobileAppAlertConfigResourcePath, restResource, api.client)
+}
+
+// GlobalSyntheticAlertConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GlobalSyntheticAlertConfigs() RestResource[*SyntheticAlertConfig] {
+	return NewCreatePOSTUpdatePOSTRestResource(GlobalSyntheticAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&SyntheticAlertConfig{})), api.client)
+}
+
+// SyntheticCredentials implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SyntheticCredentials() RestResource[*SyntheticCredential] {
+	return NewSyntheticCredentialRestResource(api.client)
+}
+
+// MaintenanceWindowConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MaintenanceWindowConfigs() RestResource[*MaintenanceWindowConfig] {
+	return NewMaintenanceWindowConfigRestResource(NewDefaultJSONUnmarshaller(&MaintenanceWindowConfig{}), api.client)
+}
+
+// Releases implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Releases() RestResource[*Release] {
+	return NewCreatePOSTUpdatePUTRestResource(ReleasesResourcePath, NewDefaultJSONUnmarshaller(&Release{}), api.client)
+}
+
+// ApdexConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApdexConfigs() RestResource[*ApdexConfig] {
+	return NewCreatePOSTUpdateNotSupportedRestResource(ApdexConfigResourcePath, NewDefaultJSONUnmarshaller(&ApdexConfig{}), api.client)
+}
+
+// ApdexReports implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApdexReports() ApdexReportResource {
+	return NewApdexReportResource(api.client)
+}
+
+// ServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ServiceConfigs() RestResource[*ServiceConfig] {
+	return NewCreatePOSTUpdatePUTRestResource(ServiceConfigResourcePath, NewDefaultJSONUnmarshaller(&ServiceConfig{}), api.client)
+}
+
+// ServiceConfigOrder implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ServiceConfigOrder() RestResource[*ServiceConfigOrder] {
+	return NewServiceConfigOrderRestResource(api.client)
+}
+
+// ManualServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ManualServiceConfigs() ManualServiceConfigRestResource {
+	return NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), api.client)
+}
+
+// HttpEndpointConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) HttpEndpointConfigs() RestResource[*HttpEndpointConfig] {
+	return NewCreatePOSTUpdatePUTRestResource(HttpEndpointConfigResourcePath, NewDefaultJSONUnmarshaller(&HttpEndpointConfig{}), api.client)
+}
+
+// MobileAppConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppConfigs() RestResource[*MobileAppConfig] {
+	return NewMobileAppConfigRestResource(NewDefaultJSONUnmarshaller(&MobileAppConfig{}), api.client)
+}
+
+// SourceMapUploadConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SourceMapUploadConfigs() RestResource[*SourceMapUploadConfig] {
+	return NewSourceMapUploadConfigRestResource(NewDefaultJSONUnmarshaller(&SourceMapUploadConfig{}), api.client)
+}
+
+// CustomPayloadConfiguration implementation of InstanaAPI interface
+func (api *baseInstanaAPI) CustomPayloadConfiguration() CustomPayloadConfigurationRestResource {
+	return NewCustomPayloadConfigurationRestResource(api.client)
+}
+
+// IdpGroupMappings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) IdpGroupMappings() RestResource[*IdpGroupMapping] {
+	return NewIdpGroupMappingRestResource(NewDefaultJSONUnmarshaller(&IdpGroupMapping{}), api.client)
+}
+
+// IdpSettings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) IdpSettings() RestResource[*IdpSettings] {
+	return NewIdpSettingsRestResource(api.client)
+}
+
+// GroupMemberships implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupMemberships() RestResource[*GroupMembership] {
+	return NewGroupMembershipRestResource(api.client)
+}
+
+// GroupPermissionSets implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupPermissionSets() RestResource[*GroupPermissionSet] {
+	return NewGroupPermissionSetRestResource(api.client)
+}
+
+// UserInvitations implementation of InstanaAPI interface
+func (api *baseInstanaAPI) UserInvitations() RestResource[*UserInvitation] {
+	return NewUserInvitationRestResource(api.client)
+}
+
+// Users implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Users() ReadOnlyRestResource[*User] {
+	return NewReadOnlyRestResource(UsersResourcePath, NewDefaultJSONUnmarshaller(&User{}), api.client)
+}
+
+// SessionSettings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SessionSettings() RestResource[*SessionSettings] {
+	return NewSingletonSettingsRestResource(SessionSettingsResourcePath, NewDefaultJSONUnmarshaller(&SessionSettings{}), api.client)
+}
+
+// SyntheticCallsSettings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SyntheticCallsSettings() RestResource[*SyntheticCallsSettings] {
+	return NewSingletonSettingsRestResource(SyntheticCallsSettingsResourcePath, NewDefaultJSONUnmarshaller(&SyntheticCallsSettings{}), api.client)
+}
+
+// BuiltinEventStates implementation of InstanaAPI interface
+func (api *baseInstanaAPI) BuiltinEventStates() RestResource[*BuiltinEventState] {
+	return NewBuiltinEventStateRestResource(api.client)
+}
+
+// HostAgents implementation of InstanaAPI interface
+func (api *baseInstanaAPI) HostAgents() HostAgentResource {
+	return NewHostAgentResource(api.client)
+}
+
+// HostAgentConfigurations implementation of InstanaAPI interface
+func (api *baseInstanaAPI) HostAgentConfigurations() RestResource[*HostAgentConfiguration] {
+	return NewHostAgentConfigurationRestResource(api.client)
+}
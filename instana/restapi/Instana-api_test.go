@@ -86,5 +86,135 @@ func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
 
 		require.NotNil(t, resource)
 	})
+	t.Run("Should return InfraAlertConfig instance", func(t *testing.T) {
+		resource := api.InfraAlertConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppAlertConfig instance", func(t *testing.T) {
+		resource := api.MobileAppAlertConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GlobalSyntheticAlertConfig instance", func(t *testing.T) {
+		resource := api.GlobalSyntheticAlertConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return SyntheticCredential instance", func(t *testing.T) {
+		resource := api.SyntheticCredentials()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MaintenanceWindowConfig instance", func(t *testing.T) {
+		resource := api.MaintenanceWindowConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Release instance", func(t *testing.T) {
+		resource := api.Releases()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApdexConfig instance", func(t *testing.T) {
+		resource := api.ApdexConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApdexReport instance", func(t *testing.T) {
+		resource := api.ApdexReports()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ServiceConfig instance", func(t *testing.T) {
+		resource := api.ServiceConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ServiceConfigOrder instance", func(t *testing.T) {
+		resource := api.ServiceConfigOrder()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ManualServiceConfig instance", func(t *testing.T) {
+		resource := api.ManualServiceConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return HttpEndpointConfig instance", func(t *testing.T) {
+		resource := api.HttpEndpointConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppConfig instance", func(t *testing.T) {
+		resource := api.MobileAppConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return SourceMapUploadConfig instance", func(t *testing.T) {
+		resource := api.SourceMapUploadConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return CustomPayloadConfiguration instance", func(t *testing.T) {
+		resource := api.CustomPayloadConfiguration()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return IdpGroupMapping instance", func(t *testing.T) {
+		resource := api.IdpGroupMappings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return IdpSettings instance", func(t *testing.T) {
+		resource := api.IdpSettings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GroupMembership instance", func(t *testing.T) {
+		resource := api.GroupMemberships()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GroupPermissionSet instance", func(t *testing.T) {
+		resource := api.GroupPermissionSets()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return UserInvitation instance", func(t *testing.T) {
+		resource := api.UserInvitations()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Users instance", func(t *testing.T) {
+		resource := api.Users()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return SessionSettings instance", func(t *testing.T) {
+		resource := api.SessionSettings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return SyntheticCallsSettings instance", func(t *testing.T) {
+		resource := api.SyntheticCallsSettings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return BuiltinEventState instance", func(t *testing.T) {
+		resource := api.BuiltinEventStates()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return HostAgent instance", func(t *testing.T) {
+		resource := api.HostAgents()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return HostAgentConfiguration instance", func(t *testing.T) {
+		resource := api.HostAgentConfigurations()
+
+		require.NotNil(t, resource)
+	})
 
 }
@@ -0,0 +1,22 @@
+package restapi
+
+// AlertingChannelsTestResourcePath path to the endpoint of the Instana RESTful API which sends a test notification to an alerting channel
+const AlertingChannelsTestResourcePath = AlertingChannelsResourcePath + "/test"
+
+// NewAlertingChannelRestResource creates a new AlertingChannelRestResource which delegates all default operations to the provided RestResource and sends test notifications via the test endpoint of the alerting channels
+func NewAlertingChannelRestResource(delegate RestResource[*AlertingChannel], client RestClient) AlertingChannelRestResource {
+	return &alertingChannelRestResource{
+		RestResource: delegate,
+		client:       client,
+	}
+}
+
+type alertingChannelRestResource struct {
+	RestResource[*AlertingChannel]
+	client RestClient
+}
+
+func (r *alertingChannelRestResource) SendTestNotification(channel *AlertingChannel) error {
+	_, err := r.client.PutWithoutID(channel, AlertingChannelsTestResourcePath)
+	return err
+}
@@ -0,0 +1,67 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestAlertingChannelRestResource(t *testing.T) {
+	t.Run("should send test notification via test endpoint", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		channel := &AlertingChannel{ID: "test-id", Name: "test", Kind: EmailChannelType, Emails: []string{"test@example.com"}}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+		restClient.EXPECT().PutWithoutID(channel, AlertingChannelsResourcePath+"/test").Times(1).Return([]byte{}, nil)
+
+		sut := NewAlertingChannelRestResource(delegate, restClient)
+
+		err := sut.SendTestNotification(channel)
+
+		require.NoError(t, err)
+	})
+	t.Run("should return error when test notification fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedError := errors.New("test")
+		channel := &AlertingChannel{ID: "test-id", Name: "test", Kind: EmailChannelType, Emails: []string{"test@example.com"}}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+		restClient.EXPECT().PutWithoutID(channel, AlertingChannelsResourcePath+"/test").Times(1).Return(nil, expectedError)
+
+		sut := NewAlertingChannelRestResource(delegate, restClient)
+
+		err := sut.SendTestNotification(channel)
+
+		require.Error(t, err)
+		require.Equal(t, expectedError, err)
+	})
+	t.Run("should delegate default operations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		channel := &AlertingChannel{ID: "test-id", Name: "test", Kind: EmailChannelType}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+		delegate.EXPECT().GetOne("test-id").Times(1).Return(channel, nil)
+		delegate.EXPECT().Create(channel).Times(1).Return(channel, nil)
+
+		sut := NewAlertingChannelRestResource(delegate, restClient)
+
+		result, err := sut.GetOne("test-id")
+		require.NoError(t, err)
+		require.Equal(t, channel, result)
+
+		result, err = sut.Create(channel)
+		require.NoError(t, err)
+		require.Equal(t, channel, result)
+	})
+}
@@ -22,4 +22,10 @@ const (
 	VictorOpsChannelType = AlertingChannelType("VICTOR_OPS")
 	//WebhookChannelType constant value for alerting channel type WEB_HOOK
 	WebhookChannelType = AlertingChannelType("WEB_HOOK")
+	//ServiceNowChannelType constant value for alerting channel type SERVICE_NOW_WEBHOOK
+	ServiceNowChannelType = AlertingChannelType("SERVICE_NOW_WEBHOOK")
+	//PrometheusWebhookChannelType constant value for alerting channel type PROMETHEUS_WEBHOOK
+	PrometheusWebhookChannelType = AlertingChannelType("PROMETHEUS_WEBHOOK")
+	//WatsonAIOpsWebhookChannelType constant value for alerting channel type WATSON_AIOPS_WEBHOOK
+	WatsonAIOpsWebhookChannelType = AlertingChannelType("WATSON_AIOPS_WEBHOOK")
 )
@@ -21,6 +21,10 @@ type AlertingChannel struct {
 	Token                 *string             `json:"token"`
 	WebhookURLs           []string            `json:"webhookUrls"`
 	Headers               []string            `json:"headers"`
+	ServiceNowURL         *string             `json:"serviceNowUrl"`
+	Username              *string             `json:"username"`
+	Password              *string             `json:"password"`
+	Receiver              *string             `json:"receiver"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject
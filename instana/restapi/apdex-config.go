@@ -0,0 +1,55 @@
+package restapi
+
+const (
+	//ApdexConfigResourcePath path to the apdex configuration resource of the Instana RESTful API
+	ApdexConfigResourcePath = SettingsBasePath + "/apdex"
+)
+
+// ApdexEntityType type definition of the entity types supported by apdex configurations
+type ApdexEntityType string
+
+// ApdexEntityTypes type definition of slice of ApdexEntityType
+type ApdexEntityTypes []ApdexEntityType
+
+// ToStringSlice returns a slice containing the string representations of the given apdex entity types
+func (types ApdexEntityTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, t := range types {
+		result[i] = string(t)
+	}
+	return result
+}
+
+const (
+	//ApdexEntityTypeApplication constant value for apdex configurations of application perspectives
+	ApdexEntityTypeApplication = ApdexEntityType("application")
+	//ApdexEntityTypeWebsite constant value for apdex configurations of websites
+	ApdexEntityTypeWebsite = ApdexEntityType("website")
+)
+
+// SupportedApdexEntityTypes supported ApdexEntityTypes of the Instana Web REST API
+var SupportedApdexEntityTypes = ApdexEntityTypes{ApdexEntityTypeApplication, ApdexEntityTypeWebsite}
+
+// ApdexEntity represents the entity an apdex configuration is calculated for
+type ApdexEntity struct {
+	Type                ApdexEntityType `json:"apdexType"`
+	EntityID            string          `json:"entityId"`
+	TagFilterExpression *TagFilter      `json:"tagFilterExpression"`
+	Threshold           int32           `json:"threshold"`
+	BoundaryScope       *BoundaryScope  `json:"boundaryScope,omitempty"`
+	IncludeInternal     *bool           `json:"includeInternal,omitempty"`
+	IncludeSynthetic    *bool           `json:"includeSynthetic,omitempty"`
+	BeaconType          *string         `json:"beaconType,omitempty"`
+}
+
+// ApdexConfig represents the REST resource of apdex configurations at Instana
+type ApdexConfig struct {
+	ID     string      `json:"id"`
+	Name   string      `json:"apdexName"`
+	Entity ApdexEntity `json:"apdexEntity"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ApdexConfig) GetIDForResourcePath() string {
+	return c.ID
+}
@@ -0,0 +1,50 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	//ApdexReportResourcePath path to the apdex report resource of the Instana RESTful API
+	ApdexReportResourcePath = InstanaAPIBasePath + "/apdex/report"
+)
+
+// ApdexReport represents the apdex score of an apdex configuration calculated by Instana for a given time window
+type ApdexReport struct {
+	ApdexID    string      `json:"apdexId"`
+	ApdexScore [][]float64 `json:"apdexScore"`
+	From       int64       `json:"from"`
+	To         int64       `json:"to"`
+}
+
+// NewApdexReportResource creates a new instance of ApdexReportResource
+func NewApdexReportResource(client RestClient) ApdexReportResource {
+	return &apdexReportResource{
+		resourcePath: ApdexReportResourcePath,
+		client:       client,
+	}
+}
+
+type apdexReportResource struct {
+	resourcePath string
+	client       RestClient
+}
+
+func (r *apdexReportResource) GetReports(apdexID string, from int64, to int64) ([]*ApdexReport, error) {
+	queryParams := map[string]string{
+		"from": strconv.FormatInt(from, 10),
+		"to":   strconv.FormatInt(to, 10),
+	}
+	data, err := r.client.GetByQuery(fmt.Sprintf("%s/%s", r.resourcePath, apdexID), queryParams)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*ApdexReport, 0)
+	err = json.Unmarshal(data, &reports)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return reports, nil
+}
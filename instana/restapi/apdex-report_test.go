@@ -0,0 +1,73 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	apdexReportApdexID  = "apdex-id"
+	apdexReportFrom     = int64(1000)
+	apdexReportTo       = int64(2000)
+	apdexReportFullPath = ApdexReportResourcePath + "/" + apdexReportApdexID
+)
+
+var apdexReportQueryParams = map[string]string{"from": "1000", "to": "2000"}
+
+func TestShouldSuccessfullyGetApdexReports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	response := `[{"apdexId":"apdex-id","apdexScore":[[1500,0.95],[1600,0.8]],"from":1000,"to":2000}]`
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetByQuery(apdexReportFullPath, apdexReportQueryParams).Times(1).Return([]byte(response), nil)
+
+	sut := NewApdexReportResource(restClient)
+
+	result, err := sut.GetReports(apdexReportApdexID, apdexReportFrom, apdexReportTo)
+
+	require.NoError(t, err)
+	require.Equal(t, []*ApdexReport{{
+		ApdexID:    apdexReportApdexID,
+		ApdexScore: [][]float64{{1500, 0.95}, {1600, 0.8}},
+		From:       apdexReportFrom,
+		To:         apdexReportTo,
+	}}, result)
+}
+
+func TestShouldFailToGetApdexReportsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetByQuery(apdexReportFullPath, apdexReportQueryParams).Times(1).Return(nil, expectedError)
+
+	sut := NewApdexReportResource(restClient)
+
+	_, err := sut.GetReports(apdexReportApdexID, apdexReportFrom, apdexReportTo)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetApdexReportsWhenResponseIsNotAValidJsonArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetByQuery(apdexReportFullPath, apdexReportQueryParams).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewApdexReportResource(restClient)
+
+	_, err := sut.GetReports(apdexReportApdexID, apdexReportFrom, apdexReportTo)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to parse json")
+}
@@ -25,6 +25,7 @@ type ApplicationAlertConfig struct {
 	Rule                  ApplicationAlertRule           `json:"rule"`
 	Threshold             Threshold                      `json:"threshold"`
 	TimeThreshold         TimeThreshold                  `json:"timeThreshold"`
+	Enabled               *bool                          `json:"enabled,omitempty"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject
@@ -32,6 +33,11 @@ func (a *ApplicationAlertConfig) GetIDForResourcePath() string {
 	return a.ID
 }
 
+// GetEnabled implementation of the interface EnableAwareInstanaDataObject
+func (a *ApplicationAlertConfig) GetEnabled() *bool {
+	return a.Enabled
+}
+
 // GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
 func (a *ApplicationAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any] {
 	return a.CustomerPayloadFields
@@ -0,0 +1,24 @@
+package restapi
+
+import "fmt"
+
+// NewBaselineAwareAlertConfigRestResource creates a new BaselineAwareAlertConfigRestResource which delegates all default operations to the provided RestResource and supports enabling/disabling, the version history and the recalculation of the historic baseline of the configurations of the given resource path
+func NewBaselineAwareAlertConfigRestResource[T EnableAwareInstanaDataObject](resourcePath string, delegate RestResource[T], client RestClient) BaselineAwareAlertConfigRestResource[T] {
+	return &baselineAwareAlertConfigRestResource[T]{
+		AlertConfigRestResource: NewAlertConfigRestResource(resourcePath, delegate, client),
+		resourcePath:            resourcePath,
+		client:                  client,
+	}
+}
+
+type baselineAwareAlertConfigRestResource[T EnableAwareInstanaDataObject] struct {
+	AlertConfigRestResource[T]
+	resourcePath string
+	client       RestClient
+}
+
+// UpdateBaseline triggers the recalculation of the historic baseline via the update-baseline endpoint of the configuration with the given ID
+func (r *baselineAwareAlertConfigRestResource[T]) UpdateBaseline(id string) error {
+	_, err := r.client.PostByQuery(fmt.Sprintf("%s/%s/update-baseline", r.resourcePath, id), map[string]string{})
+	return err
+}
@@ -0,0 +1,74 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+func TestBaselineAwareAlertConfigRestResource(t *testing.T) {
+	t.Run("should successfully update baseline", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+		restClient.EXPECT().PostByQuery(testResourcePath+"/test-id/update-baseline", map[string]string{}).Times(1).Return([]byte{}, nil)
+
+		sut := NewBaselineAwareAlertConfigRestResource[*WebsiteAlertConfig](testResourcePath, delegate, restClient)
+
+		err := sut.UpdateBaseline("test-id")
+
+		require.NoError(t, err)
+	})
+	t.Run("should return error when baseline update fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedError := errors.New("test")
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+		restClient.EXPECT().PostByQuery(testResourcePath+"/test-id/update-baseline", map[string]string{}).Times(1).Return(nil, expectedError)
+
+		sut := NewBaselineAwareAlertConfigRestResource[*WebsiteAlertConfig](testResourcePath, delegate, restClient)
+
+		err := sut.UpdateBaseline("test-id")
+
+		require.Error(t, err)
+		require.Equal(t, expectedError, err)
+	})
+	t.Run("should support default operations, enabled state, versions and baseline update", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := &WebsiteAlertConfig{ID: "test-id", Enabled: utils.BoolPtr(true)}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+		delegate.EXPECT().GetOne("test-id").Times(2).Return(object, nil)
+		restClient.EXPECT().PutWithoutBody(testResourcePath+"/test-id/enable").Times(1).Return([]byte{}, nil)
+		restClient.EXPECT().Get(testResourcePath+"/test-id/versions").Times(1).Return([]byte("[]"), nil)
+		restClient.EXPECT().PostByQuery(testResourcePath+"/test-id/update-baseline", map[string]string{}).Times(1).Return([]byte{}, nil)
+
+		sut := NewBaselineAwareAlertConfigRestResource[*WebsiteAlertConfig](testResourcePath, delegate, restClient)
+
+		result, err := sut.GetOne("test-id")
+		require.NoError(t, err)
+		require.Equal(t, object, result)
+
+		result, err = sut.UpdateEnabledState(object)
+		require.NoError(t, err)
+		require.Equal(t, object, result)
+
+		versions, err := sut.GetVersions("test-id")
+		require.NoError(t, err)
+		require.Empty(t, *versions)
+
+		require.NoError(t, sut.UpdateBaseline("test-id"))
+	})
+}
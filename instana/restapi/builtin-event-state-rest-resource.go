@@ -0,0 +1,96 @@
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// NewBuiltinEventStateRestResource creates a new REST resource to enable or disable builtin event specifications. Create reads the current state of the builtin event specification before it is changed, so that Delete can restore it. DeleteByID is not supported as the previous state is required
+func NewBuiltinEventStateRestResource(client RestClient) RestResource[*BuiltinEventState] {
+	return &builtinEventStateRestResource{
+		client: client,
+	}
+}
+
+type builtinEventStateRestResource struct {
+	client RestClient
+}
+
+func (r *builtinEventStateRestResource) GetAll() (*[]*BuiltinEventState, error) {
+	data, err := r.client.Get(BuiltinEventSpecificationResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	specs := make([]BuiltinEventSpecification, 0)
+	if err = json.Unmarshal(data, &specs); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	result := make([]*BuiltinEventState, len(specs))
+	for i, spec := range specs {
+		result[i] = &BuiltinEventState{ID: spec.ID, Enabled: spec.Enabled}
+	}
+	return &result, nil
+}
+
+func (r *builtinEventStateRestResource) GetOne(id string) (*BuiltinEventState, error) {
+	data, err := r.client.GetOne(id, BuiltinEventSpecificationResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshalState(id, data)
+}
+
+func (r *builtinEventStateRestResource) Create(data *BuiltinEventState) (*BuiltinEventState, error) {
+	current, err := r.GetOne(data.ID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.applyState(data.ID, data.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	result.PreviousEnabled = &current.Enabled
+	return result, nil
+}
+
+func (r *builtinEventStateRestResource) Update(data *BuiltinEventState) (*BuiltinEventState, error) {
+	result, err := r.applyState(data.ID, data.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	result.PreviousEnabled = data.PreviousEnabled
+	return result, nil
+}
+
+func (r *builtinEventStateRestResource) Delete(data *BuiltinEventState) error {
+	if data.PreviousEnabled == nil {
+		return nil
+	}
+	_, err := r.applyState(data.ID, *data.PreviousEnabled)
+	return err
+}
+
+func (r *builtinEventStateRestResource) DeleteByID(_ string) error {
+	return errors.New("delete of builtin event state by id is not supported as the previous state is required")
+}
+
+func (r *builtinEventStateRestResource) applyState(id string, enabled bool) (*BuiltinEventState, error) {
+	operation := "disable"
+	if enabled {
+		operation = "enable"
+	}
+	data, err := r.client.PostByQuery(fmt.Sprintf("%s/%s/%s", BuiltinEventSpecificationResourcePath, id, operation), map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshalState(id, data)
+}
+
+func (r *builtinEventStateRestResource) unmarshalState(id string, data []byte) (*BuiltinEventState, error) {
+	spec := &BuiltinEventSpecification{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return &BuiltinEventState{ID: id, Enabled: spec.Enabled}, nil
+}
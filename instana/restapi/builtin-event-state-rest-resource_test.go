@@ -0,0 +1,187 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	builtinEventStateID           = "builtin-id"
+	builtinEventEnablePath        = BuiltinEventSpecificationResourcePath + "/builtin-id/enable"
+	builtinEventDisablePath       = BuiltinEventSpecificationResourcePath + "/builtin-id/disable"
+	builtinEventEnabledPayload    = `{"id":"builtin-id","shortPluginId":"host","name":"name","severity":5,"triggering":false,"enabled":true,"lastUpdated":1}`
+	builtinEventDisabledPayload   = `{"id":"builtin-id","shortPluginId":"host","name":"name","severity":5,"triggering":false,"enabled":false,"lastUpdated":1}`
+	builtinEventSpecificationList = `[{"id":"builtin-id","enabled":false},{"id":"other-id","enabled":true}]`
+)
+
+func createBuiltinEventStateRestResource(ctrl *gomock.Controller) (*mocks.MockRestClient, RestResource[*BuiltinEventState]) {
+	restClient := mocks.NewMockRestClient(ctrl)
+	return restClient, NewBuiltinEventStateRestResource(restClient)
+}
+
+func TestShouldSuccessfullyGetOneBuiltinEventState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createBuiltinEventStateRestResource(ctrl)
+	restClient.EXPECT().GetOne(builtinEventStateID, BuiltinEventSpecificationResourcePath).Times(1).Return([]byte(builtinEventDisabledPayload), nil)
+
+	result, err := sut.GetOne(builtinEventStateID)
+
+	require.NoError(t, err)
+	require.Equal(t, &BuiltinEventState{ID: builtinEventStateID, Enabled: false}, result)
+}
+
+func TestShouldFailToGetOneBuiltinEventStateWhenClientReturnsErrorOrResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	restClient, sut := createBuiltinEventStateRestResource(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().GetOne(builtinEventStateID, BuiltinEventSpecificationResourcePath).Times(1).Return(nil, expectedError),
+		restClient.EXPECT().GetOne(builtinEventStateID, BuiltinEventSpecificationResourcePath).Times(1).Return([]byte("invalid"), nil),
+	)
+
+	_, err := sut.GetOne(builtinEventStateID)
+	require.Equal(t, expectedError, err)
+
+	_, err = sut.GetOne(builtinEventStateID)
+	require.ErrorContains(t, err, "failed to parse json")
+}
+
+func TestShouldSuccessfullyGetAllBuiltinEventStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createBuiltinEventStateRestResource(ctrl)
+	restClient.EXPECT().Get(BuiltinEventSpecificationResourcePath).Times(1).Return([]byte(builtinEventSpecificationList), nil)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*BuiltinEventState{{ID: builtinEventStateID, Enabled: false}, {ID: "other-id", Enabled: true}}, result)
+}
+
+func TestShouldFailToGetAllBuiltinEventStatesWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createBuiltinEventStateRestResource(ctrl)
+	restClient.EXPECT().Get(BuiltinEventSpecificationResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	_, err := sut.GetAll()
+
+	require.ErrorContains(t, err, "failed to parse json")
+}
+
+func TestShouldCreateBuiltinEventStateAndKeepPreviousState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createBuiltinEventStateRestResource(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().GetOne(builtinEventStateID, BuiltinEventSpecificationResourcePath).Times(1).Return([]byte(builtinEventEnabledPayload), nil),
+		restClient.EXPECT().PostByQuery(builtinEventDisablePath, map[string]string{}).Times(1).Return([]byte(builtinEventDisabledPayload), nil),
+	)
+
+	result, err := sut.Create(&BuiltinEventState{ID: builtinEventStateID, Enabled: false})
+
+	require.NoError(t, err)
+	require.Equal(t, &BuiltinEventState{ID: builtinEventStateID, Enabled: false, PreviousEnabled: utils.BoolPtr(true)}, result)
+}
+
+func TestShouldFailToCreateBuiltinEventStateWhenCurrentStateCannotBeRetrieved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	restClient, sut := createBuiltinEventStateRestResource(ctrl)
+	restClient.EXPECT().GetOne(builtinEventStateID, BuiltinEventSpecificationResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := sut.Create(&BuiltinEventState{ID: builtinEventStateID, Enabled: false})
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToCreateBuiltinEventStateWhenStateCannotBeApplied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	restClient, sut := createBuiltinEventStateRestResource(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().GetOne(builtinEventStateID, BuiltinEventSpecificationResourcePath).Times(1).Return([]byte(builtinEventEnabledPayload), nil),
+		restClient.EXPECT().PostByQuery(builtinEventDisablePath, map[string]string{}).Times(1).Return(nil, expectedError),
+	)
+
+	_, err := sut.Create(&BuiltinEventState{ID: builtinEventStateID, Enabled: false})
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldUpdateBuiltinEventStateAndPassThroughPreviousState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createBuiltinEventStateRestResource(ctrl)
+	restClient.EXPECT().PostByQuery(builtinEventEnablePath, map[string]string{}).Times(1).Return([]byte(builtinEventEnabledPayload), nil)
+
+	result, err := sut.Update(&BuiltinEventState{ID: builtinEventStateID, Enabled: true, PreviousEnabled: utils.BoolPtr(false)})
+
+	require.NoError(t, err)
+	require.Equal(t, &BuiltinEventState{ID: builtinEventStateID, Enabled: true, PreviousEnabled: utils.BoolPtr(false)}, result)
+}
+
+func TestShouldFailToUpdateBuiltinEventStateWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	restClient, sut := createBuiltinEventStateRestResource(ctrl)
+	restClient.EXPECT().PostByQuery(builtinEventEnablePath, map[string]string{}).Times(1).Return(nil, expectedError)
+
+	_, err := sut.Update(&BuiltinEventState{ID: builtinEventStateID, Enabled: true})
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldRestorePreviousBuiltinEventStateOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createBuiltinEventStateRestResource(ctrl)
+	restClient.EXPECT().PostByQuery(builtinEventEnablePath, map[string]string{}).Times(1).Return([]byte(builtinEventEnabledPayload), nil)
+
+	err := sut.Delete(&BuiltinEventState{ID: builtinEventStateID, Enabled: false, PreviousEnabled: utils.BoolPtr(true)})
+
+	require.NoError(t, err)
+}
+
+func TestShouldNotChangeBuiltinEventStateOnDeleteWhenPreviousStateIsUnknown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, sut := createBuiltinEventStateRestResource(ctrl)
+
+	err := sut.Delete(&BuiltinEventState{ID: builtinEventStateID, Enabled: false})
+
+	require.NoError(t, err)
+}
+
+func TestShouldFailToDeleteBuiltinEventStateByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, sut := createBuiltinEventStateRestResource(ctrl)
+
+	err := sut.DeleteByID(builtinEventStateID)
+
+	require.Error(t, err)
+}
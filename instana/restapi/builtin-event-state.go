@@ -0,0 +1,13 @@
+package restapi
+
+// BuiltinEventState data structure representing the enabled state of a builtin event specification. PreviousEnabled holds the state of the builtin event specification before it was managed and is used to restore it on delete. It is nil when the previous state is unknown
+type BuiltinEventState struct {
+	ID              string
+	Enabled         bool
+	PreviousEnabled *bool
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (s *BuiltinEventState) GetIDForResourcePath() string {
+	return s.ID
+}
@@ -0,0 +1,27 @@
+package restapi
+
+// ConfigVersion is the representation of a single version of the history of an alert configuration in Instana
+type ConfigVersion struct {
+	ID            string         `json:"id"`
+	Created       int64          `json:"created"`
+	Deleted       bool           `json:"deleted"`
+	Enabled       bool           `json:"enabled"`
+	ChangeSummary *ChangeSummary `json:"changeSummary"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (v *ConfigVersion) GetIDForResourcePath() string {
+	return v.ID
+}
+
+// ChangeSummary is the representation of the summary of a change of an alert configuration in Instana
+type ChangeSummary struct {
+	Author     ChangeAuthor `json:"author"`
+	ChangeType string       `json:"changeType"`
+}
+
+// ChangeAuthor is the representation of the author of a change of an alert configuration in Instana
+type ChangeAuthor struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
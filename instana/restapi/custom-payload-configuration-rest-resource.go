@@ -0,0 +1,38 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CustomPayloadConfigurationRestResource the REST resource of the singleton global custom payload configuration. In addition to the RestResource operations it provides access to the tag catalog of dynamic custom payload fields
+type CustomPayloadConfigurationRestResource interface {
+	RestResource[*CustomPayloadConfiguration]
+	GetTagCatalog() (*TagCatalog, error)
+}
+
+// NewCustomPayloadConfigurationRestResource creates a new REST resource for the global custom payload configuration. The configuration is a singleton which is created and updated via PUT. Deleting the configuration removes all global custom payload fields
+func NewCustomPayloadConfigurationRestResource(client RestClient) CustomPayloadConfigurationRestResource {
+	unmarshaller := NewCustomPayloadFieldsUnmarshallerAdapter[*CustomPayloadConfiguration](NewDefaultJSONUnmarshaller(&CustomPayloadConfiguration{}))
+	return &customPayloadConfigurationRestResource{
+		RestResource: NewSingletonSettingsRestResource[*CustomPayloadConfiguration](CustomPayloadConfigurationResourcePath, unmarshaller, client),
+		client:       client,
+	}
+}
+
+type customPayloadConfigurationRestResource struct {
+	RestResource[*CustomPayloadConfiguration]
+	client RestClient
+}
+
+func (r *customPayloadConfigurationRestResource) GetTagCatalog() (*TagCatalog, error) {
+	data, err := r.client.Get(CustomPayloadConfigurationTagCatalogResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	catalog := &TagCatalog{}
+	if err = json.Unmarshal(data, catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return catalog, nil
+}
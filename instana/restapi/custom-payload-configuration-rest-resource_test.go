@@ -0,0 +1,163 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	customPayloadConfigurationResponse = `{"fields":[{"type":"staticString","key":"static-key","value":"static-value"},{"type":"dynamic","key":"dynamic-key","value":{"tagName":"host.name","key":null}}],"lastUpdated":1234}`
+	customPayloadTagCatalogResponse    = `{"tagTree":[],"tags":[{"name":"host.name","type":"STRING","label":"Host Name"},{"name":"service.name","type":"STRING","label":"Service Name"}]}`
+)
+
+func makeExpectedCustomPayloadConfiguration() *CustomPayloadConfiguration {
+	return &CustomPayloadConfiguration{
+		Fields: []CustomPayloadField[any]{
+			{Type: StaticStringCustomPayloadType, Key: "static-key", Value: StaticStringCustomPayloadFieldValue("static-value")},
+			{Type: DynamicCustomPayloadType, Key: "dynamic-key", Value: DynamicCustomPayloadFieldValue{TagName: "host.name"}},
+		},
+		LastUpdated: 1234,
+	}
+}
+
+func TestShouldSuccessfullyGetCustomPayloadConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(CustomPayloadConfigurationResourcePath).Times(1).Return([]byte(customPayloadConfigurationResponse), nil)
+
+	sut := NewCustomPayloadConfigurationRestResource(restClient)
+
+	result, err := sut.GetOne(CustomPayloadConfigurationID)
+
+	require.NoError(t, err)
+	require.Equal(t, makeExpectedCustomPayloadConfiguration(), result)
+}
+
+func TestShouldSuccessfullyGetAllCustomPayloadConfigurations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(CustomPayloadConfigurationResourcePath).Times(1).Return([]byte(customPayloadConfigurationResponse), nil)
+
+	sut := NewCustomPayloadConfigurationRestResource(restClient)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*CustomPayloadConfiguration{makeExpectedCustomPayloadConfiguration()}, result)
+}
+
+func TestShouldFailToGetCustomPayloadConfigurationWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(CustomPayloadConfigurationResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewCustomPayloadConfigurationRestResource(restClient)
+
+	_, err := sut.GetOne(CustomPayloadConfigurationID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldCreateAndUpdateCustomPayloadConfigurationViaPutAndReadItAfterwards(t *testing.T) {
+	for name, operation := range map[string]func(r RestResource[*CustomPayloadConfiguration], c *CustomPayloadConfiguration) (*CustomPayloadConfiguration, error){
+		"create": func(r RestResource[*CustomPayloadConfiguration], c *CustomPayloadConfiguration) (*CustomPayloadConfiguration, error) {
+			return r.Create(c)
+		},
+		"update": func(r RestResource[*CustomPayloadConfiguration], c *CustomPayloadConfiguration) (*CustomPayloadConfiguration, error) {
+			return r.Update(c)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			config := makeExpectedCustomPayloadConfiguration()
+			restClient := mocks.NewMockRestClient(ctrl)
+			gomock.InOrder(
+				restClient.EXPECT().PutWithoutID(config, CustomPayloadConfigurationResourcePath).Times(1).Return([]byte("[]"), nil),
+				restClient.EXPECT().Get(CustomPayloadConfigurationResourcePath).Times(1).Return([]byte(customPayloadConfigurationResponse), nil),
+			)
+
+			sut := NewCustomPayloadConfigurationRestResource(restClient)
+
+			result, err := operation(sut, config)
+
+			require.NoError(t, err)
+			require.Equal(t, makeExpectedCustomPayloadConfiguration(), result)
+		})
+	}
+}
+
+func TestShouldFailToUpdateCustomPayloadConfigurationWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(gomock.Any(), CustomPayloadConfigurationResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewCustomPayloadConfigurationRestResource(restClient)
+
+	_, err := sut.Update(makeExpectedCustomPayloadConfiguration())
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldDeleteCustomPayloadConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().DeleteWithoutID(CustomPayloadConfigurationResourcePath).Times(2).Return(nil)
+
+	sut := NewCustomPayloadConfigurationRestResource(restClient)
+
+	require.NoError(t, sut.Delete(makeExpectedCustomPayloadConfiguration()))
+	require.NoError(t, sut.DeleteByID(CustomPayloadConfigurationID))
+}
+
+func TestShouldSuccessfullyGetTagCatalogOfCustomPayloadConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(CustomPayloadConfigurationTagCatalogResourcePath).Times(1).Return([]byte(customPayloadTagCatalogResponse), nil)
+
+	sut := NewCustomPayloadConfigurationRestResource(restClient)
+
+	result, err := sut.GetTagCatalog()
+
+	require.NoError(t, err)
+	require.Len(t, result.Tags, 2)
+	require.True(t, result.ContainsTag("host.name"))
+	require.True(t, result.ContainsTag("service.name"))
+	require.False(t, result.ContainsTag("unknown"))
+}
+
+func TestShouldFailToGetTagCatalogOfCustomPayloadConfigurationWhenResponseIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(CustomPayloadConfigurationTagCatalogResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewCustomPayloadConfigurationRestResource(restClient)
+
+	_, err := sut.GetTagCatalog()
+
+	require.Error(t, err)
+}
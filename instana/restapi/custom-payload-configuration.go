@@ -0,0 +1,53 @@
+package restapi
+
+const (
+	//CustomPayloadConfigurationResourcePath path to the global custom payload configuration of the Instana RESTful API
+	CustomPayloadConfigurationResourcePath = EventSettingsBasePath + "/custom-payload-configurations"
+	//CustomPayloadConfigurationTagCatalogResourcePath path to the tag catalog of the global custom payload configuration of the Instana RESTful API
+	CustomPayloadConfigurationTagCatalogResourcePath = CustomPayloadConfigurationResourcePath + "/catalog"
+	//CustomPayloadConfigurationID the static ID of the singleton global custom payload configuration
+	CustomPayloadConfigurationID = "global-custom-payload-configuration"
+)
+
+// CustomPayloadConfiguration represents the tenant wide custom payload configuration which is added to all alerts. The configuration is a singleton and therefore identified by a static ID
+type CustomPayloadConfiguration struct {
+	Fields      []CustomPayloadField[any] `json:"fields"`
+	LastUpdated int64                     `json:"lastUpdated,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *CustomPayloadConfiguration) GetIDForResourcePath() string {
+	return CustomPayloadConfigurationID
+}
+
+// GetCustomerPayloadFields implementation of the interface CustomPayloadFieldsAware
+func (c *CustomPayloadConfiguration) GetCustomerPayloadFields() []CustomPayloadField[any] {
+	return c.Fields
+}
+
+// SetCustomerPayloadFields implementation of the interface CustomPayloadFieldsAware
+func (c *CustomPayloadConfiguration) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
+	c.Fields = fields
+}
+
+// TagCatalog represents the catalog of tags which can be used for dynamic custom payload fields
+type TagCatalog struct {
+	Tags []TagCatalogTag `json:"tags"`
+}
+
+// TagCatalogTag represents a single tag of the TagCatalog
+type TagCatalogTag struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Label string `json:"label"`
+}
+
+// ContainsTag returns true when the catalog contains a tag with the given name
+func (c *TagCatalog) ContainsTag(name string) bool {
+	for _, t := range c.Tags {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
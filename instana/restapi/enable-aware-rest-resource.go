@@ -0,0 +1,74 @@
+package restapi
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+// NewEnableAwareRestResource creates a new EnableAwareRestResource which delegates all default operations to the provided RestResource and applies the enabled state via the enable and disable endpoints of the given resource path
+func NewEnableAwareRestResource[T EnableAwareInstanaDataObject](resourcePath string, delegate RestResource[T], client RestClient) EnableAwareRestResource[T] {
+	return &enableAwareRestResource[T]{
+		RestResource: delegate,
+		resourcePath: resourcePath,
+		client:       client,
+	}
+}
+
+type enableAwareRestResource[T EnableAwareInstanaDataObject] struct {
+	RestResource[T]
+	resourcePath string
+	client       RestClient
+}
+
+func (r *enableAwareRestResource[T]) Create(data T) (T, error) {
+	created, err := r.RestResource.Create(data)
+	if err != nil {
+		return created, err
+	}
+	return r.applyEnabledState(data, created)
+}
+
+func (r *enableAwareRestResource[T]) Update(data T) (T, error) {
+	updated, err := r.RestResource.Update(data)
+	if err != nil {
+		return updated, err
+	}
+	return r.applyEnabledState(data, updated)
+}
+
+// UpdateEnabledState enables or disables the given object via the dedicated endpoints without rewriting the full object and returns the current state of the object
+func (r *enableAwareRestResource[T]) UpdateEnabledState(data T) (T, error) {
+	id := data.GetIDForResourcePath()
+	if data.GetEnabled() != nil {
+		if err := r.putEnabledState(id, *data.GetEnabled()); err != nil {
+			return utils.GetZeroValue[T](), err
+		}
+	}
+	return r.RestResource.GetOne(id)
+}
+
+func (r *enableAwareRestResource[T]) applyEnabledState(data T, current T) (T, error) {
+	if data.GetEnabled() == nil || isEnabled(current) == *data.GetEnabled() {
+		return current, nil
+	}
+	id := current.GetIDForResourcePath()
+	if err := r.putEnabledState(id, *data.GetEnabled()); err != nil {
+		return current, err
+	}
+	return r.RestResource.GetOne(id)
+}
+
+func (r *enableAwareRestResource[T]) putEnabledState(id string, enabled bool) error {
+	operation := "disable"
+	if enabled {
+		operation = "enable"
+	}
+	_, err := r.client.PutWithoutBody(fmt.Sprintf("%s/%s/%s", r.resourcePath, id, operation))
+	return err
+}
+
+func isEnabled[T EnableAwareInstanaDataObject](obj T) bool {
+	//objects are enabled by default when the API does not provide the state explicitly
+	return obj.GetEnabled() == nil || *obj.GetEnabled()
+}
@@ -0,0 +1,164 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+const enableAwareTestID = "test-id"
+
+func TestEnableAwareRestResource(t *testing.T) {
+	t.Run("should not toggle enabled state on create when created object matches desired state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := &ApplicationAlertConfig{ID: enableAwareTestID, Enabled: utils.BoolPtr(true)}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+		delegate.EXPECT().Create(object).Times(1).Return(object, nil)
+
+		sut := NewEnableAwareRestResource[*ApplicationAlertConfig](testResourcePath, delegate, restClient)
+
+		result, err := sut.Create(object)
+
+		require.NoError(t, err)
+		require.Equal(t, object, result)
+	})
+	t.Run("should disable object after create when disabled state is requested", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := &ApplicationAlertConfig{ID: enableAwareTestID, Enabled: utils.BoolPtr(false)}
+		created := &ApplicationAlertConfig{ID: enableAwareTestID, Enabled: utils.BoolPtr(true)}
+		refreshed := &ApplicationAlertConfig{ID: enableAwareTestID, Enabled: utils.BoolPtr(false)}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+		gomock.InOrder(
+			delegate.EXPECT().Create(object).Times(1).Return(created, nil),
+			restClient.EXPECT().PutWithoutBody(testResourcePath+"/"+enableAwareTestID+"/disable").Times(1).Return([]byte{}, nil),
+			delegate.EXPECT().GetOne(enableAwareTestID).Times(1).Return(refreshed, nil),
+		)
+
+		sut := NewEnableAwareRestResource[*ApplicationAlertConfig](testResourcePath, delegate, restClient)
+
+		result, err := sut.Create(object)
+
+		require.NoError(t, err)
+		require.Equal(t, refreshed, result)
+	})
+	t.Run("should treat object as enabled after update when API does not return enabled state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := &ApplicationAlertConfig{ID: enableAwareTestID, Enabled: utils.BoolPtr(true)}
+		updated := &ApplicationAlertConfig{ID: enableAwareTestID}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+		delegate.EXPECT().Update(object).Times(1).Return(updated, nil)
+
+		sut := NewEnableAwareRestResource[*ApplicationAlertConfig](testResourcePath, delegate, restClient)
+
+		result, err := sut.Update(object)
+
+		require.NoError(t, err)
+		require.Equal(t, updated, result)
+	})
+	t.Run("should enable object after update when object is disabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := &ApplicationAlertConfig{ID: enableAwareTestID, Enabled: utils.BoolPtr(true)}
+		updated := &ApplicationAlertConfig{ID: enableAwareTestID, Enabled: utils.BoolPtr(false)}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+		gomock.InOrder(
+			delegate.EXPECT().Update(object).Times(1).Return(updated, nil),
+			restClient.EXPECT().PutWithoutBody(testResourcePath+"/"+enableAwareTestID+"/enable").Times(1).Return([]byte{}, nil),
+			delegate.EXPECT().GetOne(enableAwareTestID).Times(1).Return(object, nil),
+		)
+
+		sut := NewEnableAwareRestResource[*ApplicationAlertConfig](testResourcePath, delegate, restClient)
+
+		result, err := sut.Update(object)
+
+		require.NoError(t, err)
+		require.Equal(t, object, result)
+	})
+	t.Run("should return error when update of delegate fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedError := errors.New("test")
+		object := &ApplicationAlertConfig{ID: enableAwareTestID, Enabled: utils.BoolPtr(false)}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+		delegate.EXPECT().Update(object).Times(1).Return(nil, expectedError)
+
+		sut := NewEnableAwareRestResource[*ApplicationAlertConfig](testResourcePath, delegate, restClient)
+
+		_, err := sut.Update(object)
+
+		require.Error(t, err)
+		require.Equal(t, expectedError, err)
+	})
+	t.Run("should only call disable endpoint when updating enabled state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := &ApplicationAlertConfig{ID: enableAwareTestID, Name: "name", Enabled: utils.BoolPtr(false)}
+		refreshed := &ApplicationAlertConfig{ID: enableAwareTestID, Name: "refreshed", Enabled: utils.BoolPtr(false)}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+		gomock.InOrder(
+			restClient.EXPECT().PutWithoutBody(testResourcePath+"/"+enableAwareTestID+"/disable").Times(1).Return([]byte{}, nil),
+			delegate.EXPECT().GetOne(enableAwareTestID).Times(1).Return(refreshed, nil),
+		)
+
+		sut := NewEnableAwareRestResource[*ApplicationAlertConfig](testResourcePath, delegate, restClient)
+
+		result, err := sut.UpdateEnabledState(object)
+
+		require.NoError(t, err)
+		require.Equal(t, refreshed, result)
+	})
+	t.Run("should return error when enable endpoint fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedError := errors.New("test")
+		object := &ApplicationAlertConfig{ID: enableAwareTestID, Enabled: utils.BoolPtr(true)}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+		restClient.EXPECT().PutWithoutBody(testResourcePath+"/"+enableAwareTestID+"/enable").Times(1).Return(nil, expectedError)
+
+		sut := NewEnableAwareRestResource[*ApplicationAlertConfig](testResourcePath, delegate, restClient)
+
+		_, err := sut.UpdateEnabledState(object)
+
+		require.Error(t, err)
+		require.Equal(t, expectedError, err)
+	})
+	t.Run("should only read object when updating enabled state without a requested state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := &ApplicationAlertConfig{ID: enableAwareTestID}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+		delegate.EXPECT().GetOne(enableAwareTestID).Times(1).Return(object, nil)
+
+		sut := NewEnableAwareRestResource[*ApplicationAlertConfig](testResourcePath, delegate, restClient)
+
+		result, err := sut.UpdateEnabledState(object)
+
+		require.NoError(t, err)
+		require.Equal(t, object, result)
+	})
+}
@@ -157,10 +157,12 @@ type APIMember struct {
 
 // Group data structure for the Instana API model for groups
 type Group struct {
-	ID            string                    `json:"id"`
-	Name          string                    `json:"name"`
-	Members       []APIMember               `json:"members"`
-	PermissionSet APIPermissionSetWithRoles `json:"permissionSet"`
+	ID                  string                    `json:"id"`
+	Name                string                    `json:"name"`
+	Members             []APIMember               `json:"members"`
+	PermissionSet       APIPermissionSetWithRoles `json:"permissionSet"`
+	IgnoreMembers       bool                      `json:"-"`
+	IgnorePermissionSet bool                      `json:"-"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject
@@ -0,0 +1,68 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// NewHostAgentConfigurationRestResource creates a new REST resource to push configuration management settings to host agents. The Instana
+// API does not provide endpoints to read or remove the configuration of host agents. Therefore, GetOne is a stub which only returns the ID
+// of the configuration and Delete as well as DeleteByID do not execute any API call
+func NewHostAgentConfigurationRestResource(client RestClient) RestResource[*HostAgentConfiguration] {
+	return &hostAgentConfigurationRestResource{
+		client: client,
+	}
+}
+
+type hostAgentConfigurationRestResource struct {
+	client RestClient
+}
+
+func (r *hostAgentConfigurationRestResource) GetAll() (*[]*HostAgentConfiguration, error) {
+	return nil, errors.New("reading all host agent configurations is not supported by the Instana API")
+}
+
+// GetOne is a stub which returns the configuration with the given ID only as the Instana API does not provide the configuration of host agents
+func (r *hostAgentConfigurationRestResource) GetOne(id string) (*HostAgentConfiguration, error) {
+	return &HostAgentConfiguration{ID: id}, nil
+}
+
+func (r *hostAgentConfigurationRestResource) Create(data *HostAgentConfiguration) (*HostAgentConfiguration, error) {
+	return r.apply(data)
+}
+
+func (r *hostAgentConfigurationRestResource) Update(data *HostAgentConfiguration) (*HostAgentConfiguration, error) {
+	return r.apply(data)
+}
+
+func (r *hostAgentConfigurationRestResource) apply(data *HostAgentConfiguration) (*HostAgentConfiguration, error) {
+	var err error
+	if len(data.HostID) > 0 {
+		_, err = r.client.Post(data, fmt.Sprintf("%s/%s/configuration", HostAgentResourcePath, url.PathEscape(data.HostID)))
+	} else {
+		_, err = r.client.PostWithQuery(data, HostAgentConfigurationResourcePath, r.buildQueryParameters(data))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *hostAgentConfigurationRestResource) buildQueryParameters(data *HostAgentConfiguration) map[string]string {
+	queryParams := map[string]string{}
+	if len(data.Query) > 0 {
+		queryParams["query"] = data.Query
+	}
+	return queryParams
+}
+
+// Delete does not execute any API call as the Instana API does not provide an endpoint to remove the configuration of host agents
+func (r *hostAgentConfigurationRestResource) Delete(_ *HostAgentConfiguration) error {
+	return nil
+}
+
+// DeleteByID does not execute any API call as the Instana API does not provide an endpoint to remove the configuration of host agents
+func (r *hostAgentConfigurationRestResource) DeleteByID(_ string) error {
+	return nil
+}
@@ -0,0 +1,127 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const hostAgentConfigurationID = "config-id"
+
+func newTestHostAgentConfiguration(hostID string, query string) *HostAgentConfiguration {
+	return &HostAgentConfiguration{
+		ID:     hostAgentConfigurationID,
+		HostID: hostID,
+		Query:  query,
+		Configuration: map[string]interface{}{
+			"remoteUri":    "https://git.example.com/agent-config.git",
+			"remoteBranch": "main",
+		},
+	}
+}
+
+func TestHostAgentConfigurationRestResource(t *testing.T) {
+	t.Run("should push configuration to single host agent on create", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := newTestHostAgentConfiguration("host-id", "")
+		restClient := mocks.NewMockRestClient(ctrl)
+		restClient.EXPECT().Post(object, HostAgentResourcePath+"/host-id/configuration").Times(1).Return([]byte{}, nil)
+
+		sut := NewHostAgentConfigurationRestResource(restClient)
+
+		result, err := sut.Create(object)
+
+		require.NoError(t, err)
+		require.Equal(t, object, result)
+	})
+	t.Run("should push configuration to host agents selected by query on update", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := newTestHostAgentConfiguration("", "entity.zone:test zone")
+		restClient := mocks.NewMockRestClient(ctrl)
+		restClient.EXPECT().PostWithQuery(object, HostAgentConfigurationResourcePath, map[string]string{"query": "entity.zone:test zone"}).Times(1).Return([]byte{}, nil)
+
+		sut := NewHostAgentConfigurationRestResource(restClient)
+
+		result, err := sut.Update(object)
+
+		require.NoError(t, err)
+		require.Equal(t, object, result)
+	})
+	t.Run("should push configuration to all host agents of the tenant when neither host id nor query is provided", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := newTestHostAgentConfiguration("", "")
+		restClient := mocks.NewMockRestClient(ctrl)
+		restClient.EXPECT().PostWithQuery(object, HostAgentConfigurationResourcePath, map[string]string{}).Times(1).Return([]byte{}, nil)
+
+		sut := NewHostAgentConfigurationRestResource(restClient)
+
+		_, err := sut.Create(object)
+
+		require.NoError(t, err)
+	})
+	t.Run("should return error when configuration cannot be pushed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedError := errors.New("test")
+		object := newTestHostAgentConfiguration("host-id", "")
+		restClient := mocks.NewMockRestClient(ctrl)
+		restClient.EXPECT().Post(object, gomock.Any()).Times(1).Return(nil, expectedError)
+
+		sut := NewHostAgentConfigurationRestResource(restClient)
+
+		_, err := sut.Create(object)
+
+		require.Error(t, err)
+		require.Equal(t, expectedError, err)
+	})
+	t.Run("should return configuration with id only on get one without calling the api", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		restClient := mocks.NewMockRestClient(ctrl)
+
+		sut := NewHostAgentConfigurationRestResource(restClient)
+
+		result, err := sut.GetOne(hostAgentConfigurationID)
+
+		require.NoError(t, err)
+		require.Equal(t, &HostAgentConfiguration{ID: hostAgentConfigurationID}, result)
+	})
+	t.Run("should return error on get all", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sut := NewHostAgentConfigurationRestResource(mocks.NewMockRestClient(ctrl))
+
+		_, err := sut.GetAll()
+
+		require.Error(t, err)
+	})
+	t.Run("should not call the api on delete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sut := NewHostAgentConfigurationRestResource(mocks.NewMockRestClient(ctrl))
+
+		require.NoError(t, sut.Delete(newTestHostAgentConfiguration("host-id", "")))
+		require.NoError(t, sut.DeleteByID(hostAgentConfigurationID))
+	})
+	t.Run("should marshal configuration document without id, host id and query", func(t *testing.T) {
+		result, err := json.Marshal(newTestHostAgentConfiguration("host-id", "query"))
+
+		require.NoError(t, err)
+		require.JSONEq(t, `{"remoteUri":"https://git.example.com/agent-config.git","remoteBranch":"main"}`, string(result))
+	})
+}
@@ -0,0 +1,29 @@
+package restapi
+
+import "encoding/json"
+
+const (
+	//HostAgentConfigurationResourcePath path to the resource of the Instana API to update the configuration of the host agents selected by a dynamic focus query
+	HostAgentConfigurationResourcePath = HostAgentResourcePath + "/configuration"
+)
+
+// HostAgentConfiguration data structure representing a configuration document which is pushed to host agents. The configuration is either
+// applied to the host agent of the given HostID or to all host agents selected by the given dynamic focus Query. When neither HostID nor
+// Query is provided, the configuration is applied to all host agents of the tenant. The configuration document is sent as is as request
+// body (AgentConfigurationUpdate) to the Instana API
+type HostAgentConfiguration struct {
+	ID            string
+	HostID        string
+	Query         string
+	Configuration map[string]interface{}
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *HostAgentConfiguration) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// MarshalJSON renders the configuration document as plain JSON object as expected by the Instana API
+func (c *HostAgentConfiguration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Configuration)
+}
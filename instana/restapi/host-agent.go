@@ -0,0 +1,62 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	//HostAgentResourcePath path to the host agent resource of the Instana RESTful API
+	HostAgentResourcePath = InstanaAPIBasePath + "/host-agent"
+)
+
+// HostAgent represents the snapshot of a host agent as provided by the Instana API
+type HostAgent struct {
+	SnapshotID string   `json:"snapshotId"`
+	Host       string   `json:"host"`
+	Label      string   `json:"label"`
+	Plugin     string   `json:"plugin"`
+	Tags       []string `json:"tags"`
+	From       int64    `json:"from"`
+	To         *int64   `json:"to"`
+}
+
+type hostAgentSearchResult struct {
+	Items []*HostAgent `json:"items"`
+}
+
+// NewHostAgentResource creates a new instance of HostAgentResource
+func NewHostAgentResource(client RestClient) HostAgentResource {
+	return &hostAgentResource{
+		resourcePath: HostAgentResourcePath,
+		client:       client,
+	}
+}
+
+type hostAgentResource struct {
+	resourcePath string
+	client       RestClient
+}
+
+func (r *hostAgentResource) GetHostAgents(query string, offline bool) ([]*HostAgent, error) {
+	queryParams := map[string]string{
+		"offline": strconv.FormatBool(offline),
+	}
+	if len(query) > 0 {
+		queryParams["query"] = query
+	}
+	data, err := r.client.GetByQuery(r.resourcePath, queryParams)
+	if err != nil {
+		return nil, err
+	}
+	result := &hostAgentSearchResult{}
+	err = json.Unmarshal(data, result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	if result.Items == nil {
+		return []*HostAgent{}, nil
+	}
+	return result.Items, nil
+}
@@ -0,0 +1,83 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const hostAgentQuery = "entity.zone:test"
+
+func TestShouldSuccessfullyGetHostAgents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	response := `{"items":[{"snapshotId":"snapshot-id","host":"host-id","label":"agent-label","plugin":"com.instana.plugin.host","tags":["tag1"],"from":1000}]}`
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetByQuery(HostAgentResourcePath, map[string]string{"offline": "false", "query": hostAgentQuery}).Times(1).Return([]byte(response), nil)
+
+	sut := NewHostAgentResource(restClient)
+
+	result, err := sut.GetHostAgents(hostAgentQuery, false)
+
+	require.NoError(t, err)
+	require.Equal(t, []*HostAgent{{
+		SnapshotID: "snapshot-id",
+		Host:       "host-id",
+		Label:      "agent-label",
+		Plugin:     "com.instana.plugin.host",
+		Tags:       []string{"tag1"},
+		From:       1000,
+	}}, result)
+}
+
+func TestShouldGetHostAgentsWithoutQueryAndIncludingOfflineAgents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetByQuery(HostAgentResourcePath, map[string]string{"offline": "true"}).Times(1).Return([]byte(`{}`), nil)
+
+	sut := NewHostAgentResource(restClient)
+
+	result, err := sut.GetHostAgents("", true)
+
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
+
+func TestShouldFailToGetHostAgentsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetByQuery(HostAgentResourcePath, gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := NewHostAgentResource(restClient)
+
+	_, err := sut.GetHostAgents("", false)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetHostAgentsWhenResponseIsNotAValidJsonObject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetByQuery(HostAgentResourcePath, gomock.Any()).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewHostAgentResource(restClient)
+
+	_, err := sut.GetHostAgents("", false)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to parse json")
+}
@@ -0,0 +1,46 @@
+package restapi
+
+const (
+	//HttpEndpointConfigResourcePath path to the HTTP endpoint configuration resource of the Instana RESTful API
+	HttpEndpointConfigResourcePath = ApplicationMonitoringSettingsBasePath + "/http-endpoint"
+)
+
+// HttpPathSegmentMatchingType type definition of the matching types of path segments of HTTP endpoint rules
+type HttpPathSegmentMatchingType string
+
+const (
+	//HttpPathSegmentMatchingTypeFixed constant value for path segments which have to match the given name exactly
+	HttpPathSegmentMatchingTypeFixed = HttpPathSegmentMatchingType("FIXED")
+	//HttpPathSegmentMatchingTypeParameter constant value for path segments which represent a path parameter
+	HttpPathSegmentMatchingTypeParameter = HttpPathSegmentMatchingType("PARAMETER")
+	//HttpPathSegmentMatchingTypeMatchAll constant value for path segments which match all remaining path segments
+	HttpPathSegmentMatchingTypeMatchAll = HttpPathSegmentMatchingType("MATCH_ALL")
+	//HttpPathSegmentMatchingTypeUnsupported constant value for path segments of a type which is not supported by the Instana API
+	HttpPathSegmentMatchingTypeUnsupported = HttpPathSegmentMatchingType("UNSUPPORTED")
+)
+
+// HttpPathSegmentMatchingRule represents a single path segment matcher of an HTTP endpoint rule
+type HttpPathSegmentMatchingRule struct {
+	Type HttpPathSegmentMatchingType `json:"type"`
+	Name *string                     `json:"name,omitempty"`
+}
+
+// HttpEndpointRule represents a rule to name the endpoints of HTTP calls based on the path of the call
+type HttpEndpointRule struct {
+	Enabled      bool                          `json:"enabled"`
+	PathSegments []HttpPathSegmentMatchingRule `json:"pathSegments"`
+	TestCases    []string                      `json:"testCases"`
+}
+
+// HttpEndpointConfig represents the REST resource of the HTTP endpoint configuration of a service at Instana. The configuration is identified by the ID of the service
+type HttpEndpointConfig struct {
+	ServiceID                                      string             `json:"serviceId"`
+	EndpointNameByFirstPathSegmentRuleEnabled      bool               `json:"endpointNameByFirstPathSegmentRuleEnabled"`
+	EndpointNameByCollectedPathTemplateRuleEnabled bool               `json:"endpointNameByCollectedPathTemplateRuleEnabled"`
+	Rules                                          []HttpEndpointRule `json:"rules"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *HttpEndpointConfig) GetIDForResourcePath() string {
+	return c.ServiceID
+}
@@ -0,0 +1,35 @@
+package restapi
+
+// InfraAlertConfigResourcePath path to infrastructure alert config resource of Instana RESTful API
+const InfraAlertConfigResourcePath = EventSettingsBasePath + "/infra-alert-configs"
+
+// InfraAlertConfig is the representation of an infrastructure alert configuration in Instana
+type InfraAlertConfig struct {
+	ID                    string                    `json:"id"`
+	Name                  string                    `json:"name"`
+	Description           string                    `json:"description"`
+	Severity              int                       `json:"severity"`
+	TagFilterExpression   *TagFilter                `json:"tagFilterExpression"`
+	GroupBy               []string                  `json:"groupBy"`
+	AlertChannelIDs       []string                  `json:"alertChannelIds"`
+	Granularity           Granularity               `json:"granularity"`
+	CustomerPayloadFields []CustomPayloadField[any] `json:"customPayloadFields"`
+	Rule                  InfraAlertRule            `json:"rule"`
+	Threshold             Threshold                 `json:"threshold"`
+	TimeThreshold         InfraTimeThreshold        `json:"timeThreshold"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (a *InfraAlertConfig) GetIDForResourcePath() string {
+	return a.ID
+}
+
+// GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (a *InfraAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any] {
+	return a.CustomerPayloadFields
+}
+
+// SetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (a *InfraAlertConfig) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
+	a.CustomerPayloadFields = fields
+}
@@ -0,0 +1,10 @@
+package restapi
+
+// InfraAlertRule struct representing the API model of an infrastructure alert rule
+type InfraAlertRule struct {
+	AlertType              string       `json:"alertType"`
+	MetricName             string       `json:"metricName"`
+	EntityType             string       `json:"entityType"`
+	Aggregation            *Aggregation `json:"aggregation"`
+	CrossSeriesAggregation *Aggregation `json:"crossSeriesAggregation"`
+}
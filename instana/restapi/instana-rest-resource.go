@@ -15,6 +15,66 @@ type RestResource[T InstanaDataObject] interface {
 	DeleteByID(id string) error
 }
 
+// BaselineAwareRestResource interface definition of a instana REST resource of an alert configuration which supports the
+// recalculation of historic baseline thresholds in addition to the default operations of a RestResource
+type BaselineAwareRestResource[T InstanaDataObject] interface {
+	RestResource[T]
+	UpdateBaseline(id string) error
+}
+
+// EnableAwareInstanaDataObject interface definition of an InstanaDataObject which can be enabled and disabled via dedicated endpoints of the Instana API
+type EnableAwareInstanaDataObject interface {
+	InstanaDataObject
+	GetEnabled() *bool
+}
+
+// EnableAwareRestResource interface definition of a instana REST resource of an alert configuration which supports
+// enabling and disabling the configuration via dedicated endpoints in addition to the default operations of a RestResource
+type EnableAwareRestResource[T EnableAwareInstanaDataObject] interface {
+	RestResource[T]
+	UpdateEnabledState(data T) (T, error)
+}
+
+// VersionsAwareRestResource extension interface of a REST resource of an alert configuration which provides access to the version
+// history of a configuration and allows to restore a previous version identified by its creation timestamp
+type VersionsAwareRestResource interface {
+	GetVersions(id string) (*[]*ConfigVersion, error)
+	RestoreVersion(id string, created int64) error
+}
+
+// AlertConfigRestResource interface definition of a instana REST resource of a smart alert configuration which supports
+// enabling/disabling and the version history in addition to the default operations of a RestResource
+type AlertConfigRestResource[T EnableAwareInstanaDataObject] interface {
+	EnableAwareRestResource[T]
+	VersionsAwareRestResource
+}
+
+// BaselineAwareAlertConfigRestResource interface definition of a instana REST resource of a smart alert configuration which
+// supports the recalculation of historic baseline thresholds in addition to the operations of an AlertConfigRestResource
+type BaselineAwareAlertConfigRestResource[T EnableAwareInstanaDataObject] interface {
+	AlertConfigRestResource[T]
+	BaselineAwareRestResource[T]
+}
+
+// AlertingChannelRestResource interface definition of the REST resource of alerting channels which supports sending test notifications in addition to the default operations of a RestResource
+type AlertingChannelRestResource interface {
+	RestResource[*AlertingChannel]
+	SendTestNotification(channel *AlertingChannel) error
+}
+
+// ManualServiceConfigRestResource interface definition of the REST resource of manual service configurations which supports replacing all configurations at once in addition to the default operations of a RestResource
+type ManualServiceConfigRestResource interface {
+	RestResource[*ManualServiceConfig]
+	ReplaceAll(configs ManualServiceConfigs) (*[]*ManualServiceConfig, error)
+}
+
+// WebsiteMonitoringConfigRestResource interface definition of the REST resource of website monitoring configurations which supports
+// reading selected privacy settings of a website in addition to the default operations of a RestResource
+type WebsiteMonitoringConfigRestResource interface {
+	RestResource[*WebsiteMonitoringConfig]
+	GetPrivacySettings(id string, selection MonitoringPrivacySettingsSelection) (MonitoringPrivacySettings, error)
+}
+
 // DataFilterFunc function definition for filtering data received from Instana API
 type DataFilterFunc func(o InstanaDataObject) bool
 
@@ -25,6 +85,16 @@ type ReadOnlyRestResource[T InstanaDataObject] interface {
 	GetOne(id string) (T, error)
 }
 
+// ApdexReportResource interface definition of the read only REST resource providing the apdex reports of an apdex configuration for a given time window
+type ApdexReportResource interface {
+	GetReports(apdexID string, from int64, to int64) ([]*ApdexReport, error)
+}
+
+// HostAgentResource interface definition of the read only REST resource providing the host agents of the tenant
+type HostAgentResource interface {
+	GetHostAgents(query string, offline bool) ([]*HostAgent, error)
+}
+
 // JSONUnmarshaller interface definition for unmarshalling that unmarshalls JSON to go data structures
 type JSONUnmarshaller[T any] interface {
 	//Unmarshal converts the provided json bytes into the go data structure as provided in the target
@@ -0,0 +1,51 @@
+package restapi
+
+import "fmt"
+
+// NewMaintenanceWindowConfigRestResource creates a new REST resource for maintenance window configurations. Create and update are delegated to a REST resource using PUT for both operations. The paused state of the configuration is applied afterwards via the pause and resume endpoints of the Instana API
+func NewMaintenanceWindowConfigRestResource(unmarshaller JSONUnmarshaller[*MaintenanceWindowConfig], client RestClient) RestResource[*MaintenanceWindowConfig] {
+	return &maintenanceWindowConfigRestResource{
+		RestResource: NewCreatePUTUpdatePUTRestResource(MaintenanceWindowConfigResourcePath, unmarshaller, client),
+		resourcePath: MaintenanceWindowConfigResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type maintenanceWindowConfigRestResource struct {
+	RestResource[*MaintenanceWindowConfig]
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*MaintenanceWindowConfig]
+	client       RestClient
+}
+
+func (r *maintenanceWindowConfigRestResource) Create(data *MaintenanceWindowConfig) (*MaintenanceWindowConfig, error) {
+	result, err := r.RestResource.Create(data)
+	if err != nil {
+		return result, err
+	}
+	return r.applyPausedState(data, result)
+}
+
+func (r *maintenanceWindowConfigRestResource) Update(data *MaintenanceWindowConfig) (*MaintenanceWindowConfig, error) {
+	result, err := r.RestResource.Update(data)
+	if err != nil {
+		return result, err
+	}
+	return r.applyPausedState(data, result)
+}
+
+func (r *maintenanceWindowConfigRestResource) applyPausedState(data *MaintenanceWindowConfig, result *MaintenanceWindowConfig) (*MaintenanceWindowConfig, error) {
+	if data.Paused == result.Paused {
+		return result, nil
+	}
+	operation := "resume"
+	if data.Paused {
+		operation = "pause"
+	}
+	response, err := r.client.PutWithoutBody(fmt.Sprintf("%s/%s/%s", r.resourcePath, result.GetIDForResourcePath(), operation))
+	if err != nil {
+		return result, err
+	}
+	return r.unmarshaller.Unmarshal(response)
+}
@@ -0,0 +1,173 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const maintenanceWindowConfigID = "maintenance-window-id"
+
+func makeTestMaintenanceWindowConfig(paused bool) *MaintenanceWindowConfig {
+	return &MaintenanceWindowConfig{
+		ID:    maintenanceWindowConfigID,
+		Name:  "maintenance-window-name",
+		Query: "entity.type:host",
+		Scheduling: MaintenanceScheduling{
+			Start: 1683827571245,
+			Duration: MaintenanceDuration{
+				Amount: 2,
+				Unit:   MaintenanceDurationUnitHours,
+			},
+			Type: MaintenanceSchedulingTypeOneTime,
+		},
+		Paused: paused,
+	}
+}
+
+func TestShouldReturnStringRepresentationOfSupportedMaintenanceDurationUnits(t *testing.T) {
+	require.Equal(t, []string{"MINUTES", "HOURS", "DAYS"}, SupportedMaintenanceDurationUnits.ToStringSlice())
+}
+
+func TestShouldCreateMaintenanceWindowConfigViaPUTWithoutPausingWhenPausedStateMatches(t *testing.T) {
+	config := makeTestMaintenanceWindowConfig(false)
+	response := []byte("response")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Put(config, MaintenanceWindowConfigResourcePath).Times(1).Return(response, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(config, nil)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, restClient)
+
+	result, err := sut.Create(config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldPauseMaintenanceWindowConfigAfterCreateWhenPausedIsRequested(t *testing.T) {
+	config := makeTestMaintenanceWindowConfig(true)
+	notPausedConfig := makeTestMaintenanceWindowConfig(false)
+	createResponse := []byte("create-response")
+	pauseResponse := []byte("pause-response")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().Put(config, MaintenanceWindowConfigResourcePath).Times(1).Return(createResponse, nil),
+		unmarshaller.EXPECT().Unmarshal(createResponse).Times(1).Return(notPausedConfig, nil),
+		restClient.EXPECT().PutWithoutBody(MaintenanceWindowConfigResourcePath+"/"+maintenanceWindowConfigID+"/pause").Times(1).Return(pauseResponse, nil),
+		unmarshaller.EXPECT().Unmarshal(pauseResponse).Times(1).Return(config, nil),
+	)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, restClient)
+
+	result, err := sut.Create(config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldResumeMaintenanceWindowConfigAfterUpdateWhenNotPausedIsRequested(t *testing.T) {
+	config := makeTestMaintenanceWindowConfig(false)
+	pausedConfig := makeTestMaintenanceWindowConfig(true)
+	updateResponse := []byte("update-response")
+	resumeResponse := []byte("resume-response")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().Put(config, MaintenanceWindowConfigResourcePath).Times(1).Return(updateResponse, nil),
+		unmarshaller.EXPECT().Unmarshal(updateResponse).Times(1).Return(pausedConfig, nil),
+		restClient.EXPECT().PutWithoutBody(MaintenanceWindowConfigResourcePath+"/"+maintenanceWindowConfigID+"/resume").Times(1).Return(resumeResponse, nil),
+		unmarshaller.EXPECT().Unmarshal(resumeResponse).Times(1).Return(config, nil),
+	)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, restClient)
+
+	result, err := sut.Update(config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldFailToUpdateMaintenanceWindowConfigWhenUpsertFails(t *testing.T) {
+	config := makeTestMaintenanceWindowConfig(true)
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Put(config, MaintenanceWindowConfigResourcePath).Times(1).Return(nil, expectedError)
+	restClient.EXPECT().PutWithoutBody(gomock.Any()).Times(0)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, restClient)
+
+	_, err := sut.Update(config)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToCreateMaintenanceWindowConfigWhenPauseFails(t *testing.T) {
+	config := makeTestMaintenanceWindowConfig(true)
+	notPausedConfig := makeTestMaintenanceWindowConfig(false)
+	createResponse := []byte("create-response")
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Put(config, MaintenanceWindowConfigResourcePath).Times(1).Return(createResponse, nil)
+	restClient.EXPECT().PutWithoutBody(MaintenanceWindowConfigResourcePath+"/"+maintenanceWindowConfigID+"/pause").Times(1).Return(nil, expectedError)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	unmarshaller.EXPECT().Unmarshal(createResponse).Times(1).Return(notPausedConfig, nil)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, restClient)
+
+	result, err := sut.Create(config)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+	require.Equal(t, notPausedConfig, result)
+}
+
+func TestShouldDelegateReadAndDeleteOfMaintenanceWindowConfigToDefaultRestResource(t *testing.T) {
+	config := makeTestMaintenanceWindowConfig(false)
+	response := []byte("response")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetOne(maintenanceWindowConfigID, MaintenanceWindowConfigResourcePath).Times(1).Return(response, nil)
+	restClient.EXPECT().Delete(maintenanceWindowConfigID, MaintenanceWindowConfigResourcePath).Times(1).Return(nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*MaintenanceWindowConfig](ctrl)
+	unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(config, nil)
+
+	sut := NewMaintenanceWindowConfigRestResource(unmarshaller, restClient)
+
+	result, err := sut.GetOne(maintenanceWindowConfigID)
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+
+	err = sut.DeleteByID(maintenanceWindowConfigID)
+	require.NoError(t, err)
+}
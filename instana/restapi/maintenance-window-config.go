@@ -0,0 +1,74 @@
+package restapi
+
+const (
+	//MaintenanceWindowConfigResourcePath path to the maintenance window config resource of the Instana RESTful API
+	MaintenanceWindowConfigResourcePath = SettingsBasePath + "/v2/maintenance"
+)
+
+// MaintenanceSchedulingType type definition of the scheduling type of maintenance window configurations
+type MaintenanceSchedulingType string
+
+const (
+	//MaintenanceSchedulingTypeOneTime constant value for maintenance windows which are scheduled exactly once
+	MaintenanceSchedulingTypeOneTime = MaintenanceSchedulingType("ONE_TIME")
+	//MaintenanceSchedulingTypeRecurrent constant value for maintenance windows which are scheduled recurrently based on an RRULE
+	MaintenanceSchedulingTypeRecurrent = MaintenanceSchedulingType("RECURRENT")
+)
+
+// MaintenanceDurationUnit type definition of the unit of the duration of a maintenance window
+type MaintenanceDurationUnit string
+
+// MaintenanceDurationUnits type definition of slice of MaintenanceDurationUnit
+type MaintenanceDurationUnits []MaintenanceDurationUnit
+
+// ToStringSlice returns a slice containing the string representations of the given duration units
+func (units MaintenanceDurationUnits) ToStringSlice() []string {
+	result := make([]string, len(units))
+	for i, u := range units {
+		result[i] = string(u)
+	}
+	return result
+}
+
+const (
+	//MaintenanceDurationUnitMinutes constant value for the duration unit MINUTES
+	MaintenanceDurationUnitMinutes = MaintenanceDurationUnit("MINUTES")
+	//MaintenanceDurationUnitHours constant value for the duration unit HOURS
+	MaintenanceDurationUnitHours = MaintenanceDurationUnit("HOURS")
+	//MaintenanceDurationUnitDays constant value for the duration unit DAYS
+	MaintenanceDurationUnitDays = MaintenanceDurationUnit("DAYS")
+)
+
+// SupportedMaintenanceDurationUnits supported MaintenanceDurationUnits of the Instana Web REST API
+var SupportedMaintenanceDurationUnits = MaintenanceDurationUnits{MaintenanceDurationUnitMinutes, MaintenanceDurationUnitHours, MaintenanceDurationUnitDays}
+
+// MaintenanceDuration represents the duration of a single occurrence of a maintenance window
+type MaintenanceDuration struct {
+	Amount int64                   `json:"amount"`
+	Unit   MaintenanceDurationUnit `json:"unit"`
+}
+
+// MaintenanceScheduling represents the scheduling of a maintenance window configuration
+type MaintenanceScheduling struct {
+	Start      int64                     `json:"start"`
+	Duration   MaintenanceDuration       `json:"duration"`
+	Type       MaintenanceSchedulingType `json:"type"`
+	RRule      *string                   `json:"rrule,omitempty"`
+	TimezoneID *string                   `json:"timezoneId,omitempty"`
+}
+
+// MaintenanceWindowConfig represents the REST resource of maintenance window configurations at Instana
+type MaintenanceWindowConfig struct {
+	ID                         string                `json:"id"`
+	Name                       string                `json:"name"`
+	Query                      string                `json:"query"`
+	Scheduling                 MaintenanceScheduling `json:"scheduling"`
+	TagFilterExpressionEnabled bool                  `json:"tagFilterExpressionEnabled"`
+	TagFilterExpression        *TagFilter            `json:"tagFilterExpression"`
+	Paused                     bool                  `json:"paused"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *MaintenanceWindowConfig) GetIDForResourcePath() string {
+	return c.ID
+}
@@ -0,0 +1,42 @@
+package restapi
+
+// NewManualServiceConfigRestResource creates a new REST resource for manual service configurations. The Instana API does not provide an endpoint to read a single manual service configuration. Therefore, single configurations are looked up from the list of all configurations. In addition to the per ID operations, the resource supports replacing all configurations at once via the collection endpoint
+func NewManualServiceConfigRestResource(unmarshaller JSONUnmarshaller[*ManualServiceConfig], client RestClient) ManualServiceConfigRestResource {
+	return &manualServiceConfigRestResource{
+		RestResource: NewCreatePOSTUpdatePUTRestResource(ManualServiceConfigResourcePath, unmarshaller, client),
+		resourcePath: ManualServiceConfigResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type manualServiceConfigRestResource struct {
+	RestResource[*ManualServiceConfig]
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*ManualServiceConfig]
+	client       RestClient
+}
+
+func (r *manualServiceConfigRestResource) GetOne(id string) (*ManualServiceConfig, error) {
+	configs, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range *configs {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *manualServiceConfigRestResource) ReplaceAll(configs ManualServiceConfigs) (*[]*ManualServiceConfig, error) {
+	if configs == nil {
+		configs = ManualServiceConfigs{}
+	}
+	response, err := r.client.PutWithoutID(configs, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.UnmarshalArray(response)
+}
@@ -0,0 +1,127 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	manualServiceConfigID       = "manual-service-id"
+	manualServiceConfigsPayload = `[{"id":"other-id","enabled":true,"tagFilterExpression":null},{"id":"manual-service-id","enabled":true,"unmonitoredServiceName":"service","tagFilterExpression":null}]`
+)
+
+func createManualServiceConfigRestResource(ctrl *gomock.Controller) (*mocks.MockRestClient, ManualServiceConfigRestResource) {
+	restClient := mocks.NewMockRestClient(ctrl)
+	return restClient, NewManualServiceConfigRestResource(NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), restClient)
+}
+
+func TestShouldSuccessfullyGetOneManualServiceConfigFromListOfAllConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createManualServiceConfigRestResource(ctrl)
+	restClient.EXPECT().Get(ManualServiceConfigResourcePath).Times(1).Return([]byte(manualServiceConfigsPayload), nil)
+
+	result, err := sut.GetOne(manualServiceConfigID)
+
+	require.NoError(t, err)
+	serviceName := "service"
+	require.Equal(t, &ManualServiceConfig{ID: manualServiceConfigID, Enabled: true, UnmonitoredServiceName: &serviceName}, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenManualServiceConfigDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createManualServiceConfigRestResource(ctrl)
+	restClient.EXPECT().Get(ManualServiceConfigResourcePath).Times(1).Return([]byte("[]"), nil)
+
+	_, err := sut.GetOne(manualServiceConfigID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneManualServiceConfigWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createManualServiceConfigRestResource(ctrl)
+	restClient.EXPECT().Get(ManualServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := sut.GetOne(manualServiceConfigID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldCreateManualServiceConfigViaPostAndUpdateViaPut(t *testing.T) {
+	config := &ManualServiceConfig{ID: manualServiceConfigID, Enabled: true}
+	response := []byte(`{"id":"manual-service-id","enabled":true,"tagFilterExpression":null}`)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createManualServiceConfigRestResource(ctrl)
+	restClient.EXPECT().Post(config, ManualServiceConfigResourcePath).Times(1).Return(response, nil)
+	restClient.EXPECT().Put(config, ManualServiceConfigResourcePath).Times(1).Return(response, nil)
+
+	created, err := sut.Create(config)
+	require.NoError(t, err)
+	require.Equal(t, config, created)
+
+	updated, err := sut.Update(config)
+	require.NoError(t, err)
+	require.Equal(t, config, updated)
+}
+
+func TestShouldReplaceAllManualServiceConfigs(t *testing.T) {
+	configs := ManualServiceConfigs{{ID: "other-id", Enabled: true}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createManualServiceConfigRestResource(ctrl)
+	restClient.EXPECT().PutWithoutID(configs, ManualServiceConfigResourcePath).Times(1).Return([]byte(manualServiceConfigsPayload), nil)
+
+	result, err := sut.ReplaceAll(configs)
+
+	require.NoError(t, err)
+	require.Len(t, *result, 2)
+	require.Equal(t, "other-id", (*result)[0].ID)
+	require.Equal(t, manualServiceConfigID, (*result)[1].ID)
+}
+
+func TestShouldReplaceAllManualServiceConfigsWithEmptyListWhenNilIsProvided(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createManualServiceConfigRestResource(ctrl)
+	restClient.EXPECT().PutWithoutID(ManualServiceConfigs{}, ManualServiceConfigResourcePath).Times(1).Return([]byte("[]"), nil)
+
+	result, err := sut.ReplaceAll(nil)
+
+	require.NoError(t, err)
+	require.Empty(t, *result)
+}
+
+func TestShouldFailToReplaceAllManualServiceConfigsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createManualServiceConfigRestResource(ctrl)
+	restClient.EXPECT().PutWithoutID(ManualServiceConfigs{}, ManualServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := sut.ReplaceAll(ManualServiceConfigs{})
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
@@ -0,0 +1,29 @@
+package restapi
+
+const (
+	//ManualServiceConfigResourcePath path to the manual service configuration resource of the Instana RESTful API
+	ManualServiceConfigResourcePath = ApplicationMonitoringSettingsBasePath + "/manual-service"
+)
+
+// ManualServiceConfig represents the REST resource of a manual service configuration at Instana. Manual services allow to model dependencies which cannot be detected by Instana automatically
+type ManualServiceConfig struct {
+	ID                     string     `json:"id,omitempty"`
+	Description            *string    `json:"description,omitempty"`
+	Enabled                bool       `json:"enabled"`
+	ExistingServiceID      *string    `json:"existingServiceId,omitempty"`
+	TagFilterExpression    *TagFilter `json:"tagFilterExpression"`
+	UnmonitoredServiceName *string    `json:"unmonitoredServiceName,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ManualServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// ManualServiceConfigs is the list of all manual service configurations which is sent to the collection endpoint of the Instana API to replace all manual service configurations at once
+type ManualServiceConfigs []*ManualServiceConfig
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The list itself does not have an ID and is always sent to the collection endpoint
+func (c ManualServiceConfigs) GetIDForResourcePath() string {
+	return ""
+}
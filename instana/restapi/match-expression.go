@@ -0,0 +1,126 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// MatchExpressionType type for the MatchExpression discriminator type
+type MatchExpressionType string
+
+const (
+	//MatchExpressionTypeBinaryOperator discriminator type for a binary operator MatchExpression combining two match expressions
+	MatchExpressionTypeBinaryOperator MatchExpressionType = "BINARY_OP"
+	//MatchExpressionTypeLeaf discriminator type for a leaf MatchExpression matching a single tag
+	MatchExpressionTypeLeaf MatchExpressionType = "LEAF"
+)
+
+// MatchExpression data structure of the Instana API model of a match expression. A match expression is either a binary operator (BINARY_OP) combining the match expressions left and right or a leaf (LEAF) matching a single tag
+type MatchExpression struct {
+	Type        MatchExpressionType  `json:"type"`
+	Conjunction *LogicalOperatorType `json:"conjunction,omitempty"`
+	Left        *MatchExpression     `json:"left,omitempty"`
+	Right       *MatchExpression     `json:"right,omitempty"`
+	Entity      *TagFilterEntity     `json:"entity,omitempty"`
+	Key         *string              `json:"key,omitempty"`
+	Operator    *ExpressionOperator  `json:"operator,omitempty"`
+	Value       *string              `json:"value,omitempty"`
+}
+
+// NewMatchExpressionFromTagFilter converts the given TagFilter into a MatchExpression. Expressions with more than two elements are converted into nested binary operators. Number and boolean values are converted to strings as match expressions only support string values
+func NewMatchExpressionFromTagFilter(tagFilter *TagFilter) (*MatchExpression, error) {
+	if tagFilter == nil {
+		return nil, errors.New("tag filter is missing")
+	}
+	if tagFilter.Type == TagFilterExpressionType {
+		return newBinaryMatchExpressionFromTagFilterElements(*tagFilter.LogicalOperator, tagFilter.Elements)
+	}
+	if tagFilter.Key != nil {
+		return nil, fmt.Errorf("tag keys are not supported by match expressions; %s", *tagFilter.Name)
+	}
+	return &MatchExpression{
+		Type:     MatchExpressionTypeLeaf,
+		Entity:   tagFilter.Entity,
+		Key:      tagFilter.Name,
+		Operator: tagFilter.Operator,
+		Value:    mapTagFilterValueToString(tagFilter),
+	}, nil
+}
+
+func newBinaryMatchExpressionFromTagFilterElements(operator LogicalOperatorType, elements []*TagFilter) (*MatchExpression, error) {
+	if len(elements) == 0 {
+		return nil, errors.New("tag filter expression without elements is not supported by match expressions")
+	}
+	left, err := NewMatchExpressionFromTagFilter(elements[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(elements) == 1 {
+		return left, nil
+	}
+	right, err := newBinaryMatchExpressionFromTagFilterElements(operator, elements[1:])
+	if err != nil {
+		return nil, err
+	}
+	return &MatchExpression{
+		Type:        MatchExpressionTypeBinaryOperator,
+		Conjunction: &operator,
+		Left:        left,
+		Right:       right,
+	}, nil
+}
+
+func mapTagFilterValueToString(tagFilter *TagFilter) *string {
+	if tagFilter.StringValue != nil {
+		return tagFilter.StringValue
+	}
+	if tagFilter.NumberValue != nil {
+		value := strconv.FormatInt(*tagFilter.NumberValue, 10)
+		return &value
+	}
+	if tagFilter.BooleanValue != nil {
+		value := strconv.FormatBool(*tagFilter.BooleanValue)
+		return &value
+	}
+	return nil
+}
+
+// ToTagFilter converts the MatchExpression into the corresponding TagFilter. Nested binary operators with the same conjunction are flattened into a single expression
+func (e *MatchExpression) ToTagFilter() (*TagFilter, error) {
+	if e.Type == MatchExpressionTypeBinaryOperator {
+		if e.Conjunction == nil || e.Left == nil || e.Right == nil {
+			return nil, errors.New("binary operator match expression requires conjunction, left and right")
+		}
+		elements := make([]*TagFilter, 0)
+		for _, side := range []*MatchExpression{e.Left, e.Right} {
+			element, err := side.ToTagFilter()
+			if err != nil {
+				return nil, err
+			}
+			if element.Type == TagFilterExpressionType && *element.LogicalOperator == *e.Conjunction {
+				elements = append(elements, element.Elements...)
+			} else {
+				elements = append(elements, element)
+			}
+		}
+		if *e.Conjunction == LogicalOr {
+			return NewLogicalOrTagFilter(elements), nil
+		}
+		return NewLogicalAndTagFilter(elements), nil
+	}
+	if e.Type != MatchExpressionTypeLeaf {
+		return nil, fmt.Errorf("unsupported match expression type %s", e.Type)
+	}
+	if e.Entity == nil || e.Key == nil || e.Operator == nil {
+		return nil, errors.New("leaf match expression requires entity, key and operator")
+	}
+	if SupportedUnaryExpressionOperators.IsSupported(*e.Operator) {
+		return NewUnaryTagFilter(*e.Entity, *e.Key, *e.Operator), nil
+	}
+	value := ""
+	if e.Value != nil {
+		value = *e.Value
+	}
+	return NewStringTagFilter(*e.Entity, *e.Key, *e.Operator, value), nil
+}
@@ -0,0 +1,157 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldConvertLeafTagFilterToMatchExpression(t *testing.T) {
+	tagFilter := NewStringTagFilter(TagFilterEntityDestination, "call.http.path", StartsWithOperator, "/health")
+
+	result, err := NewMatchExpressionFromTagFilter(tagFilter)
+
+	require.NoError(t, err)
+	require.Equal(t, makeLeafMatchExpression(TagFilterEntityDestination, "call.http.path", StartsWithOperator, utils.StringPtr("/health")), result)
+}
+
+func TestShouldConvertNumberAndBooleanTagFilterValuesToStringValuesOfMatchExpression(t *testing.T) {
+	numberResult, err := NewMatchExpressionFromTagFilter(NewNumberTagFilter(TagFilterEntityNotApplicable, "call.http.status", EqualsOperator, 200))
+	require.NoError(t, err)
+	require.Equal(t, "200", *numberResult.Value)
+
+	boolResult, err := NewMatchExpressionFromTagFilter(NewBooleanTagFilter(TagFilterEntityNotApplicable, "call.erroneous", EqualsOperator, true))
+	require.NoError(t, err)
+	require.Equal(t, "true", *boolResult.Value)
+}
+
+func TestShouldConvertUnaryTagFilterToMatchExpressionWithoutValue(t *testing.T) {
+	result, err := NewMatchExpressionFromTagFilter(NewUnaryTagFilter(TagFilterEntityNotApplicable, "call.http.path", IsEmptyOperator))
+
+	require.NoError(t, err)
+	require.Equal(t, makeLeafMatchExpression(TagFilterEntityNotApplicable, "call.http.path", IsEmptyOperator, nil), result)
+}
+
+func TestShouldConvertTagFilterExpressionToNestedBinaryMatchExpressions(t *testing.T) {
+	tagFilter := NewLogicalOrTagFilter([]*TagFilter{
+		NewStringTagFilter(TagFilterEntityNotApplicable, "a", EqualsOperator, "1"),
+		NewStringTagFilter(TagFilterEntityNotApplicable, "b", EqualsOperator, "2"),
+		NewLogicalAndTagFilter([]*TagFilter{
+			NewStringTagFilter(TagFilterEntityNotApplicable, "c", EqualsOperator, "3"),
+			NewUnaryTagFilter(TagFilterEntityNotApplicable, "d", NotEmptyOperator),
+		}),
+	})
+
+	result, err := NewMatchExpressionFromTagFilter(tagFilter)
+
+	require.NoError(t, err)
+	require.Equal(t, makeExpectedNestedMatchExpression(), result)
+}
+
+func TestShouldFailToConvertTagFilterToMatchExpressionWhenTagKeyIsProvided(t *testing.T) {
+	_, err := NewMatchExpressionFromTagFilter(NewTagTagFilter(TagFilterEntityNotApplicable, "call.tag", EqualsOperator, "key", "value"))
+
+	require.ErrorContains(t, err, "tag keys are not supported")
+}
+
+func TestShouldFailToConvertTagFilterToMatchExpressionWhenTagFilterIsMissingOrEmpty(t *testing.T) {
+	_, err := NewMatchExpressionFromTagFilter(nil)
+	require.Error(t, err)
+
+	_, err = NewMatchExpressionFromTagFilter(NewLogicalAndTagFilter([]*TagFilter{}))
+	require.Error(t, err)
+}
+
+func TestShouldConvertNestedBinaryMatchExpressionsToFlattenedTagFilter(t *testing.T) {
+	expected := NewLogicalOrTagFilter([]*TagFilter{
+		NewStringTagFilter(TagFilterEntityNotApplicable, "a", EqualsOperator, "1"),
+		NewStringTagFilter(TagFilterEntityNotApplicable, "b", EqualsOperator, "2"),
+		NewLogicalAndTagFilter([]*TagFilter{
+			NewStringTagFilter(TagFilterEntityNotApplicable, "c", EqualsOperator, "3"),
+			NewUnaryTagFilter(TagFilterEntityNotApplicable, "d", NotEmptyOperator),
+		}),
+	})
+
+	result, err := makeExpectedNestedMatchExpression().ToTagFilter()
+
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestShouldFailToConvertInvalidMatchExpressionToTagFilter(t *testing.T) {
+	for name, expression := range map[string]*MatchExpression{
+		"unsupported type":             {Type: MatchExpressionType("invalid")},
+		"binary operator without left": {Type: MatchExpressionTypeBinaryOperator, Conjunction: operatorPtr(LogicalAnd), Right: makeLeafMatchExpression(TagFilterEntityNotApplicable, "a", IsEmptyOperator, nil)},
+		"leaf without key":             {Type: MatchExpressionTypeLeaf, Entity: entityPtr(TagFilterEntityNotApplicable), Operator: expressionOperatorPtr(IsEmptyOperator)},
+		"invalid nested element": {
+			Type:        MatchExpressionTypeBinaryOperator,
+			Conjunction: operatorPtr(LogicalAnd),
+			Left:        makeLeafMatchExpression(TagFilterEntityNotApplicable, "a", IsEmptyOperator, nil),
+			Right:       &MatchExpression{Type: MatchExpressionTypeLeaf},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := expression.ToTagFilter()
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestShouldMarshalMatchExpressionAsExpectedByInstanaAPI(t *testing.T) {
+	expression := &MatchExpression{
+		Type:        MatchExpressionTypeBinaryOperator,
+		Conjunction: operatorPtr(LogicalAnd),
+		Left:        makeLeafMatchExpression(TagFilterEntityNotApplicable, "a", EqualsOperator, utils.StringPtr("1")),
+		Right:       makeLeafMatchExpression(TagFilterEntityNotApplicable, "b", IsEmptyOperator, nil),
+	}
+
+	result, err := json.Marshal(expression)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"BINARY_OP","conjunction":"AND","left":{"type":"LEAF","entity":"NOT_APPLICABLE","key":"a","operator":"EQUALS","value":"1"},"right":{"type":"LEAF","entity":"NOT_APPLICABLE","key":"b","operator":"IS_EMPTY"}}`, string(result))
+}
+
+func makeExpectedNestedMatchExpression() *MatchExpression {
+	return &MatchExpression{
+		Type:        MatchExpressionTypeBinaryOperator,
+		Conjunction: operatorPtr(LogicalOr),
+		Left:        makeLeafMatchExpression(TagFilterEntityNotApplicable, "a", EqualsOperator, utils.StringPtr("1")),
+		Right: &MatchExpression{
+			Type:        MatchExpressionTypeBinaryOperator,
+			Conjunction: operatorPtr(LogicalOr),
+			Left:        makeLeafMatchExpression(TagFilterEntityNotApplicable, "b", EqualsOperator, utils.StringPtr("2")),
+			Right: &MatchExpression{
+				Type:        MatchExpressionTypeBinaryOperator,
+				Conjunction: operatorPtr(LogicalAnd),
+				Left:        makeLeafMatchExpression(TagFilterEntityNotApplicable, "c", EqualsOperator, utils.StringPtr("3")),
+				Right:       makeLeafMatchExpression(TagFilterEntityNotApplicable, "d", NotEmptyOperator, nil),
+			},
+		},
+	}
+}
+
+func makeLeafMatchExpression(entity TagFilterEntity, key string, operator ExpressionOperator, value *string) *MatchExpression {
+	return &MatchExpression{
+		Type:     MatchExpressionTypeLeaf,
+		Entity:   &entity,
+		Key:      &key,
+		Operator: &operator,
+		Value:    value,
+	}
+}
+
+func operatorPtr(operator LogicalOperatorType) *LogicalOperatorType {
+	return &operator
+}
+
+func entityPtr(entity TagFilterEntity) *TagFilterEntity {
+	return &entity
+}
+
+func expressionOperatorPtr(operator ExpressionOperator) *ExpressionOperator {
+	return &operator
+}
@@ -0,0 +1,42 @@
+package restapi
+
+// MobileAppAlertConfigResourcePath path to mobile app alert config resource of Instana RESTful API
+const MobileAppAlertConfigResourcePath = EventSettingsBasePath + "/mobile-app-alert-configs"
+
+// MobileAppAlertConfig is the representation of a mobile app alert configuration in Instana
+type MobileAppAlertConfig struct {
+	ID                    string                    `json:"id"`
+	Name                  string                    `json:"name"`
+	Description           string                    `json:"description"`
+	Severity              int                       `json:"severity"`
+	Triggering            bool                      `json:"triggering"`
+	MobileAppID           string                    `json:"mobileAppId"`
+	TagFilterExpression   *TagFilter                `json:"tagFilterExpression"`
+	AlertChannelIDs       []string                  `json:"alertChannelIds"`
+	Granularity           Granularity               `json:"granularity"`
+	CustomerPayloadFields []CustomPayloadField[any] `json:"customPayloadFields"`
+	Rule                  MobileAppAlertRule        `json:"rule"`
+	Threshold             Threshold                 `json:"threshold"`
+	TimeThreshold         MobileAppTimeThreshold    `json:"timeThreshold"`
+	Enabled               *bool                     `json:"enabled,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *MobileAppAlertConfig) GetIDForResourcePath() string {
+	return r.ID
+}
+
+// GetEnabled implementation of the interface EnableAwareInstanaDataObject
+func (r *MobileAppAlertConfig) GetEnabled() *bool {
+	return r.Enabled
+}
+
+// GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (a *MobileAppAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any] {
+	return a.CustomerPayloadFields
+}
+
+// SetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (a *MobileAppAlertConfig) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
+	a.CustomerPayloadFields = fields
+}
@@ -0,0 +1,11 @@
+package restapi
+
+// MobileAppAlertRule struct representing the API model of a mobile app alert rule
+type MobileAppAlertRule struct {
+	AlertType       string              `json:"alertType"`
+	MetricName      string              `json:"metricName"`
+	Aggregation     *Aggregation        `json:"aggregation"`
+	Operator        *ExpressionOperator `json:"operator"`
+	Value           *string             `json:"value"`
+	CustomEventName *string             `json:"customEventName"`
+}
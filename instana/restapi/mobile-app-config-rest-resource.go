@@ -0,0 +1,103 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NewMobileAppConfigRestResource creates a new REST resource for the mobile app config. Besides the mobile app itself the
+// REST resource manages the geo location, IP masking and geo mapping rules sub resources of the mobile app.
+func NewMobileAppConfigRestResource(unmarshaller JSONUnmarshaller[*MobileAppConfig], client RestClient) RestResource[*MobileAppConfig] {
+	return &mobileAppConfigRestResource{
+		resourcePath:          MobileAppConfigResourcePath,
+		unmarshaller:          unmarshaller,
+		client:                client,
+		privacySettingsClient: newMonitoringPrivacySettingsClient(client),
+	}
+}
+
+type mobileAppConfigRestResource struct {
+	resourcePath          string
+	unmarshaller          JSONUnmarshaller[*MobileAppConfig]
+	client                RestClient
+	privacySettingsClient *monitoringPrivacySettingsClient
+}
+
+func (r *mobileAppConfigRestResource) GetAll() (*[]*MobileAppConfig, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.UnmarshalArray(data)
+}
+
+// GetOne returns the mobile app with the given ID including its privacy settings. The Instana API does not provide an endpoint to
+// get a single mobile app. Therefore, the mobile app is looked up from the list of all mobile apps.
+func (r *mobileAppConfigRestResource) GetOne(id string) (*MobileAppConfig, error) {
+	objects, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range *objects {
+		if o.ID == id {
+			o.MonitoringPrivacySettings, err = r.privacySettingsClient.read(r.entityPath(id), allMonitoringPrivacySettings)
+			if err != nil {
+				return nil, err
+			}
+			return o, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+// Create creates the mobile app and writes its privacy settings afterwards. When the privacy settings cannot be written,
+// the created mobile app is deleted again so that no orphaned mobile app is left behind.
+func (r *mobileAppConfigRestResource) Create(data *MobileAppConfig) (*MobileAppConfig, error) {
+	response, err := r.client.PostByQuery(r.resourcePath, map[string]string{"name": data.Name})
+	if err != nil {
+		return data, err
+	}
+	result, err := r.writePrivacySettings(response, data)
+	if err != nil && len(result.ID) > 0 {
+		if deleteErr := r.DeleteByID(result.ID); deleteErr != nil {
+			return data, errors.Join(err, fmt.Errorf("failed to delete mobile app %s after failed creation; %w", result.ID, deleteErr))
+		}
+		return data, err
+	}
+	return result, err
+}
+
+func (r *mobileAppConfigRestResource) Update(data *MobileAppConfig) (*MobileAppConfig, error) {
+	response, err := r.client.PutByQuery(r.resourcePath, data.GetIDForResourcePath(), map[string]string{"name": data.Name})
+	if err != nil {
+		return data, err
+	}
+	return r.writePrivacySettings(response, data)
+}
+
+func (r *mobileAppConfigRestResource) writePrivacySettings(response []byte, data *MobileAppConfig) (*MobileAppConfig, error) {
+	result, err := r.unmarshaller.Unmarshal(response)
+	if err != nil {
+		return data, err
+	}
+	if err = r.privacySettingsClient.write(r.entityPath(result.ID), data.MonitoringPrivacySettings); err != nil {
+		return result, err
+	}
+	result.MonitoringPrivacySettings, err = r.privacySettingsClient.read(r.entityPath(result.ID), allMonitoringPrivacySettings)
+	if err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+func (r *mobileAppConfigRestResource) entityPath(id string) string {
+	return fmt.Sprintf("%s/%s", r.resourcePath, id)
+}
+
+func (r *mobileAppConfigRestResource) Delete(data *MobileAppConfig) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *mobileAppConfigRestResource) DeleteByID(id string) error {
+	return r.client.Delete(id, r.resourcePath)
+}
@@ -0,0 +1,230 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	mobileAppConfigID              = "mobile-app-id"
+	mobileAppConfigName            = "mobile-app-name"
+	mobileAppConfigPath            = MobileAppConfigResourcePath + "/" + mobileAppConfigID
+	mobileAppConfigGeoMappingRules = "10.0.0.0/8,Europe,Germany"
+	mobileAppConfigsPayload        = `[{"id":"other-id","name":"other"},{"id":"mobile-app-id","name":"mobile-app-name"}]`
+	mobileAppConfigPayload         = `{"id":"mobile-app-id","name":"mobile-app-name"}`
+	mobileAppGeoLocationPayload    = `{"geoDetailRemoval":"REMOVE_CITY"}`
+	mobileAppIPMaskingPayload      = `{"ipMasking":"STRICT"}`
+)
+
+var mobileAppConfigNameQueryParameter = map[string]string{"name": mobileAppConfigName}
+
+func createMobileAppConfigRestResource(ctrl *gomock.Controller) (*mocks.MockRestClient, RestResource[*MobileAppConfig]) {
+	restClient := mocks.NewMockRestClient(ctrl)
+	return restClient, NewMobileAppConfigRestResource(NewDefaultJSONUnmarshaller(&MobileAppConfig{}), restClient)
+}
+
+func expectMobileAppPrivacySettingsRead(restClient *mocks.MockRestClient) {
+	restClient.EXPECT().Get(mobileAppConfigPath+"/geo-location").Times(1).Return([]byte(mobileAppGeoLocationPayload), nil)
+	restClient.EXPECT().Get(mobileAppConfigPath+"/ip-masking").Times(1).Return([]byte(mobileAppIPMaskingPayload), nil)
+	restClient.EXPECT().GetCSV(mobileAppConfigPath+"/geo-mapping-rules").Times(1).Return([]byte(mobileAppConfigGeoMappingRules), nil)
+}
+
+func makeExpectedMobileAppConfig() *MobileAppConfig {
+	geoMappingRules := mobileAppConfigGeoMappingRules
+	return &MobileAppConfig{
+		ID:   mobileAppConfigID,
+		Name: mobileAppConfigName,
+		MonitoringPrivacySettings: MonitoringPrivacySettings{
+			GeoLocation:     &GeoLocationConfiguration{GeoDetailRemoval: GeoDetailRemovalRemoveCity},
+			IPMasking:       &IPMaskingConfiguration{IPMasking: IPMaskingStrict},
+			GeoMappingRules: &geoMappingRules,
+		},
+	}
+}
+
+func TestShouldSuccessfullyGetAllMobileAppConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	restClient.EXPECT().Get(MobileAppConfigResourcePath).Times(1).Return([]byte(mobileAppConfigsPayload), nil)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Len(t, *result, 2)
+	require.Equal(t, "other-id", (*result)[0].ID)
+	require.Equal(t, mobileAppConfigID, (*result)[1].ID)
+}
+
+func TestShouldSuccessfullyGetOneMobileAppConfigIncludingPrivacySettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	restClient.EXPECT().Get(MobileAppConfigResourcePath).Times(1).Return([]byte(mobileAppConfigsPayload), nil)
+	expectMobileAppPrivacySettingsRead(restClient)
+
+	result, err := sut.GetOne(mobileAppConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, makeExpectedMobileAppConfig(), result)
+}
+
+func TestShouldReturnEntityNotFoundWhenMobileAppConfigDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	restClient.EXPECT().Get(MobileAppConfigResourcePath).Times(1).Return([]byte("[]"), nil)
+
+	_, err := sut.GetOne(mobileAppConfigID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneMobileAppConfigWhenPrivacySettingsCannotBeRead(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	restClient.EXPECT().Get(MobileAppConfigResourcePath).Times(1).Return([]byte(mobileAppConfigsPayload), nil)
+	restClient.EXPECT().Get(mobileAppConfigPath+"/geo-location").Times(1).Return([]byte(mobileAppGeoLocationPayload), nil)
+	restClient.EXPECT().Get(mobileAppConfigPath+"/ip-masking").Times(1).Return(nil, expectedError)
+
+	_, err := sut.GetOne(mobileAppConfigID)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldCreateMobileAppConfigAndWriteAllProvidedPrivacySettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := makeExpectedMobileAppConfig()
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().PostByQuery(MobileAppConfigResourcePath, mobileAppConfigNameQueryParameter).Times(1).Return([]byte(mobileAppConfigPayload), nil),
+		restClient.EXPECT().PutWithoutID(config.GeoLocation, mobileAppConfigPath+"/geo-location").Times(1).Return([]byte(mobileAppGeoLocationPayload), nil),
+		restClient.EXPECT().PutWithoutID(config.IPMasking, mobileAppConfigPath+"/ip-masking").Times(1).Return([]byte(mobileAppIPMaskingPayload), nil),
+		restClient.EXPECT().PutCSV(mobileAppConfigGeoMappingRules, mobileAppConfigPath+"/geo-mapping-rules").Times(1).Return([]byte(mobileAppConfigGeoMappingRules), nil),
+	)
+	expectMobileAppPrivacySettingsRead(restClient)
+
+	result, err := sut.Create(config)
+
+	require.NoError(t, err)
+	require.Equal(t, makeExpectedMobileAppConfig(), result)
+}
+
+func TestShouldUpdateMobileAppConfigAndOnlyWriteProvidedPrivacySettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := &MobileAppConfig{ID: mobileAppConfigID, Name: mobileAppConfigName}
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	restClient.EXPECT().PutByQuery(MobileAppConfigResourcePath, mobileAppConfigID, mobileAppConfigNameQueryParameter).Times(1).Return([]byte(mobileAppConfigPayload), nil)
+	expectMobileAppPrivacySettingsRead(restClient)
+
+	result, err := sut.Update(config)
+
+	require.NoError(t, err)
+	require.Equal(t, makeExpectedMobileAppConfig(), result)
+}
+
+func TestShouldFailToUpdateMobileAppConfigWhenPrivacySettingsCannotBeWritten(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := makeExpectedMobileAppConfig()
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	restClient.EXPECT().PutByQuery(MobileAppConfigResourcePath, mobileAppConfigID, mobileAppConfigNameQueryParameter).Times(1).Return([]byte(mobileAppConfigPayload), nil)
+	restClient.EXPECT().PutWithoutID(config.GeoLocation, mobileAppConfigPath+"/geo-location").Times(1).Return(nil, expectedError)
+
+	_, err := sut.Update(config)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldDeleteCreatedMobileAppConfigWhenPrivacySettingsCannotBeWrittenOnCreate(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := makeExpectedMobileAppConfig()
+	config.ID = ""
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().PostByQuery(MobileAppConfigResourcePath, mobileAppConfigNameQueryParameter).Times(1).Return([]byte(mobileAppConfigPayload), nil),
+		restClient.EXPECT().PutWithoutID(config.GeoLocation, mobileAppConfigPath+"/geo-location").Times(1).Return(nil, expectedError),
+		restClient.EXPECT().Delete(mobileAppConfigID, MobileAppConfigResourcePath).Times(1).Return(nil),
+	)
+
+	result, err := sut.Create(config)
+
+	require.Equal(t, expectedError, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldReturnBothErrorsWhenCreatedMobileAppConfigCannotBeDeletedAfterPrivacySettingsCannotBeWritten(t *testing.T) {
+	expectedError := errors.New("test")
+	deleteError := errors.New("delete")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := makeExpectedMobileAppConfig()
+	config.ID = ""
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().PostByQuery(MobileAppConfigResourcePath, mobileAppConfigNameQueryParameter).Times(1).Return([]byte(mobileAppConfigPayload), nil),
+		restClient.EXPECT().PutWithoutID(config.GeoLocation, mobileAppConfigPath+"/geo-location").Times(1).Return(nil, expectedError),
+		restClient.EXPECT().Delete(mobileAppConfigID, MobileAppConfigResourcePath).Times(1).Return(deleteError),
+	)
+
+	_, err := sut.Create(config)
+
+	require.ErrorIs(t, err, expectedError)
+	require.ErrorIs(t, err, deleteError)
+	require.Contains(t, err.Error(), mobileAppConfigID)
+}
+
+func TestShouldFailToCreateMobileAppConfigWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := makeExpectedMobileAppConfig()
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	restClient.EXPECT().PostByQuery(MobileAppConfigResourcePath, mobileAppConfigNameQueryParameter).Times(1).Return(nil, expectedError)
+
+	_, err := sut.Create(config)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldDeleteMobileAppConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createMobileAppConfigRestResource(ctrl)
+	restClient.EXPECT().Delete(mobileAppConfigID, MobileAppConfigResourcePath).Times(1).Return(nil)
+
+	err := sut.Delete(&MobileAppConfig{ID: mobileAppConfigID})
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,20 @@
+package restapi
+
+const (
+	//MobileAppMonitoringResourcePath path to mobile app monitoring
+	MobileAppMonitoringResourcePath = InstanaAPIBasePath + "/mobile-app-monitoring"
+	//MobileAppConfigResourcePath path to mobile app config resource of Instana RESTful API
+	MobileAppConfigResourcePath = MobileAppMonitoringResourcePath + "/config"
+)
+
+// MobileAppConfig data structure of a Mobile App Configuration of the Instana API including its privacy settings
+type MobileAppConfig struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	MonitoringPrivacySettings
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *MobileAppConfig) GetIDForResourcePath() string {
+	return r.ID
+}
@@ -0,0 +1,172 @@
+package restapi
+
+const (
+	geoLocationPathElement     = "/geo-location"
+	ipMaskingPathElement       = "/ip-masking"
+	geoMappingRulesPathElement = "/geo-mapping-rules"
+)
+
+// GeoDetailRemoval type definition of the level of geo location details which are removed from beacons of websites and mobile apps
+type GeoDetailRemoval string
+
+// GeoDetailRemovals type definition of slice of GeoDetailRemoval
+type GeoDetailRemovals []GeoDetailRemoval
+
+// ToStringSlice returns a slice containing the string representations of the given geo detail removals
+func (removals GeoDetailRemovals) ToStringSlice() []string {
+	result := make([]string, len(removals))
+	for i, r := range removals {
+		result[i] = string(r)
+	}
+	return result
+}
+
+const (
+	//GeoDetailRemovalNoRemoval constant value for the GeoDetailRemoval NO_REMOVAL
+	GeoDetailRemovalNoRemoval = GeoDetailRemoval("NO_REMOVAL")
+	//GeoDetailRemovalRemoveCoordinates constant value for the GeoDetailRemoval REMOVE_COORDINATES
+	GeoDetailRemovalRemoveCoordinates = GeoDetailRemoval("REMOVE_COORDINATES")
+	//GeoDetailRemovalRemoveCity constant value for the GeoDetailRemoval REMOVE_CITY
+	GeoDetailRemovalRemoveCity = GeoDetailRemoval("REMOVE_CITY")
+	//GeoDetailRemovalRemoveAll constant value for the GeoDetailRemoval REMOVE_ALL
+	GeoDetailRemovalRemoveAll = GeoDetailRemoval("REMOVE_ALL")
+)
+
+// SupportedGeoDetailRemovals list of all supported GeoDetailRemoval values
+var SupportedGeoDetailRemovals = GeoDetailRemovals{GeoDetailRemovalNoRemoval, GeoDetailRemovalRemoveCoordinates, GeoDetailRemovalRemoveCity, GeoDetailRemovalRemoveAll}
+
+// IPMasking type definition of the masking strategy of IP addresses of beacons of websites and mobile apps
+type IPMasking string
+
+// IPMaskings type definition of slice of IPMasking
+type IPMaskings []IPMasking
+
+// ToStringSlice returns a slice containing the string representations of the given IP maskings
+func (maskings IPMaskings) ToStringSlice() []string {
+	result := make([]string, len(maskings))
+	for i, m := range maskings {
+		result[i] = string(m)
+	}
+	return result
+}
+
+const (
+	//IPMaskingDefault constant value for the IPMasking DEFAULT
+	IPMaskingDefault = IPMasking("DEFAULT")
+	//IPMaskingStrict constant value for the IPMasking STRICT
+	IPMaskingStrict = IPMasking("STRICT")
+	//IPMaskingRemoveAllDetails constant value for the IPMasking REMOVE_ALL_DETAILS
+	IPMaskingRemoveAllDetails = IPMasking("REMOVE_ALL_DETAILS")
+)
+
+// SupportedIPMaskings list of all supported IPMasking values
+var SupportedIPMaskings = IPMaskings{IPMaskingDefault, IPMaskingStrict, IPMaskingRemoveAllDetails}
+
+// GeoLocationConfiguration data structure of the geo location configuration of a website or mobile app
+type GeoLocationConfiguration struct {
+	GeoDetailRemoval GeoDetailRemoval `json:"geoDetailRemoval"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The configuration is a sub resource without an own ID
+func (c *GeoLocationConfiguration) GetIDForResourcePath() string {
+	return ""
+}
+
+// IPMaskingConfiguration data structure of the IP masking configuration of a website or mobile app
+type IPMaskingConfiguration struct {
+	IPMasking IPMasking `json:"ipMasking"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The configuration is a sub resource without an own ID
+func (c *IPMaskingConfiguration) GetIDForResourcePath() string {
+	return ""
+}
+
+// MonitoringPrivacySettings data structure of the privacy related sub resources of websites and mobile apps. The settings
+// are not part of the JSON representation of the website or mobile app but managed via dedicated sub resources.
+// Settings which are nil are not modified when the settings are written.
+type MonitoringPrivacySettings struct {
+	GeoLocation     *GeoLocationConfiguration `json:"-"`
+	IPMasking       *IPMaskingConfiguration   `json:"-"`
+	GeoMappingRules *string                   `json:"-"`
+}
+
+// MonitoringPrivacySettingsSelection defines which privacy related sub resources of a website or mobile app are read
+type MonitoringPrivacySettingsSelection struct {
+	GeoLocation     bool
+	IPMasking       bool
+	GeoMappingRules bool
+}
+
+var allMonitoringPrivacySettings = MonitoringPrivacySettingsSelection{GeoLocation: true, IPMasking: true, GeoMappingRules: true}
+
+func newMonitoringPrivacySettingsClient(client RestClient) *monitoringPrivacySettingsClient {
+	return &monitoringPrivacySettingsClient{
+		client:                  client,
+		geoLocationUnmarshaller: NewDefaultJSONUnmarshaller(&GeoLocationConfiguration{}),
+		ipMaskingUnmarshaller:   NewDefaultJSONUnmarshaller(&IPMaskingConfiguration{}),
+	}
+}
+
+type monitoringPrivacySettingsClient struct {
+	client                  RestClient
+	geoLocationUnmarshaller JSONUnmarshaller[*GeoLocationConfiguration]
+	ipMaskingUnmarshaller   JSONUnmarshaller[*IPMaskingConfiguration]
+}
+
+// read reads the selected privacy settings from the sub resources of the entity with the given resource path. Settings
+// which are not selected are returned as nil
+func (c *monitoringPrivacySettingsClient) read(entityPath string, selection MonitoringPrivacySettingsSelection) (MonitoringPrivacySettings, error) {
+	result := MonitoringPrivacySettings{}
+
+	if selection.GeoLocation {
+		data, err := c.client.Get(entityPath + geoLocationPathElement)
+		if err != nil {
+			return result, err
+		}
+		if result.GeoLocation, err = c.geoLocationUnmarshaller.Unmarshal(data); err != nil {
+			return result, err
+		}
+	}
+
+	if selection.IPMasking {
+		data, err := c.client.Get(entityPath + ipMaskingPathElement)
+		if err != nil {
+			return result, err
+		}
+		if result.IPMasking, err = c.ipMaskingUnmarshaller.Unmarshal(data); err != nil {
+			return result, err
+		}
+	}
+
+	if selection.GeoMappingRules {
+		data, err := c.client.GetCSV(entityPath + geoMappingRulesPathElement)
+		if err != nil {
+			return result, err
+		}
+		geoMappingRules := string(data)
+		result.GeoMappingRules = &geoMappingRules
+	}
+	return result, nil
+}
+
+// write updates the provided privacy settings at the sub resources of the entity with the given resource path. The geo
+// mapping rules are written after the geo location configuration so that the explicitly provided rules take precedence.
+func (c *monitoringPrivacySettingsClient) write(entityPath string, settings MonitoringPrivacySettings) error {
+	if settings.GeoLocation != nil {
+		if _, err := c.client.PutWithoutID(settings.GeoLocation, entityPath+geoLocationPathElement); err != nil {
+			return err
+		}
+	}
+	if settings.IPMasking != nil {
+		if _, err := c.client.PutWithoutID(settings.IPMasking, entityPath+ipMaskingPathElement); err != nil {
+			return err
+		}
+	}
+	if settings.GeoMappingRules != nil {
+		if _, err := c.client.PutCSV(*settings.GeoMappingRules, entityPath+geoMappingRulesPathElement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// NewGroupMembershipRestResource creates a new REST resource for memberships of single users in RBAC groups. Users are
+// added via the users sub resource of the group and removed via the user sub resource. Only the given user is touched,
+// all other members of the group are left as they are.
+func NewGroupMembershipRestResource(client RestClient) RestResource[*GroupMembership] {
+	return &groupMembershipRestResource{
+		client: client,
+	}
+}
+
+type groupMembershipRestResource struct {
+	client RestClient
+}
+
+func (r *groupMembershipRestResource) GetAll() (*[]*GroupMembership, error) {
+	return nil, errors.New("reading all group memberships is not supported as they are scoped to a group")
+}
+
+func (r *groupMembershipRestResource) GetOne(id string) (*GroupMembership, error) {
+	groupID, userID, err := ParseGroupMembershipID(id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := r.client.GetOne(groupID, GroupsResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.lookupMembership(groupID, userID, data)
+}
+
+func (r *groupMembershipRestResource) Create(data *GroupMembership) (*GroupMembership, error) {
+	response, err := r.client.PutWithoutID(GroupUserIDs{data.UserID}, fmt.Sprintf("%s/%s/users", GroupsResourcePath, data.GroupID))
+	if err != nil {
+		return data, err
+	}
+	return r.lookupMembership(data.GroupID, data.UserID, response)
+}
+
+func (r *groupMembershipRestResource) Update(data *GroupMembership) (*GroupMembership, error) {
+	return data, errors.New("update is not supported for group memberships")
+}
+
+func (r *groupMembershipRestResource) Delete(data *GroupMembership) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *groupMembershipRestResource) DeleteByID(id string) error {
+	groupID, userID, err := ParseGroupMembershipID(id)
+	if err != nil {
+		return err
+	}
+	return r.client.Delete(userID, fmt.Sprintf("%s/%s/user", GroupsResourcePath, groupID))
+}
+
+func (r *groupMembershipRestResource) lookupMembership(groupID string, userID string, data []byte) (*GroupMembership, error) {
+	group, err := unmarshalGroup(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range group.Members {
+		if member.UserID == userID {
+			return &GroupMembership{GroupID: groupID, UserID: userID, Email: member.Email}, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+// NewGroupPermissionSetRestResource creates a new REST resource for the permissions of RBAC groups. The permissions are
+// written via the permissions sub resource of the group which only receives the list of permissions. Other properties
+// of the group like members and scope bindings are not sent. Deleting the permission set writes an empty list of
+// permissions to the sub resource.
+func NewGroupPermissionSetRestResource(client RestClient) RestResource[*GroupPermissionSet] {
+	return &groupPermissionSetRestResource{
+		client: client,
+	}
+}
+
+type groupPermissionSetRestResource struct {
+	client RestClient
+}
+
+func (r *groupPermissionSetRestResource) GetAll() (*[]*GroupPermissionSet, error) {
+	data, err := r.client.Get(GroupsResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*Group, 0)
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	result := make([]*GroupPermissionSet, len(groups))
+	for i, g := range groups {
+		result[i] = r.toPermissionSet(g)
+	}
+	return &result, nil
+}
+
+func (r *groupPermissionSetRestResource) GetOne(id string) (*GroupPermissionSet, error) {
+	data, err := r.client.GetOne(id, GroupsResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	group, err := unmarshalGroup(data)
+	if err != nil {
+		return nil, err
+	}
+	return r.toPermissionSet(group), nil
+}
+
+func (r *groupPermissionSetRestResource) Create(data *GroupPermissionSet) (*GroupPermissionSet, error) {
+	return r.Update(data)
+}
+
+func (r *groupPermissionSetRestResource) Update(data *GroupPermissionSet) (*GroupPermissionSet, error) {
+	result, err := r.replacePermissions(data.GroupID, data.Permissions)
+	if err != nil {
+		return data, err
+	}
+	return result, nil
+}
+
+func (r *groupPermissionSetRestResource) Delete(data *GroupPermissionSet) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *groupPermissionSetRestResource) DeleteByID(id string) error {
+	_, err := r.replacePermissions(id, []InstanaPermission{})
+	if err != nil && !errors.Is(err, ErrEntityNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (r *groupPermissionSetRestResource) replacePermissions(groupID string, permissions []InstanaPermission) (*GroupPermissionSet, error) {
+	if permissions == nil {
+		permissions = make([]InstanaPermission, 0)
+	}
+	response, err := r.client.PutWithoutID(GroupPermissions(permissions), fmt.Sprintf("%s/%s/permissions", GroupsResourcePath, groupID))
+	if err != nil {
+		return nil, err
+	}
+	group, err := unmarshalGroup(response)
+	if err != nil {
+		return nil, err
+	}
+	return r.toPermissionSet(group), nil
+}
+
+func (r *groupPermissionSetRestResource) toPermissionSet(group *Group) *GroupPermissionSet {
+	permissions := group.PermissionSet.Permissions
+	if permissions == nil {
+		permissions = make([]InstanaPermission, 0)
+	}
+	return &GroupPermissionSet{GroupID: group.ID, Permissions: permissions}
+}
+
+// NewGroupRestResource creates a new REST resource for RBAC groups. When the members or the permission set of a group
+// are ignored, the current members or permission set of the group are read from the Instana API and sent as is on
+// updates so that members and permissions which are managed outside of the group resource are not removed. As the
+// Instana API does not support conditional updates, members and permissions which are changed by other clients between
+// reading and writing the group are overwritten.
+func NewGroupRestResource(client RestClient) RestResource[*Group] {
+	return &groupRestResource{
+		RestResource: NewCreatePOSTUpdatePUTRestResource(GroupsResourcePath, NewDefaultJSONUnmarshaller(&Group{}), client),
+	}
+}
+
+type groupRestResource struct {
+	RestResource[*Group]
+}
+
+func (r *groupRestResource) Update(data *Group) (*Group, error) {
+	if !data.IgnoreMembers && !data.IgnorePermissionSet {
+		return r.RestResource.Update(data)
+	}
+	current, err := r.GetOne(data.ID)
+	if err != nil {
+		return data, err
+	}
+	update := *data
+	if data.IgnoreMembers {
+		update.Members = current.Members
+		if update.Members == nil {
+			update.Members = make([]APIMember, 0)
+		}
+	}
+	if data.IgnorePermissionSet {
+		update.PermissionSet = current.PermissionSet
+	}
+	return r.RestResource.Update(&update)
+}
+
+func unmarshalGroup(data []byte) (*Group, error) {
+	group := &Group{}
+	if err := json.Unmarshal(data, group); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return group, nil
+}
@@ -0,0 +1,391 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	groupMembershipGroupID     = "group-id"
+	groupMembershipUserID      = "user-id"
+	groupMembershipGroupPath   = GroupsResourcePath + "/group-id"
+	groupPermissionsPath       = groupMembershipGroupPath + "/permissions"
+	groupWithMembersPayload    = `{"id":"group-id","name":"group","members":[{"userId":"other-user","email":"other@example.com"},{"userId":"user-id","email":"user@example.com"}],"permissionSet":{"permissions":["CAN_VIEW_LOGS","CAN_CONFIGURE_AGENTS"]}}`
+	groupWithoutMembersPayload = `{"id":"group-id","name":"group","members":[],"permissionSet":{"permissions":[]}}`
+)
+
+func TestShouldCreateAndParseGroupMembershipID(t *testing.T) {
+	id := NewGroupMembershipID(groupMembershipGroupID, groupMembershipUserID)
+
+	groupID, userID, err := ParseGroupMembershipID(id)
+
+	require.NoError(t, err)
+	require.Equal(t, "group-id/user-id", id)
+	require.Equal(t, groupMembershipGroupID, groupID)
+	require.Equal(t, groupMembershipUserID, userID)
+	require.Equal(t, id, (&GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID}).GetIDForResourcePath())
+}
+
+func TestShouldFailToParseInvalidGroupMembershipID(t *testing.T) {
+	for _, id := range []string{"", "group-id", "group-id/", "/user-id", "a/b/c"} {
+		t.Run(id, func(t *testing.T) {
+			_, _, err := ParseGroupMembershipID(id)
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestShouldSuccessfullyGetOneGroupMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return([]byte(groupWithMembersPayload), nil)
+
+	sut := NewGroupMembershipRestResource(restClient)
+
+	result, err := sut.GetOne(NewGroupMembershipID(groupMembershipGroupID, groupMembershipUserID))
+
+	email := "user@example.com"
+	require.NoError(t, err)
+	require.Equal(t, &GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID, Email: &email}, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenUserIsNotMemberOfGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return([]byte(groupWithoutMembersPayload), nil)
+
+	sut := NewGroupMembershipRestResource(restClient)
+
+	_, err := sut.GetOne(NewGroupMembershipID(groupMembershipGroupID, groupMembershipUserID))
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneGroupMembershipWhenIDIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewGroupMembershipRestResource(mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.GetOne("invalid")
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToGetAllGroupMemberships(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewGroupMembershipRestResource(mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+}
+
+func TestShouldCreateGroupMembershipViaUsersSubResourceOfGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(GroupUserIDs{groupMembershipUserID}, groupMembershipGroupPath+"/users").Times(1).Return([]byte(groupWithMembersPayload), nil)
+
+	sut := NewGroupMembershipRestResource(restClient)
+
+	result, err := sut.Create(&GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID})
+
+	require.NoError(t, err)
+	require.Equal(t, groupMembershipUserID, result.UserID)
+	require.Equal(t, groupMembershipGroupID, result.GroupID)
+}
+
+func TestShouldFailToCreateGroupMembershipWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(gomock.Any(), groupMembershipGroupPath+"/users").Times(1).Return(nil, expectedError)
+
+	sut := NewGroupMembershipRestResource(restClient)
+
+	_, err := sut.Create(&GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID})
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldNotSupportUpdateOfGroupMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewGroupMembershipRestResource(mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.Update(&GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID})
+
+	require.Error(t, err)
+}
+
+func TestShouldDeleteGroupMembershipViaUserSubResourceOfGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Delete(groupMembershipUserID, groupMembershipGroupPath+"/user").Times(2).Return(nil)
+
+	sut := NewGroupMembershipRestResource(restClient)
+
+	require.NoError(t, sut.Delete(&GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID}))
+	require.NoError(t, sut.DeleteByID(NewGroupMembershipID(groupMembershipGroupID, groupMembershipUserID)))
+}
+
+func TestShouldSuccessfullyGetOneGroupPermissionSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return([]byte(groupWithMembersPayload), nil)
+
+	sut := NewGroupPermissionSetRestResource(restClient)
+
+	result, err := sut.GetOne(groupMembershipGroupID)
+
+	require.NoError(t, err)
+	require.Equal(t, &GroupPermissionSet{GroupID: groupMembershipGroupID, Permissions: []InstanaPermission{PermissionCanViewLogs, PermissionCanConfigureAgents}}, result)
+}
+
+func TestShouldSuccessfullyGetAllGroupPermissionSets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(GroupsResourcePath).Times(1).Return([]byte("["+groupWithMembersPayload+"]"), nil)
+
+	sut := NewGroupPermissionSetRestResource(restClient)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*GroupPermissionSet{{GroupID: groupMembershipGroupID, Permissions: []InstanaPermission{PermissionCanViewLogs, PermissionCanConfigureAgents}}}, result)
+}
+
+func TestShouldFailToGetOneGroupPermissionSetWhenResponseIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewGroupPermissionSetRestResource(restClient)
+
+	_, err := sut.GetOne(groupMembershipGroupID)
+
+	require.Error(t, err)
+}
+
+func TestShouldCreateAndUpdateGroupPermissionSetViaPermissionsSubResourceOfGroup(t *testing.T) {
+	for name, operation := range map[string]func(r RestResource[*GroupPermissionSet], p *GroupPermissionSet) (*GroupPermissionSet, error){
+		"create": func(r RestResource[*GroupPermissionSet], p *GroupPermissionSet) (*GroupPermissionSet, error) {
+			return r.Create(p)
+		},
+		"update": func(r RestResource[*GroupPermissionSet], p *GroupPermissionSet) (*GroupPermissionSet, error) {
+			return r.Update(p)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			permissions := []InstanaPermission{PermissionCanViewLogs}
+			restClient := mocks.NewMockRestClient(ctrl)
+			restClient.EXPECT().PutWithoutID(GroupPermissions(permissions), groupPermissionsPath).Times(1).Return([]byte(`{"id":"group-id","name":"group","members":[{"userId":"other-user"}],"permissionSet":{"permissions":["CAN_VIEW_LOGS"]}}`), nil)
+
+			sut := NewGroupPermissionSetRestResource(restClient)
+
+			result, err := operation(sut, &GroupPermissionSet{GroupID: groupMembershipGroupID, Permissions: permissions})
+
+			require.NoError(t, err)
+			require.Equal(t, &GroupPermissionSet{GroupID: groupMembershipGroupID, Permissions: permissions}, result)
+		})
+	}
+}
+
+func TestShouldFailToUpdateGroupPermissionSetWhenPermissionsCannotBeWritten(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(GroupPermissions{}, groupPermissionsPath).Times(1).Return(nil, expectedError)
+
+	sut := NewGroupPermissionSetRestResource(restClient)
+
+	_, err := sut.Update(&GroupPermissionSet{GroupID: groupMembershipGroupID})
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToUpdateGroupPermissionSetWhenResponseIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(GroupPermissions{PermissionCanViewLogs}, groupPermissionsPath).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewGroupPermissionSetRestResource(restClient)
+
+	_, err := sut.Update(&GroupPermissionSet{GroupID: groupMembershipGroupID, Permissions: []InstanaPermission{PermissionCanViewLogs}})
+
+	require.Error(t, err)
+}
+
+func TestShouldWriteEmptyPermissionsWhenGroupPermissionSetIsDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(GroupPermissions{}, groupPermissionsPath).Times(2).Return([]byte(groupWithoutMembersPayload), nil)
+
+	sut := NewGroupPermissionSetRestResource(restClient)
+
+	require.NoError(t, sut.Delete(&GroupPermissionSet{GroupID: groupMembershipGroupID, Permissions: []InstanaPermission{PermissionCanViewLogs}}))
+	require.NoError(t, sut.DeleteByID(groupMembershipGroupID))
+}
+
+func TestShouldIgnoreNotFoundErrorWhenGroupPermissionSetIsDeletedAndGroupDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(GroupPermissions{}, groupPermissionsPath).Times(1).Return(nil, ErrEntityNotFound)
+
+	sut := NewGroupPermissionSetRestResource(restClient)
+
+	require.NoError(t, sut.DeleteByID(groupMembershipGroupID))
+}
+
+func TestShouldUpdateGroupWithGivenMembersWhenMembersAreNotIgnored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	group := &Group{ID: groupMembershipGroupID, Name: "group", Members: []APIMember{{UserID: "new-user"}}}
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Put(group, GroupsResourcePath).Times(1).Return([]byte(groupWithMembersPayload), nil)
+
+	sut := NewGroupRestResource(restClient)
+
+	_, err := sut.Update(group)
+
+	require.NoError(t, err)
+}
+
+func TestShouldUpdateGroupWithCurrentMembersWhenMembersAreIgnored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	group := &Group{ID: groupMembershipGroupID, Name: "group", Members: []APIMember{}, IgnoreMembers: true}
+	otherEmail := "other@example.com"
+	userEmail := "user@example.com"
+	expectedUpdate := &Group{
+		ID:            groupMembershipGroupID,
+		Name:          "group",
+		Members:       []APIMember{{UserID: "other-user", Email: &otherEmail}, {UserID: groupMembershipUserID, Email: &userEmail}},
+		IgnoreMembers: true,
+	}
+	restClient := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return([]byte(groupWithMembersPayload), nil),
+		restClient.EXPECT().Put(expectedUpdate, GroupsResourcePath).Times(1).Return([]byte(groupWithMembersPayload), nil),
+	)
+
+	sut := NewGroupRestResource(restClient)
+
+	_, err := sut.Update(group)
+
+	require.NoError(t, err)
+	require.Empty(t, group.Members)
+}
+
+func TestShouldFailToUpdateGroupWhenMembersAreIgnoredAndCurrentGroupCannotBeRead(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewGroupRestResource(restClient)
+
+	_, err := sut.Update(&Group{ID: groupMembershipGroupID, Name: "group", IgnoreMembers: true})
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldUpdateGroupWithCurrentPermissionSetWhenPermissionSetIsIgnored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	group := &Group{ID: groupMembershipGroupID, Name: "group", Members: []APIMember{{UserID: "new-user"}}, IgnorePermissionSet: true}
+	expectedUpdate := &Group{
+		ID:                  groupMembershipGroupID,
+		Name:                "group",
+		Members:             []APIMember{{UserID: "new-user"}},
+		PermissionSet:       APIPermissionSetWithRoles{Permissions: []InstanaPermission{PermissionCanViewLogs, PermissionCanConfigureAgents}},
+		IgnorePermissionSet: true,
+	}
+	restClient := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return([]byte(groupWithMembersPayload), nil),
+		restClient.EXPECT().Put(expectedUpdate, GroupsResourcePath).Times(1).Return([]byte(groupWithMembersPayload), nil),
+	)
+
+	sut := NewGroupRestResource(restClient)
+
+	_, err := sut.Update(group)
+
+	require.NoError(t, err)
+}
+
+func TestShouldKeepPermissionsOfGroupPermissionSetWhenGroupWithIgnoredPermissionSetIsUpdated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	permissions := []InstanaPermission{PermissionCanViewLogs}
+	groupWithPermissionsPayload := `{"id":"group-id","name":"group","members":[],"permissionSet":{"permissions":["CAN_VIEW_LOGS"]}}`
+	expectedGroupUpdate := &Group{
+		ID:                  groupMembershipGroupID,
+		Name:                "renamed",
+		Members:             []APIMember{},
+		PermissionSet:       APIPermissionSetWithRoles{Permissions: permissions},
+		IgnorePermissionSet: true,
+	}
+	restClient := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().PutWithoutID(GroupPermissions(permissions), groupPermissionsPath).Times(1).Return([]byte(groupWithPermissionsPayload), nil),
+		restClient.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return([]byte(groupWithPermissionsPayload), nil),
+		restClient.EXPECT().Put(expectedGroupUpdate, GroupsResourcePath).Times(1).Return([]byte(`{"id":"group-id","name":"renamed","members":[],"permissionSet":{"permissions":["CAN_VIEW_LOGS"]}}`), nil),
+	)
+
+	permissionSetResource := NewGroupPermissionSetRestResource(restClient)
+	groupResource := NewGroupRestResource(restClient)
+
+	_, err := permissionSetResource.Update(&GroupPermissionSet{GroupID: groupMembershipGroupID, Permissions: permissions})
+	require.NoError(t, err)
+
+	updatedGroup, err := groupResource.Update(&Group{ID: groupMembershipGroupID, Name: "renamed", Members: []APIMember{}, PermissionSet: APIPermissionSetWithRoles{Permissions: []InstanaPermission{}}, IgnorePermissionSet: true})
+
+	require.NoError(t, err)
+	require.Equal(t, permissions, updatedGroup.PermissionSet.Permissions)
+}
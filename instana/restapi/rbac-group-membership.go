@@ -0,0 +1,63 @@
+package restapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+const groupMembershipIDSeparator = "/"
+
+// GroupMembership data structure representing the membership of a single user in an RBAC group. The membership is
+// managed via the user sub resources of the group and is therefore not part of the group payload itself
+type GroupMembership struct {
+	GroupID string
+	UserID  string
+	Email   *string
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the combined ID of the group and the user
+func (m *GroupMembership) GetIDForResourcePath() string {
+	return NewGroupMembershipID(m.GroupID, m.UserID)
+}
+
+// NewGroupMembershipID creates the combined ID of a group membership from the group ID and the user ID
+func NewGroupMembershipID(groupID string, userID string) string {
+	return groupID + groupMembershipIDSeparator + userID
+}
+
+// ParseGroupMembershipID splits the combined ID of a group membership into the group ID and the user ID
+func ParseGroupMembershipID(id string) (string, string, error) {
+	parts := strings.Split(id, groupMembershipIDSeparator)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("invalid group membership ID %s; expected format <groupId>/<userId>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// GroupPermissionSet data structure representing the permissions of an RBAC group which are managed independently of the
+// other properties of the group. The ID of the permission set is the ID of the group
+type GroupPermissionSet struct {
+	GroupID     string
+	Permissions []InstanaPermission
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the ID of the group
+func (p *GroupPermissionSet) GetIDForResourcePath() string {
+	return p.GroupID
+}
+
+// GroupUserIDs the list of user IDs which is sent to the users sub resource of a group
+type GroupUserIDs []string
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The list itself does not have an ID
+func (u GroupUserIDs) GetIDForResourcePath() string {
+	return ""
+}
+
+// GroupPermissions the list of permissions which is sent to the permissions sub resource of a group
+type GroupPermissions []InstanaPermission
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The list itself does not have an ID
+func (p GroupPermissions) GetIDForResourcePath() string {
+	return ""
+}
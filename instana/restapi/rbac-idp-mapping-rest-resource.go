@@ -0,0 +1,47 @@
+package restapi
+
+// NewIdpGroupMappingRestResource creates a new REST resource for identity provider group mappings. The Instana API does not provide an endpoint to read a single mapping. Therefore, single mappings are looked up from the list of all mappings
+func NewIdpGroupMappingRestResource(unmarshaller JSONUnmarshaller[*IdpGroupMapping], client RestClient) RestResource[*IdpGroupMapping] {
+	return &idpGroupMappingRestResource{
+		RestResource: NewCreatePOSTUpdatePUTRestResource(IdpGroupMappingsResourcePath, unmarshaller, client),
+	}
+}
+
+type idpGroupMappingRestResource struct {
+	RestResource[*IdpGroupMapping]
+}
+
+func (r *idpGroupMappingRestResource) GetOne(id string) (*IdpGroupMapping, error) {
+	mappings, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range *mappings {
+		if m.ID == id {
+			return m, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+// NewIdpSettingsRestResource creates a new REST resource for the singleton identity provider settings. The settings are updated via PUT. The settings cannot be deleted; deleting the resource resets the settings to the default which does not restrict users with empty identity provider groups
+func NewIdpSettingsRestResource(client RestClient) RestResource[*IdpSettings] {
+	return &idpSettingsRestResource{
+		RestResource: NewSingletonSettingsRestResource(IdpSettingsResourcePath, NewDefaultJSONUnmarshaller(&IdpSettings{}), client),
+		client:       client,
+	}
+}
+
+type idpSettingsRestResource struct {
+	RestResource[*IdpSettings]
+	client RestClient
+}
+
+func (r *idpSettingsRestResource) Delete(_ *IdpSettings) error {
+	return r.DeleteByID(IdpSettingsID)
+}
+
+func (r *idpSettingsRestResource) DeleteByID(_ string) error {
+	_, err := r.client.PutWithoutID(&IdpSettings{RestrictEmptyIdpGroups: false}, IdpSettingsResourcePath)
+	return err
+}
@@ -0,0 +1,201 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	idpGroupMappingID       = "mapping-id"
+	idpGroupMappingsPayload = `[{"id":"other-id","groupId":"other-group","key":"groups","value":"other"},{"id":"mapping-id","groupId":"group-id","key":"groups","value":"admins"}]`
+	idpGroupMappingPayload  = `{"id":"mapping-id","groupId":"group-id","key":"groups","value":"admins"}`
+)
+
+func createIdpGroupMappingRestResource(ctrl *gomock.Controller) (*mocks.MockRestClient, RestResource[*IdpGroupMapping]) {
+	restClient := mocks.NewMockRestClient(ctrl)
+	return restClient, NewIdpGroupMappingRestResource(NewDefaultJSONUnmarshaller(&IdpGroupMapping{}), restClient)
+}
+
+func makeExpectedIdpGroupMapping() *IdpGroupMapping {
+	return &IdpGroupMapping{ID: idpGroupMappingID, GroupID: "group-id", Key: "groups", Value: "admins"}
+}
+
+func TestShouldSuccessfullyGetOneIdpGroupMappingFromListOfAllMappings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createIdpGroupMappingRestResource(ctrl)
+	restClient.EXPECT().Get(IdpGroupMappingsResourcePath).Times(1).Return([]byte(idpGroupMappingsPayload), nil)
+
+	result, err := sut.GetOne(idpGroupMappingID)
+
+	require.NoError(t, err)
+	require.Equal(t, makeExpectedIdpGroupMapping(), result)
+}
+
+func TestShouldReturnEntityNotFoundWhenIdpGroupMappingDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createIdpGroupMappingRestResource(ctrl)
+	restClient.EXPECT().Get(IdpGroupMappingsResourcePath).Times(1).Return([]byte("[]"), nil)
+
+	_, err := sut.GetOne(idpGroupMappingID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneIdpGroupMappingWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createIdpGroupMappingRestResource(ctrl)
+	restClient.EXPECT().Get(IdpGroupMappingsResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := sut.GetOne(idpGroupMappingID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldCreateIdpGroupMappingViaPost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mapping := makeExpectedIdpGroupMapping()
+	restClient, sut := createIdpGroupMappingRestResource(ctrl)
+	restClient.EXPECT().Post(mapping, IdpGroupMappingsResourcePath).Times(1).Return([]byte(idpGroupMappingPayload), nil)
+
+	result, err := sut.Create(mapping)
+
+	require.NoError(t, err)
+	require.Equal(t, makeExpectedIdpGroupMapping(), result)
+}
+
+func TestShouldUpdateIdpGroupMappingViaPut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mapping := makeExpectedIdpGroupMapping()
+	restClient, sut := createIdpGroupMappingRestResource(ctrl)
+	restClient.EXPECT().Put(mapping, IdpGroupMappingsResourcePath).Times(1).Return([]byte(idpGroupMappingPayload), nil)
+
+	result, err := sut.Update(mapping)
+
+	require.NoError(t, err)
+	require.Equal(t, makeExpectedIdpGroupMapping(), result)
+}
+
+func TestShouldDeleteIdpGroupMapping(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createIdpGroupMappingRestResource(ctrl)
+	restClient.EXPECT().Delete(idpGroupMappingID, IdpGroupMappingsResourcePath).Times(1).Return(nil)
+
+	err := sut.DeleteByID(idpGroupMappingID)
+
+	require.NoError(t, err)
+}
+
+func TestShouldSuccessfullyGetIdpSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(IdpSettingsResourcePath).Times(1).Return([]byte(`{"restrictEmptyIdpGroups":true}`), nil)
+
+	sut := NewIdpSettingsRestResource(restClient)
+
+	result, err := sut.GetOne(IdpSettingsID)
+
+	require.NoError(t, err)
+	require.Equal(t, &IdpSettings{RestrictEmptyIdpGroups: true}, result)
+}
+
+func TestShouldSuccessfullyGetAllIdpSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(IdpSettingsResourcePath).Times(1).Return([]byte(`{"restrictEmptyIdpGroups":false}`), nil)
+
+	sut := NewIdpSettingsRestResource(restClient)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*IdpSettings{{RestrictEmptyIdpGroups: false}}, result)
+}
+
+func TestShouldFailToGetIdpSettingsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(IdpSettingsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewIdpSettingsRestResource(restClient)
+
+	_, err := sut.GetOne(IdpSettingsID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldCreateIdpSettingsViaPutAndReadThemAfterwards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	settings := &IdpSettings{RestrictEmptyIdpGroups: true}
+	restClient := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().PutWithoutID(settings, IdpSettingsResourcePath).Times(1).Return([]byte{}, nil),
+		restClient.EXPECT().Get(IdpSettingsResourcePath).Times(1).Return([]byte(`{"restrictEmptyIdpGroups":true}`), nil),
+	)
+
+	sut := NewIdpSettingsRestResource(restClient)
+
+	result, err := sut.Create(settings)
+
+	require.NoError(t, err)
+	require.Equal(t, &IdpSettings{RestrictEmptyIdpGroups: true}, result)
+}
+
+func TestShouldFailToUpdateIdpSettingsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	settings := &IdpSettings{RestrictEmptyIdpGroups: true}
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(settings, IdpSettingsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewIdpSettingsRestResource(restClient)
+
+	_, err := sut.Update(settings)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldResetIdpSettingsOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(&IdpSettings{RestrictEmptyIdpGroups: false}, IdpSettingsResourcePath).Times(1).Return([]byte{}, nil)
+
+	sut := NewIdpSettingsRestResource(restClient)
+
+	err := sut.Delete(&IdpSettings{RestrictEmptyIdpGroups: true})
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,33 @@
+package restapi
+
+const (
+	//IdpGroupMappingsResourcePath path to the identity provider group mappings of the Instana RESTful API
+	IdpGroupMappingsResourcePath = RBACSettingsBasePath + "/mappings"
+	//IdpSettingsResourcePath path to the identity provider settings of the Instana RESTful API
+	IdpSettingsResourcePath = IdpGroupMappingsResourcePath + "/identityProvider/restrictEmptyIdpGroups"
+	//IdpSettingsID the static ID of the singleton identity provider settings
+	IdpSettingsID = "rbac-idp-settings"
+)
+
+// IdpGroupMapping data structure for the Instana API model for the mapping of a key/value pair of an identity provider (LDAP, OIDC, SAML) to an Instana group
+type IdpGroupMapping struct {
+	ID      string `json:"id"`
+	GroupID string `json:"groupId"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (m *IdpGroupMapping) GetIDForResourcePath() string {
+	return m.ID
+}
+
+// IdpSettings data structure for the Instana API model for the identity provider settings. The settings are a singleton and therefore identified by a static ID
+type IdpSettings struct {
+	RestrictEmptyIdpGroups bool `json:"restrictEmptyIdpGroups"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (s *IdpSettings) GetIDForResourcePath() string {
+	return IdpSettingsID
+}
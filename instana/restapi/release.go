@@ -0,0 +1,36 @@
+package restapi
+
+const (
+	//ReleasesResourcePath path to the releases resource of the Instana RESTful API
+	ReleasesResourcePath = InstanaAPIBasePath + "/releases"
+)
+
+// ReleaseApplicationScope represents an application which is in scope of a release
+type ReleaseApplicationScope struct {
+	Name string `json:"name"`
+}
+
+// ReleaseServiceScopedTo represents the applications a service scope of a release is restricted to
+type ReleaseServiceScopedTo struct {
+	Applications []ReleaseApplicationScope `json:"applications"`
+}
+
+// ReleaseServiceScope represents a service which is in scope of a release
+type ReleaseServiceScope struct {
+	Name     string                  `json:"name"`
+	ScopedTo *ReleaseServiceScopedTo `json:"scopedTo,omitempty"`
+}
+
+// Release represents the REST resource of a release at Instana
+type Release struct {
+	ID           string                    `json:"id"`
+	Name         string                    `json:"name"`
+	Start        int64                     `json:"start"`
+	Applications []ReleaseApplicationScope `json:"applications,omitempty"`
+	Services     []ReleaseServiceScope     `json:"services,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *Release) GetIDForResourcePath() string {
+	return r.ID
+}
@@ -1,6 +1,7 @@
 package restapi
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"errors"
@@ -17,17 +18,34 @@ var ErrEntityNotFound = errors.New("failed to get resource from Instana API. 404
 
 const contentTypeHeader = "Content-Type"
 const encodingApplicationJSON = "application/json; charset=utf-8"
+const encodingTextCSV = "text/csv; charset=utf-8"
 
 // RestClient interface to access REST resources of the Instana API
 type RestClient interface {
 	Get(resourcePath string) ([]byte, error)
 	GetOne(id string, resourcePath string) ([]byte, error)
+	GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error)
+	GetCSV(resourcePath string) ([]byte, error)
 	Post(data InstanaDataObject, resourcePath string) ([]byte, error)
 	PostWithID(data InstanaDataObject, resourcePath string) ([]byte, error)
 	Put(data InstanaDataObject, resourcePath string) ([]byte, error)
+	PutWithoutID(data InstanaDataObject, resourcePath string) ([]byte, error)
+	PutCSV(data string, resourcePath string) ([]byte, error)
+	PutMultipartForm(resourcePath string, formData map[string]string, file MultipartFile) ([]byte, error)
 	Delete(resourceID string, resourceBasePath string) error
+	DeleteWithoutID(resourcePath string) error
+	DeleteByQuery(resourcePath string, queryParams map[string]string) error
 	PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error)
+	PostWithQuery(data InstanaDataObject, resourcePath string, queryParams map[string]string) ([]byte, error)
 	PutByQuery(resourcePath string, is string, queryParams map[string]string) ([]byte, error)
+	PutWithoutBody(resourcePath string) ([]byte, error)
+}
+
+// MultipartFile a file which is sent as part of a multipart form request
+type MultipartFile struct {
+	ParamName string
+	FileName  string
+	Content   []byte
 }
 
 type apiRequest struct {
@@ -88,6 +106,21 @@ func (client *restClientImpl) GetOne(id string, resourcePath string) ([]byte, er
 	return client.executeRequest(resty.MethodGet, url, req)
 }
 
+// GetByQuery request data via HTTP GET for the given resourcePath and query parameters
+func (client *restClientImpl) GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	client.appendQueryParameters(req, queryParams)
+	return client.executeRequest(resty.MethodGet, url, req)
+}
+
+// GetCSV request CSV data via HTTP GET for the given resourcePath
+func (client *restClientImpl) GetCSV(resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetHeader("Accept", "text/csv")
+	return client.executeRequest(resty.MethodGet, url, req)
+}
+
 // Post executes a HTTP PUT request to create or update the given resource
 func (client *restClientImpl) Post(data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
@@ -109,6 +142,27 @@ func (client *restClientImpl) Put(data InstanaDataObject, resourcePath string) (
 	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
 }
 
+// PutWithoutID executes a HTTP PUT request to create or update the given resource using the resource path as is without appending the ID of the InstanaDataObject
+func (client *restClientImpl) PutWithoutID(data InstanaDataObject, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
+	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
+}
+
+// PutCSV executes a HTTP PUT request to the given resource path using the given CSV data as request body
+func (client *restClientImpl) PutCSV(data string, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetHeader("Accept", "text/csv").SetHeader(contentTypeHeader, encodingTextCSV).SetBody(data)
+	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
+}
+
+// PutMultipartForm executes a HTTP PUT request to the given resource path using a multipart form body containing the given form data and file
+func (client *restClientImpl) PutMultipartForm(resourcePath string, formData map[string]string, file MultipartFile) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetFormData(formData).SetFileReader(file.ParamName, file.FileName, bytes.NewReader(file.Content))
+	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
+}
+
 // Delete executes a HTTP DELETE request to delete the resource with the given ID
 func (client *restClientImpl) Delete(resourceID string, resourceBasePath string) error {
 	url := client.buildResourceURL(resourceBasePath, resourceID)
@@ -117,6 +171,23 @@ func (client *restClientImpl) Delete(resourceID string, resourceBasePath string)
 	return err
 }
 
+// DeleteWithoutID executes a HTTP DELETE request using the resource path as is without appending an ID
+func (client *restClientImpl) DeleteWithoutID(resourcePath string) error {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	_, err := client.executeRequestWithThrottling(resty.MethodDelete, url, req)
+	return err
+}
+
+// DeleteByQuery executes a HTTP DELETE request using the resource path as is and the given query parameters
+func (client *restClientImpl) DeleteByQuery(resourcePath string, queryParams map[string]string) error {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	client.appendQueryParameters(req, queryParams)
+	_, err := client.executeRequestWithThrottling(resty.MethodDelete, url, req)
+	return err
+}
+
 // PostByQuery executes a HTTP POST request to create the resource by providing the data a query parameters
 func (client *restClientImpl) PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
@@ -125,6 +196,14 @@ func (client *restClientImpl) PostByQuery(resourcePath string, queryParams map[s
 	return client.executeRequest(resty.MethodPost, url, req)
 }
 
+// PostWithQuery executes a HTTP POST request using the resource path as is, the given data as request body and the given query parameters
+func (client *restClientImpl) PostWithQuery(data InstanaDataObject, resourcePath string, queryParams map[string]string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
+	client.appendQueryParameters(req, queryParams)
+	return client.executeRequestWithThrottling(resty.MethodPost, url, req)
+}
+
 // PutByQuery executes a HTTP PUT request to update the resource with the given ID by providing the data a query parameters
 func (client *restClientImpl) PutByQuery(resourcePath string, id string, queryParams map[string]string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, id)
@@ -133,6 +212,13 @@ func (client *restClientImpl) PutByQuery(resourcePath string, id string, queryPa
 	return client.executeRequest(resty.MethodPut, url, req)
 }
 
+// PutWithoutBody executes a HTTP PUT request without request body using the resource path as is. This is used to trigger operations like enable or disable on existing resources
+func (client *restClientImpl) PutWithoutBody(resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
+}
+
 func (client *restClientImpl) createRequest() *resty.Request {
 	return client.restyClient.R().SetHeader("Accept", "application/json").SetHeader("Authorization", fmt.Sprintf("apiToken %s", client.apiToken))
 }
@@ -2,8 +2,10 @@ package restapi_test
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"testing"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
@@ -88,6 +90,60 @@ func TestShouldReturnNotFoundErrorMessageForGetOneRequestWhenStatusIsNotEntityNo
 	verifyNotFoundResponse(data, err, t)
 }
 
+func TestShouldReturnDataForSuccessfulGetByQueryRequest(t *testing.T) {
+	queryParameters := map[string]string{
+		"a": "b",
+		"c": "d",
+	}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodGet, testPath, queryParameters, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.GetByQuery(testPath, queryParameters)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForGetByQueryRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	queryParameters := map[string]string{
+		"a": "b",
+	}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodGet, testPath, queryParameters, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.GetByQuery(testPath, queryParameters)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulGetCSVRequest(t *testing.T) {
+	httpServer := doSetupAndStartHttpServer(http.MethodGet, testPath, http.StatusOK, func(r *http.Request) error {
+		if r.Header.Get("Accept") != "text/csv" {
+			return fmt.Errorf("Expected Accept header text/csv; current value is '%s'", r.Header.Get("Accept"))
+		}
+		return nil
+	})
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.GetCSV(testPath)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForGetCSVRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodGet, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.GetCSV(testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 func TestShouldReturnDataForSuccessfulPostRequest(t *testing.T) {
 	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPost, testPath)
 	defer httpServer.Close()
@@ -151,6 +207,102 @@ func TestShouldReturnErrorMessageForPutRequestWhenStatusIsNotASuccessStatusAndNo
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldReturnDataForSuccessfulPutWithoutIDRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutWithoutID(testDataObject{id: testID}, testPath)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutWithoutIDRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutWithoutID(testDataObject{id: testID}, testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPutCSVRequest(t *testing.T) {
+	csv := "10.0.0.0/8,Europe,Germany"
+	httpServer := doSetupAndStartHttpServer(http.MethodPut, testPath, http.StatusOK, func(r *http.Request) error {
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "text/csv") {
+			return fmt.Errorf("Expected Content-Type header text/csv; current value is '%s'", r.Header.Get("Content-Type"))
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		if string(body) != csv {
+			return fmt.Errorf("Expected body '%s'; current value is '%s'", csv, string(body))
+		}
+		return nil
+	})
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutCSV(csv, testPath)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutCSVRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutCSV("csv", testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnDataForSuccessfulPutMultipartFormRequest(t *testing.T) {
+	httpServer := doSetupAndStartHttpServer(http.MethodPut, testPath, http.StatusOK, func(r *http.Request) error {
+		if err := r.ParseMultipartForm(1024); err != nil {
+			return err
+		}
+		if r.FormValue("url") != "https://example.com/app.js" {
+			return fmt.Errorf("Expected form value url to be 'https://example.com/app.js'; current value is '%s'", r.FormValue("url"))
+		}
+		file, header, err := r.FormFile("sourceMap")
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		content, err := io.ReadAll(file)
+		if err != nil {
+			return err
+		}
+		if header.Filename != "app.js.map" || string(content) != "content" {
+			return fmt.Errorf("Expected file app.js.map with content 'content'; got file %s with content '%s'", header.Filename, string(content))
+		}
+		return nil
+	})
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutMultipartForm(testPath, map[string]string{"url": "https://example.com/app.js"}, MultipartFile{ParamName: "sourceMap", FileName: "app.js.map", Content: []byte("content")})
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutMultipartFormRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutMultipartForm(testPath, map[string]string{}, MultipartFile{ParamName: "sourceMap", FileName: "app.js.map", Content: []byte("content")})
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 func TestShouldReturnDataForSuccessfulPostByQueryRequestWhenNoQueryParametersAreProvided(t *testing.T) {
 	queryParameters := map[string]string{}
 	shouldReturnDataForSuccessfulPostByQueryRequest(t, queryParameters)
@@ -189,6 +341,44 @@ func TestShouldReturnErrorMessageForPostByQueryRequestWhenStatusIsNotASuccessSta
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldReturnDataForSuccessfulPostWithQueryRequestWhenNoQueryParametersAreProvided(t *testing.T) {
+	queryParameters := map[string]string{}
+	shouldReturnDataForSuccessfulPostWithQueryRequest(t, queryParameters)
+}
+
+func TestShouldReturnDataForSuccessfulPostWithQueryRequestWhenQueryParametersAreProvided(t *testing.T) {
+	queryParameters := map[string]string{
+		"a": "b",
+		"c": "d",
+	}
+	shouldReturnDataForSuccessfulPostWithQueryRequest(t, queryParameters)
+}
+
+func shouldReturnDataForSuccessfulPostWithQueryRequest(t *testing.T, queryParameters map[string]string) {
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodPost, testPath, queryParameters, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PostWithQuery(testDataObject{id: testID}, testPath, queryParameters)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPostWithQueryRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	queryParameters := map[string]string{
+		"a": "b",
+		"c": "d",
+	}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodPost, testPath, queryParameters, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PostWithQuery(testDataObject{id: testID}, testPath, queryParameters)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 func TestShouldReturnDataForSuccessfulPutByQueryRequestWhenNoQueryParametersAreProvided(t *testing.T) {
 	queryParameters := map[string]string{}
 	shouldReturnDataForSuccessfulPutByQueryRequest(t, queryParameters)
@@ -242,6 +432,27 @@ func TestShouldReturnErrorMessageForPutByQueryRequestWhenStatusIsNotASuccessStat
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldReturnDataForSuccessfulPutWithoutBodyRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PutWithoutBody(testPathWithID)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPutWithoutBodyRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodPut, testPathWithID, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PutWithoutBody(testPathWithID)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 type testDataObject struct {
 	id string
 }
@@ -272,6 +483,54 @@ func TestShouldReturnErrorMessageForDeleteRequestWhenStatusIsNotASuccessStatusAn
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldReturnNothingForSuccessfulDeleteWithoutIDRequest(t *testing.T) {
+	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodDelete, testPath)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteWithoutID(testPath)
+
+	require.Nil(t, err)
+}
+
+func TestShouldReturnErrorMessageForDeleteWithoutIDRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServer(http.MethodDelete, testPath, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteWithoutID(testPath)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
+func TestShouldReturnNothingForSuccessfulDeleteByQueryRequest(t *testing.T) {
+	queryParameters := map[string]string{
+		"a": "b",
+	}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodDelete, testPath, queryParameters, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByQuery(testPath, queryParameters)
+
+	require.Nil(t, err)
+}
+
+func TestShouldReturnErrorMessageForDeleteByQueryRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	statusCode := http.StatusBadRequest
+	queryParameters := map[string]string{
+		"a": "b",
+	}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodDelete, testPath, queryParameters, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByQuery(testPath, queryParameters)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 func setupAndStartHttpServerWithOKResponseCode(httpMethod string, fullPath string) testutils.TestHTTPServer {
 	return setupAndStartHttpServer(httpMethod, fullPath, 200)
 }
@@ -0,0 +1,62 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NewServiceConfigOrderRestResource creates a new REST resource for the order of the service configurations. The Instana API does not provide a dedicated read endpoint for the order. Therefore, the order is derived from the list of all service configurations which is returned in the order applied by Instana. The order cannot be deleted; deleting the resource only stops managing it.
+func NewServiceConfigOrderRestResource(client RestClient) RestResource[*ServiceConfigOrder] {
+	return &serviceConfigOrderRestResource{
+		client: client,
+	}
+}
+
+type serviceConfigOrderRestResource struct {
+	client RestClient
+}
+
+func (r *serviceConfigOrderRestResource) GetAll() (*[]*ServiceConfigOrder, error) {
+	order, err := r.GetOne(ServiceConfigOrderID)
+	if err != nil {
+		return nil, err
+	}
+	return &[]*ServiceConfigOrder{order}, nil
+}
+
+func (r *serviceConfigOrderRestResource) GetOne(_ string) (*ServiceConfigOrder, error) {
+	data, err := r.client.Get(ServiceConfigResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	configs := make([]*ServiceConfig, 0)
+	err = json.Unmarshal(data, &configs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	ids := make([]string, len(configs))
+	for i, c := range configs {
+		ids[i] = c.ID
+	}
+	return &ServiceConfigOrder{ID: ServiceConfigOrderID, ServiceConfigIDs: ids}, nil
+}
+
+func (r *serviceConfigOrderRestResource) Create(data *ServiceConfigOrder) (*ServiceConfigOrder, error) {
+	return r.Update(data)
+}
+
+func (r *serviceConfigOrderRestResource) Update(data *ServiceConfigOrder) (*ServiceConfigOrder, error) {
+	_, err := r.client.PutWithoutID(data, ServiceConfigOrderResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceConfigOrder{ID: ServiceConfigOrderID, ServiceConfigIDs: data.ServiceConfigIDs}, nil
+}
+
+func (r *serviceConfigOrderRestResource) Delete(_ *ServiceConfigOrder) error {
+	return nil
+}
+
+func (r *serviceConfigOrderRestResource) DeleteByID(_ string) error {
+	return nil
+}
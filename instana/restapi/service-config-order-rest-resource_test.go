@@ -0,0 +1,136 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const serviceConfigsResponse = `[{"id":"id-2","name":"name-2","label":"{service.name}","enabled":true,"matchSpecification":[]},{"id":"id-1","name":"name-1","label":"{service.name}","enabled":true,"matchSpecification":[]}]`
+
+func TestShouldSuccessfullyGetServiceConfigOrderFromListOfServiceConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigsResponse), nil)
+
+	sut := NewServiceConfigOrderRestResource(restClient)
+
+	result, err := sut.GetOne(ServiceConfigOrderID)
+
+	require.NoError(t, err)
+	require.Equal(t, &ServiceConfigOrder{ID: ServiceConfigOrderID, ServiceConfigIDs: []string{"id-2", "id-1"}}, result)
+}
+
+func TestShouldSuccessfullyGetAllServiceConfigOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte(serviceConfigsResponse), nil)
+
+	sut := NewServiceConfigOrderRestResource(restClient)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*ServiceConfigOrder{{ID: ServiceConfigOrderID, ServiceConfigIDs: []string{"id-2", "id-1"}}}, result)
+}
+
+func TestShouldFailToGetServiceConfigOrderWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewServiceConfigOrderRestResource(restClient)
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetServiceConfigOrderWhenResponseIsNotAValidJsonArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(ServiceConfigResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewServiceConfigOrderRestResource(restClient)
+
+	_, err := sut.GetOne(ServiceConfigOrderID)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to parse json")
+}
+
+func TestShouldPutServiceConfigOrderWhenCreatingAndUpdatingOrder(t *testing.T) {
+	order := &ServiceConfigOrder{ID: ServiceConfigOrderID, ServiceConfigIDs: []string{"id-1", "id-2"}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(order, ServiceConfigOrderResourcePath).Times(2).Return([]byte{}, nil)
+
+	sut := NewServiceConfigOrderRestResource(restClient)
+
+	created, err := sut.Create(order)
+	require.NoError(t, err)
+	require.Equal(t, order, created)
+
+	updated, err := sut.Update(order)
+	require.NoError(t, err)
+	require.Equal(t, order, updated)
+}
+
+func TestShouldFailToUpdateServiceConfigOrderWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+	order := &ServiceConfigOrder{ID: ServiceConfigOrderID, ServiceConfigIDs: []string{"id-1"}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutWithoutID(order, ServiceConfigOrderResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewServiceConfigOrderRestResource(restClient)
+
+	_, err := sut.Update(order)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldNotCallApiWhenDeletingServiceConfigOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+
+	sut := NewServiceConfigOrderRestResource(restClient)
+
+	require.NoError(t, sut.Delete(&ServiceConfigOrder{ID: ServiceConfigOrderID}))
+	require.NoError(t, sut.DeleteByID(ServiceConfigOrderID))
+}
+
+func TestShouldMarshalServiceConfigOrderAsPlainJsonArray(t *testing.T) {
+	data, err := json.Marshal(&ServiceConfigOrder{ID: ServiceConfigOrderID, ServiceConfigIDs: []string{"id-1", "id-2"}})
+	require.NoError(t, err)
+	require.Equal(t, `["id-1","id-2"]`, string(data))
+
+	data, err = json.Marshal(&ServiceConfigOrder{ID: ServiceConfigOrderID})
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(data))
+}
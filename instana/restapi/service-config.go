@@ -0,0 +1,53 @@
+package restapi
+
+import "encoding/json"
+
+const (
+	//ServiceConfigResourcePath path to the service configuration resource of the Instana RESTful API
+	ServiceConfigResourcePath = ApplicationMonitoringSettingsBasePath + "/service"
+	//ServiceConfigOrderResourcePath path to the order of the service configurations of the Instana RESTful API
+	ServiceConfigOrderResourcePath = ServiceConfigResourcePath + "/order"
+	//ServiceConfigOrderID the static ID of the singleton service configuration order
+	ServiceConfigOrderID = "service-config-order"
+)
+
+// ServiceMatchingRule represents a single key/value rule of the match specification of a service configuration
+type ServiceMatchingRule struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ServiceConfig represents the REST resource of a service mapping rule at Instana
+type ServiceConfig struct {
+	ID                 string                `json:"id"`
+	Name               string                `json:"name"`
+	Comment            *string               `json:"comment"`
+	Label              string                `json:"label"`
+	Enabled            bool                  `json:"enabled"`
+	MatchSpecification []ServiceMatchingRule `json:"matchSpecification"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// ServiceConfigOrder represents the ordered list of the IDs of all service configurations at Instana. The order is a singleton and is sent to the API as plain JSON array of IDs
+type ServiceConfigOrder struct {
+	ID               string
+	ServiceConfigIDs []string
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (o *ServiceConfigOrder) GetIDForResourcePath() string {
+	return o.ID
+}
+
+// MarshalJSON renders the order as plain JSON array of service configuration IDs as expected by the Instana API
+func (o *ServiceConfigOrder) MarshalJSON() ([]byte, error) {
+	ids := o.ServiceConfigIDs
+	if ids == nil {
+		ids = []string{}
+	}
+	return json.Marshal(ids)
+}
@@ -0,0 +1,43 @@
+package restapi
+
+const (
+	//SessionSettingsResourcePath path to the session settings of the Instana RESTful API
+	SessionSettingsResourcePath = SettingsBasePath + "/session"
+	//SessionSettingsID the static ID of the singleton session settings
+	SessionSettingsID = "session-settings"
+	//SyntheticCallsSettingsResourcePath path to the synthetic calls settings of the Instana RESTful API
+	SyntheticCallsSettingsResourcePath = SettingsBasePath + "/synthetic-calls"
+	//SyntheticCallsSettingsID the static ID of the singleton synthetic calls settings
+	SyntheticCallsSettingsID = "synthetic-calls-settings"
+)
+
+// SessionSettings data structure for the Instana API model of the tenant wide session settings. The settings are a singleton and therefore identified by a static ID
+type SessionSettings struct {
+	IdleTimeInMillis      *int64 `json:"idleTimeInMillis,omitempty"`
+	TokenLifeTimeInMillis *int64 `json:"tokenLifeTimeInMillis,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (s *SessionSettings) GetIDForResourcePath() string {
+	return SessionSettingsID
+}
+
+// SyntheticCallRule data structure for the Instana API model of a rule which flags matching calls as synthetic calls
+type SyntheticCallRule struct {
+	Name               string           `json:"name"`
+	Description        *string          `json:"description,omitempty"`
+	Enabled            bool             `json:"enabled"`
+	MatchSpecification *MatchExpression `json:"matchSpecification"`
+}
+
+// SyntheticCallsSettings data structure for the Instana API model of the tenant wide synthetic calls settings. The default rules are provided by Instana and are only returned by the API. The settings are a singleton and therefore identified by a static ID
+type SyntheticCallsSettings struct {
+	CustomRules         []SyntheticCallRule `json:"customRules"`
+	DefaultRules        []SyntheticCallRule `json:"defaultRules,omitempty"`
+	DefaultRulesEnabled bool                `json:"defaultRulesEnabled"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (s *SyntheticCallsSettings) GetIDForResourcePath() string {
+	return SyntheticCallsSettingsID
+}
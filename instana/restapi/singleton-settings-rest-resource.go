@@ -0,0 +1,58 @@
+package restapi
+
+import "github.com/gessnerfl/terraform-provider-instana/utils"
+
+// NewSingletonSettingsRestResource creates a new REST resource for tenant wide settings which exist exactly once and are available at the given resource path without any ID. Create and update are implemented as HTTP PUT of the settings followed by a read of the stored settings. Delete resets the settings to the defaults of Instana via HTTP DELETE
+func NewSingletonSettingsRestResource[T InstanaDataObject](resourcePath string, unmarshaller JSONUnmarshaller[T], client RestClient) RestResource[T] {
+	return &singletonSettingsRestResource[T]{
+		resourcePath: resourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type singletonSettingsRestResource[T InstanaDataObject] struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[T]
+	client       RestClient
+}
+
+func (r *singletonSettingsRestResource[T]) GetAll() (*[]T, error) {
+	settings, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	return &[]T{settings}, nil
+}
+
+func (r *singletonSettingsRestResource[T]) GetOne(_ string) (T, error) {
+	return r.read()
+}
+
+func (r *singletonSettingsRestResource[T]) read() (T, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return utils.GetZeroValue[T](), err
+	}
+	return r.unmarshaller.Unmarshal(data)
+}
+
+func (r *singletonSettingsRestResource[T]) Create(data T) (T, error) {
+	return r.Update(data)
+}
+
+func (r *singletonSettingsRestResource[T]) Update(data T) (T, error) {
+	_, err := r.client.PutWithoutID(data, r.resourcePath)
+	if err != nil {
+		return utils.GetZeroValue[T](), err
+	}
+	return r.read()
+}
+
+func (r *singletonSettingsRestResource[T]) Delete(_ T) error {
+	return r.DeleteByID("")
+}
+
+func (r *singletonSettingsRestResource[T]) DeleteByID(_ string) error {
+	return r.client.DeleteWithoutID(r.resourcePath)
+}
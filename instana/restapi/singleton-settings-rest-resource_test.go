@@ -0,0 +1,137 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const sessionSettingsPayload = `{"idleTimeInMillis":3600000,"tokenLifeTimeInMillis":86400000}`
+
+func createSessionSettingsRestResource(ctrl *gomock.Controller) (*mocks.MockRestClient, RestResource[*SessionSettings]) {
+	restClient := mocks.NewMockRestClient(ctrl)
+	return restClient, NewSingletonSettingsRestResource(SessionSettingsResourcePath, NewDefaultJSONUnmarshaller(&SessionSettings{}), restClient)
+}
+
+func makeExpectedSessionSettings() *SessionSettings {
+	return &SessionSettings{IdleTimeInMillis: utils.Int64Ptr(3600000), TokenLifeTimeInMillis: utils.Int64Ptr(86400000)}
+}
+
+func TestShouldSuccessfullyGetOneSingletonSettingsWithoutID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createSessionSettingsRestResource(ctrl)
+	restClient.EXPECT().Get(SessionSettingsResourcePath).Times(1).Return([]byte(sessionSettingsPayload), nil)
+
+	result, err := sut.GetOne(SessionSettingsID)
+
+	require.NoError(t, err)
+	require.Equal(t, makeExpectedSessionSettings(), result)
+	require.Equal(t, SessionSettingsID, result.GetIDForResourcePath())
+}
+
+func TestShouldSuccessfullyGetAllSingletonSettingsAsSingleElement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createSessionSettingsRestResource(ctrl)
+	restClient.EXPECT().Get(SessionSettingsResourcePath).Times(1).Return([]byte(sessionSettingsPayload), nil)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*SessionSettings{makeExpectedSessionSettings()}, result)
+}
+
+func TestShouldFailToGetSingletonSettingsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createSessionSettingsRestResource(ctrl)
+	restClient.EXPECT().Get(SessionSettingsResourcePath).Times(2).Return(nil, expectedError)
+
+	_, err := sut.GetOne(SessionSettingsID)
+	require.Equal(t, expectedError, err)
+
+	_, err = sut.GetAll()
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetSingletonSettingsWhenResponseIsNotValidJson(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createSessionSettingsRestResource(ctrl)
+	restClient.EXPECT().Get(SessionSettingsResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	_, err := sut.GetOne(SessionSettingsID)
+
+	require.Error(t, err)
+}
+
+func TestShouldCreateAndUpdateSingletonSettingsViaPutAndReadThemAfterwards(t *testing.T) {
+	for name, operation := range map[string]func(RestResource[*SessionSettings], *SessionSettings) (*SessionSettings, error){
+		"create": func(r RestResource[*SessionSettings], s *SessionSettings) (*SessionSettings, error) {
+			return r.Create(s)
+		},
+		"update": func(r RestResource[*SessionSettings], s *SessionSettings) (*SessionSettings, error) {
+			return r.Update(s)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			settings := makeExpectedSessionSettings()
+			restClient, sut := createSessionSettingsRestResource(ctrl)
+			gomock.InOrder(
+				restClient.EXPECT().PutWithoutID(settings, SessionSettingsResourcePath).Times(1).Return([]byte{}, nil),
+				restClient.EXPECT().Get(SessionSettingsResourcePath).Times(1).Return([]byte(sessionSettingsPayload), nil),
+			)
+
+			result, err := operation(sut, settings)
+
+			require.NoError(t, err)
+			require.Equal(t, makeExpectedSessionSettings(), result)
+		})
+	}
+}
+
+func TestShouldFailToUpdateSingletonSettingsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	settings := makeExpectedSessionSettings()
+	restClient, sut := createSessionSettingsRestResource(ctrl)
+	restClient.EXPECT().PutWithoutID(settings, SessionSettingsResourcePath).Times(1).Return(nil, expectedError)
+
+	result, err := sut.Update(settings)
+
+	require.Equal(t, expectedError, err)
+	require.Nil(t, result)
+}
+
+func TestShouldResetSingletonSettingsOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createSessionSettingsRestResource(ctrl)
+	restClient.EXPECT().DeleteWithoutID(SessionSettingsResourcePath).Times(2).Return(nil)
+
+	require.NoError(t, sut.Delete(makeExpectedSessionSettings()))
+	require.NoError(t, sut.DeleteByID(SessionSettingsID))
+}
+
+func TestShouldReturnStaticIDOfSyntheticCallsSettings(t *testing.T) {
+	require.Equal(t, SyntheticCallsSettingsID, (&SyntheticCallsSettings{}).GetIDForResourcePath())
+}
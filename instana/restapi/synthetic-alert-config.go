@@ -0,0 +1,46 @@
+package restapi
+
+// GlobalSyntheticAlertConfigsResourcePath path to global synthetic alert config resource of Instana RESTful API
+const GlobalSyntheticAlertConfigsResourcePath = EventSettingsBasePath + "/global-alert-configs/synthetics"
+
+// SyntheticAlertConfig is the representation of a synthetic alert configuration in Instana
+type SyntheticAlertConfig struct {
+	ID                    string                    `json:"id"`
+	Name                  string                    `json:"name"`
+	Description           string                    `json:"description"`
+	Severity              int                       `json:"severity"`
+	SyntheticTestIDs      []string                  `json:"syntheticTestIds"`
+	TagFilterExpression   *TagFilter                `json:"tagFilterExpression"`
+	AlertChannelIDs       []string                  `json:"alertChannelIds"`
+	CustomerPayloadFields []CustomPayloadField[any] `json:"customPayloadFields"`
+	Rule                  SyntheticAlertRule        `json:"rule"`
+	TimeThreshold         SyntheticTimeThreshold    `json:"timeThreshold"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *SyntheticAlertConfig) GetIDForResourcePath() string {
+	return r.ID
+}
+
+// GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (a *SyntheticAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any] {
+	return a.CustomerPayloadFields
+}
+
+// SetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
+func (a *SyntheticAlertConfig) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
+	a.CustomerPayloadFields = fields
+}
+
+// SyntheticAlertRule struct representing the API model of a synthetic alert rule
+type SyntheticAlertRule struct {
+	AlertType   string       `json:"alertType"`
+	MetricName  string       `json:"metricName"`
+	Aggregation *Aggregation `json:"aggregation"`
+}
+
+// SyntheticTimeThreshold struct representing the API model of a synthetic time threshold
+type SyntheticTimeThreshold struct {
+	Type            string `json:"type"`
+	ViolationsCount *int32 `json:"violationsCount"`
+}
@@ -0,0 +1,69 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NewSyntheticCredentialRestResource creates a new REST resource for synthetic credentials. The Instana API only provides the names of the existing credentials. Credential values cannot be queried after creation and credentials cannot be updated.
+func NewSyntheticCredentialRestResource(client RestClient) RestResource[*SyntheticCredential] {
+	return &syntheticCredentialRestResource{
+		resourcePath: SyntheticCredentialResourcePath,
+		client:       client,
+	}
+}
+
+type syntheticCredentialRestResource struct {
+	resourcePath string
+	client       RestClient
+}
+
+func (r *syntheticCredentialRestResource) GetAll() (*[]*SyntheticCredential, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0)
+	err = json.Unmarshal(data, &names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	credentials := make([]*SyntheticCredential, len(names))
+	for i, name := range names {
+		credentials[i] = &SyntheticCredential{Name: name}
+	}
+	return &credentials, nil
+}
+
+func (r *syntheticCredentialRestResource) GetOne(name string) (*SyntheticCredential, error) {
+	credentials, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range *credentials {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *syntheticCredentialRestResource) Create(data *SyntheticCredential) (*SyntheticCredential, error) {
+	_, err := r.client.Post(data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return data, nil
+}
+
+func (r *syntheticCredentialRestResource) Update(_ *SyntheticCredential) (*SyntheticCredential, error) {
+	return nil, fmt.Errorf("update is not supported for %s", r.resourcePath)
+}
+
+func (r *syntheticCredentialRestResource) Delete(data *SyntheticCredential) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *syntheticCredentialRestResource) DeleteByID(name string) error {
+	return r.client.Delete(name, r.resourcePath)
+}
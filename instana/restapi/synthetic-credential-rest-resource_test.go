@@ -0,0 +1,193 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const syntheticCredentialName = "credential_name"
+
+func makeTestSyntheticCredential() *SyntheticCredential {
+	return &SyntheticCredential{
+		Name:  syntheticCredentialName,
+		Value: "credential-value",
+	}
+}
+
+func TestShouldSuccessfullyGetAllSyntheticCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return([]byte("[\"credential_1\", \"credential_2\"]"), nil)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*SyntheticCredential{{Name: "credential_1"}, {Name: "credential_2"}}, result)
+}
+
+func TestShouldFailToGetAllSyntheticCredentialsWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetAllSyntheticCredentialsWhenResponseIsNotAValidJsonArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to parse json")
+}
+
+func TestShouldSuccessfullyGetOneSyntheticCredentialByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return([]byte("[\"other\", \""+syntheticCredentialName+"\"]"), nil)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	result, err := sut.GetOne(syntheticCredentialName)
+
+	require.NoError(t, err)
+	require.Equal(t, &SyntheticCredential{Name: syntheticCredentialName}, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenSyntheticCredentialDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return([]byte("[\"other\"]"), nil)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	_, err := sut.GetOne(syntheticCredentialName)
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneSyntheticCredentialWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(SyntheticCredentialResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	_, err := sut.GetOne(syntheticCredentialName)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyCreateSyntheticCredentialAndReturnTheProvidedObject(t *testing.T) {
+	credential := makeTestSyntheticCredential()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Post(credential, SyntheticCredentialResourcePath).Times(1).Return([]byte("{}"), nil)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	result, err := sut.Create(credential)
+
+	require.NoError(t, err)
+	require.Equal(t, credential, result)
+}
+
+func TestShouldFailToCreateSyntheticCredentialWhenClientReturnsError(t *testing.T) {
+	credential := makeTestSyntheticCredential()
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Post(credential, SyntheticCredentialResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	_, err := sut.Create(credential)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldReturnErrorWhenSyntheticCredentialIsUpdated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	_, err := sut.Update(makeTestSyntheticCredential())
+
+	require.Error(t, err)
+	require.Equal(t, "update is not supported for "+SyntheticCredentialResourcePath, err.Error())
+}
+
+func TestShouldSuccessfullyDeleteSyntheticCredentialByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Delete(syntheticCredentialName, SyntheticCredentialResourcePath).Times(2).Return(nil)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	require.NoError(t, sut.Delete(makeTestSyntheticCredential()))
+	require.NoError(t, sut.DeleteByID(syntheticCredentialName))
+}
+
+func TestShouldFailToDeleteSyntheticCredentialWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Delete(syntheticCredentialName, SyntheticCredentialResourcePath).Times(1).Return(expectedError)
+
+	sut := NewSyntheticCredentialRestResource(restClient)
+
+	err := sut.DeleteByID(syntheticCredentialName)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
@@ -0,0 +1,17 @@
+package restapi
+
+const (
+	//SyntheticCredentialResourcePath path to synthetic monitoring credentials
+	SyntheticCredentialResourcePath = SyntheticSettingsBasePath + "/credentials"
+)
+
+// SyntheticCredential represents the REST resource of a credential which can be used in synthetic API scripts at Instana
+type SyntheticCredential struct {
+	Name  string `json:"credentialName"`
+	Value string `json:"credentialValue"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject for SyntheticCredential
+func (s *SyntheticCredential) GetIDForResourcePath() string {
+	return s.Name
+}
@@ -0,0 +1,144 @@
+package restapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// NewUserInvitationRestResource creates a new REST resource for user invitations. Invitations are read from the users
+// overview of the Instana API which contains both, users and pending invitations. When no pending invitation exists
+// for the email address but a user with the same email address, the invitation is treated as accepted. Accepted
+// invitations are not deleted as this would remove the user from the tenant. Inviting a user who is already a member
+// of the tenant fails.
+func NewUserInvitationRestResource(client RestClient) RestResource[*UserInvitation] {
+	return &userInvitationRestResource{
+		client: client,
+	}
+}
+
+type userInvitationRestResource struct {
+	client RestClient
+}
+
+func (r *userInvitationRestResource) GetAll() (*[]*UserInvitation, error) {
+	overview, err := r.getOverview()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*UserInvitation, 0)
+	emails := make(map[string]bool)
+	for _, invitation := range overview.Invitations {
+		email := strings.ToLower(invitation.Email)
+		if emails[email] {
+			continue
+		}
+		emails[email] = true
+		result = append(result, &UserInvitation{Email: invitation.Email, GroupID: invitation.GroupID})
+	}
+	return &result, nil
+}
+
+func (r *userInvitationRestResource) GetOne(email string) (*UserInvitation, error) {
+	overview, err := r.getOverview()
+	if err != nil {
+		return nil, err
+	}
+	return r.lookupInvitation(email, overview)
+}
+
+func (r *userInvitationRestResource) lookupInvitation(email string, overview *UsersOverview) (*UserInvitation, error) {
+	for _, invitation := range overview.Invitations {
+		if strings.EqualFold(invitation.Email, email) {
+			return &UserInvitation{Email: email, GroupID: invitation.GroupID}, nil
+		}
+	}
+	for _, user := range overview.Users {
+		if strings.EqualFold(user.Email, email) {
+			userID := user.ID
+			return &UserInvitation{Email: email, Accepted: true, UserID: &userID}, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *userInvitationRestResource) getOverview() (*UsersOverview, error) {
+	data, err := r.client.Get(UsersOverviewResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	overview := &UsersOverview{}
+	if err := json.Unmarshal(data, overview); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return overview, nil
+}
+
+func (r *userInvitationRestResource) Create(data *UserInvitation) (*UserInvitation, error) {
+	invitations := Invitations{{Email: data.Email, GroupID: data.GroupID}}
+	response, err := r.client.Post(invitations, InvitationsResourcePath)
+	if err != nil {
+		return data, err
+	}
+	results, err := r.unmarshalInvitationResults(response)
+	if err != nil {
+		return data, err
+	}
+	for _, result := range results {
+		if result.InvitationStatus == InvitationStatusUserAlreadyExists {
+			return data, fmt.Errorf("failed to invite user %s; the user is already a member of the tenant, use instana_rbac_group_membership to manage the group memberships of existing users", result.UserEmail)
+		}
+		if result.InvitationStatus != InvitationStatusSuccess {
+			return data, fmt.Errorf("failed to invite user %s; invitation status = %s", result.UserEmail, result.InvitationStatus)
+		}
+	}
+	return r.GetOne(data.Email)
+}
+
+func (r *userInvitationRestResource) unmarshalInvitationResults(data []byte) ([]InvitationResult, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return []InvitationResult{}, nil
+	}
+	responses := make([]InvitationResponse, 0)
+	if trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &responses); err != nil {
+			return nil, fmt.Errorf("failed to parse json; %s", err)
+		}
+	} else {
+		response := InvitationResponse{}
+		if err := json.Unmarshal(trimmed, &response); err != nil {
+			return nil, fmt.Errorf("failed to parse json; %s", err)
+		}
+		responses = append(responses, response)
+	}
+	results := make([]InvitationResult, 0)
+	for _, response := range responses {
+		results = append(results, response.InvitationResults...)
+	}
+	return results, nil
+}
+
+func (r *userInvitationRestResource) Update(data *UserInvitation) (*UserInvitation, error) {
+	return data, errors.New("update is not supported for user invitations")
+}
+
+func (r *userInvitationRestResource) Delete(data *UserInvitation) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *userInvitationRestResource) DeleteByID(email string) error {
+	invitation, err := r.GetOne(email)
+	if err != nil {
+		if errors.Is(err, ErrEntityNotFound) {
+			return nil
+		}
+		return err
+	}
+	if invitation.Accepted {
+		return nil
+	}
+	return r.client.DeleteByQuery(InvitationsResourcePath, map[string]string{"email": email})
+}
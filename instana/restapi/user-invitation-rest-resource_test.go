@@ -0,0 +1,273 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	invitationEmail                  = "user@example.com"
+	usersOverviewWithInvitation      = `{"users":[{"id":"other-id","email":"other@example.com","fullName":"other"}],"invitations":[{"id":"i1","email":"User@Example.com","groupId":"group-1"},{"id":"i2","email":"next@example.com","groupId":"group-2"}]}`
+	usersOverviewWithAcceptedUser    = `{"users":[{"id":"user-id","email":"user@example.com","fullName":"user"}],"invitations":[]}`
+	usersOverviewWithoutUser         = `{"users":[],"invitations":[]}`
+	invitationResponseSuccess        = `{"invitationResults":[{"userEmail":"user@example.com","invitationStatus":"SUCCESS"}]}`
+	invitationResponseAsArray        = `[{"invitationResults":[{"userEmail":"user@example.com","invitationStatus":"SUCCESS"}]}]`
+	invitationResponseUserExists     = `{"invitationResults":[{"userEmail":"user@example.com","invitationStatus":"FAILURE_USER_ALREADY_EXISTS"}]}`
+	invitationResponseInternalError  = `{"invitationResults":[{"userEmail":"user@example.com","invitationStatus":"INTERNAL_ERROR"}]}`
+	invitationResponseUnparsableJSON = `{"invitationResults":`
+)
+
+func TestShouldGetPendingUserInvitationIgnoringTheCaseOfTheEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte(usersOverviewWithInvitation), nil)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	result, err := sut.GetOne(invitationEmail)
+
+	require.NoError(t, err)
+	require.Equal(t, &UserInvitation{Email: invitationEmail, GroupID: "group-1"}, result)
+}
+
+func TestShouldGetAcceptedUserInvitationWhenUserExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte(usersOverviewWithAcceptedUser), nil)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	result, err := sut.GetOne(invitationEmail)
+
+	userID := "user-id"
+	require.NoError(t, err)
+	require.Equal(t, &UserInvitation{Email: invitationEmail, Accepted: true, UserID: &userID}, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenNeitherInvitationNorUserExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte(usersOverviewWithoutUser), nil)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	_, err := sut.GetOne(invitationEmail)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetUserInvitationWhenOverviewCannotBeRead(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	_, err := sut.GetOne(invitationEmail)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToGetUserInvitationWhenOverviewIsNotValidJSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	_, err := sut.GetOne(invitationEmail)
+
+	require.Error(t, err)
+}
+
+func TestShouldGetAllPendingUserInvitations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte(usersOverviewWithInvitation), nil)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*UserInvitation{
+		{Email: "User@Example.com", GroupID: "group-1"},
+		{Email: "next@example.com", GroupID: "group-2"},
+	}, result)
+}
+
+func TestShouldCreateUserInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedInvitations := Invitations{{Email: invitationEmail, GroupID: "group-1"}}
+	restClient := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().Post(expectedInvitations, InvitationsResourcePath).Times(1).Return([]byte(invitationResponseSuccess), nil),
+		restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte(usersOverviewWithInvitation), nil),
+	)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	result, err := sut.Create(&UserInvitation{Email: invitationEmail, GroupID: "group-1"})
+
+	require.NoError(t, err)
+	require.Equal(t, &UserInvitation{Email: invitationEmail, GroupID: "group-1"}, result)
+}
+
+func TestShouldCreateUserInvitationWhenResponseIsProvidedAsArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().Post(gomock.Any(), InvitationsResourcePath).Times(1).Return([]byte(invitationResponseAsArray), nil),
+		restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte(usersOverviewWithInvitation), nil),
+	)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	result, err := sut.Create(&UserInvitation{Email: invitationEmail, GroupID: "group-1"})
+
+	require.NoError(t, err)
+	require.Equal(t, &UserInvitation{Email: invitationEmail, GroupID: "group-1"}, result)
+}
+
+func TestShouldFailToCreateUserInvitationWhenUserAlreadyExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Post(gomock.Any(), InvitationsResourcePath).Times(1).Return([]byte(invitationResponseUserExists), nil)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	_, err := sut.Create(&UserInvitation{Email: invitationEmail, GroupID: "group-1"})
+
+	require.ErrorContains(t, err, "already a member of the tenant")
+}
+
+func TestShouldFailToCreateUserInvitationWhenInvitationIsNotSuccessful(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Post(gomock.Any(), InvitationsResourcePath).Times(1).Return([]byte(invitationResponseInternalError), nil)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	_, err := sut.Create(&UserInvitation{Email: invitationEmail, GroupID: "group-1"})
+
+	require.ErrorContains(t, err, "INTERNAL_ERROR")
+}
+
+func TestShouldFailToCreateUserInvitationWhenResponseIsNotValidJSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Post(gomock.Any(), InvitationsResourcePath).Times(1).Return([]byte(invitationResponseUnparsableJSON), nil)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	_, err := sut.Create(&UserInvitation{Email: invitationEmail, GroupID: "group-1"})
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToCreateUserInvitationWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Post(gomock.Any(), InvitationsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	_, err := sut.Create(&UserInvitation{Email: invitationEmail, GroupID: "group-1"})
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldNotSupportUpdateOfUserInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewUserInvitationRestResource(mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.Update(&UserInvitation{Email: invitationEmail})
+
+	require.Error(t, err)
+}
+
+func TestShouldRevokePendingUserInvitationOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte(usersOverviewWithInvitation), nil),
+		restClient.EXPECT().DeleteByQuery(InvitationsResourcePath, map[string]string{"email": invitationEmail}).Times(1).Return(nil),
+	)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	err := sut.Delete(&UserInvitation{Email: invitationEmail})
+
+	require.NoError(t, err)
+}
+
+func TestShouldNotDeleteAnythingWhenUserInvitationIsAcceptedOrDoesNotExist(t *testing.T) {
+	for name, overview := range map[string]string{"accepted": usersOverviewWithAcceptedUser, "not existing": usersOverviewWithoutUser} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			restClient := mocks.NewMockRestClient(ctrl)
+			restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return([]byte(overview), nil)
+
+			sut := NewUserInvitationRestResource(restClient)
+
+			err := sut.DeleteByID(invitationEmail)
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestShouldFailToDeleteUserInvitationWhenOverviewCannotBeRead(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(UsersOverviewResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewUserInvitationRestResource(restClient)
+
+	err := sut.DeleteByID(invitationEmail)
+
+	require.Equal(t, expectedError, err)
+}
@@ -0,0 +1,82 @@
+package restapi
+
+const (
+	//InvitationsResourcePath path to the user invitations of the Instana RESTful API
+	InvitationsResourcePath = SettingsBasePath + "/invitations"
+	//UsersResourcePath path to the users of the Instana RESTful API
+	UsersResourcePath = SettingsBasePath + "/users"
+	//UsersOverviewResourcePath path to the overview of users including pending invitations of the Instana RESTful API
+	UsersOverviewResourcePath = UsersResourcePath + "/overview"
+)
+
+// InvitationStatus custom type for the status of an invitation returned by the Instana API when users are invited
+type InvitationStatus string
+
+const (
+	//InvitationStatusSuccess constant value for the invitation status SUCCESS
+	InvitationStatusSuccess = InvitationStatus("SUCCESS")
+	//InvitationStatusUserAlreadyExists constant value for the invitation status FAILURE_USER_ALREADY_EXISTS
+	InvitationStatusUserAlreadyExists = InvitationStatus("FAILURE_USER_ALREADY_EXISTS")
+)
+
+// UserInvitation data structure representing the invitation of a single user into a group. The invitation is identified
+// by the email address of the user as Instana only manages a single pending invitation per email address. Once the user
+// has accepted the invitation, the invitation no longer exists in Instana and the invitation is marked as accepted
+type UserInvitation struct {
+	Email    string
+	GroupID  string
+	Accepted bool
+	UserID   *string
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the email address of the user
+func (i *UserInvitation) GetIDForResourcePath() string {
+	return i.Email
+}
+
+// Invitation data structure of the Instana API model of a pending invitation of a user into a single group
+type Invitation struct {
+	ID      string `json:"id,omitempty"`
+	Email   string `json:"email"`
+	GroupID string `json:"groupId"`
+}
+
+// Invitations the list of invitations which is sent to the Instana API to invite users
+type Invitations []Invitation
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. The list itself does not have an ID
+func (i Invitations) GetIDForResourcePath() string {
+	return ""
+}
+
+// InvitationResult data structure of the Instana API model of the result of the invitation of a single user
+type InvitationResult struct {
+	UserEmail        string           `json:"userEmail"`
+	InvitationStatus InvitationStatus `json:"invitationStatus"`
+}
+
+// InvitationResponse data structure of the Instana API model of the response of an invitation request
+type InvitationResponse struct {
+	InvitationResults []InvitationResult `json:"invitationResults"`
+}
+
+// User data structure of the Instana API model of a user of the tenant
+type User struct {
+	ID           string `json:"id"`
+	Email        string `json:"email"`
+	FullName     string `json:"fullName"`
+	LastLoggedIn *int64 `json:"lastLoggedIn"`
+	GroupCount   *int64 `json:"groupCount"`
+	TfaEnabled   *bool  `json:"tfaEnabled"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (u *User) GetIDForResourcePath() string {
+	return u.ID
+}
+
+// UsersOverview data structure of the Instana API model of all users of the tenant including pending invitations
+type UsersOverview struct {
+	Users       []User       `json:"users"`
+	Invitations []Invitation `json:"invitations"`
+}
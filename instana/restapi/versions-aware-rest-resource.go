@@ -0,0 +1,44 @@
+package restapi
+
+import "fmt"
+
+// NewVersionsAwareRestResource creates a new VersionsAwareRestResource which provides access to the version history of the configurations of the given resource path
+func NewVersionsAwareRestResource(resourcePath string, client RestClient) VersionsAwareRestResource {
+	return &versionsAwareRestResource{
+		resourcePath: resourcePath,
+		unmarshaller: NewDefaultJSONUnmarshaller(&ConfigVersion{}),
+		client:       client,
+	}
+}
+
+type versionsAwareRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*ConfigVersion]
+	client       RestClient
+}
+
+func (r *versionsAwareRestResource) GetVersions(id string) (*[]*ConfigVersion, error) {
+	data, err := r.client.Get(fmt.Sprintf("%s/%s/versions", r.resourcePath, id))
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.UnmarshalArray(data)
+}
+
+func (r *versionsAwareRestResource) RestoreVersion(id string, created int64) error {
+	_, err := r.client.PutWithoutBody(fmt.Sprintf("%s/%s/restore/%d", r.resourcePath, id, created))
+	return err
+}
+
+// NewAlertConfigRestResource creates a new AlertConfigRestResource which delegates all default operations to the provided RestResource and supports enabling/disabling as well as the version history of the configurations of the given resource path
+func NewAlertConfigRestResource[T EnableAwareInstanaDataObject](resourcePath string, delegate RestResource[T], client RestClient) AlertConfigRestResource[T] {
+	return &alertConfigRestResource[T]{
+		EnableAwareRestResource:   NewEnableAwareRestResource(resourcePath, delegate, client),
+		VersionsAwareRestResource: NewVersionsAwareRestResource(resourcePath, client),
+	}
+}
+
+type alertConfigRestResource[T EnableAwareInstanaDataObject] struct {
+	EnableAwareRestResource[T]
+	VersionsAwareRestResource
+}
@@ -0,0 +1,134 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+const configVersionsPayload = `[
+	{"id":"test-id","created":1700000002000,"deleted":false,"enabled":false,"changeSummary":{"author":{"id":"user-id","type":"USER"},"changeType":"DISABLE"}},
+	{"id":"test-id","created":1700000001000,"deleted":false,"enabled":true}
+]`
+
+func TestVersionsAwareRestResource(t *testing.T) {
+	t.Run("should successfully get versions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		restClient := mocks.NewMockRestClient(ctrl)
+		restClient.EXPECT().Get(testResourcePath+"/test-id/versions").Times(1).Return([]byte(configVersionsPayload), nil)
+
+		sut := NewVersionsAwareRestResource(testResourcePath, restClient)
+
+		result, err := sut.GetVersions("test-id")
+
+		require.NoError(t, err)
+		require.Equal(t, &[]*ConfigVersion{
+			{
+				ID:      "test-id",
+				Created: 1700000002000,
+				Enabled: false,
+				ChangeSummary: &ChangeSummary{
+					Author:     ChangeAuthor{ID: "user-id", Type: "USER"},
+					ChangeType: "DISABLE",
+				},
+			},
+			{
+				ID:      "test-id",
+				Created: 1700000001000,
+				Enabled: true,
+			},
+		}, result)
+	})
+	t.Run("should return error when versions cannot be retrieved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedError := errors.New("test")
+		restClient := mocks.NewMockRestClient(ctrl)
+		restClient.EXPECT().Get(testResourcePath+"/test-id/versions").Times(1).Return(nil, expectedError)
+
+		sut := NewVersionsAwareRestResource(testResourcePath, restClient)
+
+		_, err := sut.GetVersions("test-id")
+
+		require.Error(t, err)
+		require.Equal(t, expectedError, err)
+	})
+	t.Run("should return error when versions response is not valid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		restClient := mocks.NewMockRestClient(ctrl)
+		restClient.EXPECT().Get(testResourcePath+"/test-id/versions").Times(1).Return([]byte("invalid"), nil)
+
+		sut := NewVersionsAwareRestResource(testResourcePath, restClient)
+
+		_, err := sut.GetVersions("test-id")
+
+		require.Error(t, err)
+	})
+	t.Run("should successfully restore version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		restClient := mocks.NewMockRestClient(ctrl)
+		restClient.EXPECT().PutWithoutBody(testResourcePath+"/test-id/restore/1700000001000").Times(1).Return([]byte{}, nil)
+
+		sut := NewVersionsAwareRestResource(testResourcePath, restClient)
+
+		err := sut.RestoreVersion("test-id", 1700000001000)
+
+		require.NoError(t, err)
+	})
+	t.Run("should return error when restore of version fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedError := errors.New("test")
+		restClient := mocks.NewMockRestClient(ctrl)
+		restClient.EXPECT().PutWithoutBody(testResourcePath+"/test-id/restore/1700000001000").Times(1).Return(nil, expectedError)
+
+		sut := NewVersionsAwareRestResource(testResourcePath, restClient)
+
+		err := sut.RestoreVersion("test-id", 1700000001000)
+
+		require.Error(t, err)
+		require.Equal(t, expectedError, err)
+	})
+}
+
+func TestAlertConfigRestResource(t *testing.T) {
+	t.Run("should support default operations, enabled state and versions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		object := &ApplicationAlertConfig{ID: "test-id", Enabled: utils.BoolPtr(false)}
+		restClient := mocks.NewMockRestClient(ctrl)
+		delegate := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+		delegate.EXPECT().GetOne("test-id").Times(2).Return(object, nil)
+		restClient.EXPECT().PutWithoutBody(testResourcePath+"/test-id/disable").Times(1).Return([]byte{}, nil)
+		restClient.EXPECT().Get(testResourcePath+"/test-id/versions").Times(1).Return([]byte("[]"), nil)
+
+		sut := NewAlertConfigRestResource[*ApplicationAlertConfig](testResourcePath, delegate, restClient)
+
+		result, err := sut.GetOne("test-id")
+		require.NoError(t, err)
+		require.Equal(t, object, result)
+
+		result, err = sut.UpdateEnabledState(object)
+		require.NoError(t, err)
+		require.Equal(t, object, result)
+
+		versions, err := sut.GetVersions("test-id")
+		require.NoError(t, err)
+		require.Empty(t, *versions)
+	})
+}
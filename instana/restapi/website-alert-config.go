@@ -18,6 +18,7 @@ type WebsiteAlertConfig struct {
 	Rule                  WebsiteAlertRule          `json:"rule"`
 	Threshold             Threshold                 `json:"threshold"`
 	TimeThreshold         WebsiteTimeThreshold      `json:"timeThreshold"`
+	Enabled               *bool                     `json:"enabled,omitempty"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject
@@ -25,6 +26,11 @@ func (r *WebsiteAlertConfig) GetIDForResourcePath() string {
 	return r.ID
 }
 
+// GetEnabled implementation of the interface EnableAwareInstanaDataObject
+func (r *WebsiteAlertConfig) GetEnabled() *bool {
+	return r.Enabled
+}
+
 // GetCustomerPayloadFields implementation of the interface customPayloadFieldsAwareInstanaDataObject
 func (a *WebsiteAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any] {
 	return a.CustomerPayloadFields
@@ -3,11 +3,12 @@ package restapi
 // WebsiteMonitoringConfigResourcePath path to website monitoring config resource of Instana RESTful API
 const WebsiteMonitoringConfigResourcePath = WebsiteMonitoringResourcePath + "/config"
 
-// WebsiteMonitoringConfig data structure of a Website Monitoring Configuration of the Instana API
+// WebsiteMonitoringConfig data structure of a Website Monitoring Configuration of the Instana API including its privacy settings
 type WebsiteMonitoringConfig struct {
 	ID      string `json:"id"`
 	Name    string `json:"name"`
 	AppName string `json:"appName"`
+	MonitoringPrivacySettings
 }
 
 // GetIDForResourcePath implemention of the interface InstanaDataObject
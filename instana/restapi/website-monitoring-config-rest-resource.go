@@ -1,18 +1,27 @@
 package restapi
 
-// NewWebsiteMonitoringConfigRestResource creates a new REST for the website monitoring config
-func NewWebsiteMonitoringConfigRestResource(unmarshaller JSONUnmarshaller[*WebsiteMonitoringConfig], client RestClient) RestResource[*WebsiteMonitoringConfig] {
+import (
+	"errors"
+	"fmt"
+)
+
+// NewWebsiteMonitoringConfigRestResource creates a new REST for the website monitoring config. Besides the website itself the
+// REST resource manages the geo location, IP masking and geo mapping rules sub resources of the website. GetOne does not read
+// the privacy settings. They are read on demand via GetPrivacySettings so that only the required sub resources are requested.
+func NewWebsiteMonitoringConfigRestResource(unmarshaller JSONUnmarshaller[*WebsiteMonitoringConfig], client RestClient) WebsiteMonitoringConfigRestResource {
 	return &websiteMonitoringConfigRestResource{
-		resourcePath: WebsiteMonitoringConfigResourcePath,
-		unmarshaller: unmarshaller,
-		client:       client,
+		resourcePath:          WebsiteMonitoringConfigResourcePath,
+		unmarshaller:          unmarshaller,
+		client:                client,
+		privacySettingsClient: newMonitoringPrivacySettingsClient(client),
 	}
 }
 
 type websiteMonitoringConfigRestResource struct {
-	resourcePath string
-	unmarshaller JSONUnmarshaller[*WebsiteMonitoringConfig]
-	client       RestClient
+	resourcePath          string
+	unmarshaller          JSONUnmarshaller[*WebsiteMonitoringConfig]
+	client                RestClient
+	privacySettingsClient *monitoringPrivacySettingsClient
 }
 
 func (r *websiteMonitoringConfigRestResource) GetAll() (*[]*WebsiteMonitoringConfig, error) {
@@ -35,12 +44,26 @@ func (r *websiteMonitoringConfigRestResource) GetOne(id string) (*WebsiteMonitor
 	return r.validateResponseAndConvertToStruct(data)
 }
 
+// GetPrivacySettings reads the selected privacy settings of the website with the given ID
+func (r *websiteMonitoringConfigRestResource) GetPrivacySettings(id string, selection MonitoringPrivacySettingsSelection) (MonitoringPrivacySettings, error) {
+	return r.privacySettingsClient.read(r.entityPath(id), selection)
+}
+
+// Create creates the website and writes its privacy settings afterwards. When the privacy settings cannot be written,
+// the created website is deleted again so that no orphaned website is left behind.
 func (r *websiteMonitoringConfigRestResource) Create(data *WebsiteMonitoringConfig) (*WebsiteMonitoringConfig, error) {
 	response, err := r.client.PostByQuery(r.resourcePath, map[string]string{"name": data.Name})
 	if err != nil {
 		return data, err
 	}
-	return r.validateResponseAndConvertToStruct(response)
+	result, err := r.writePrivacySettings(response, data)
+	if err != nil && result != nil && len(result.ID) > 0 {
+		if deleteErr := r.DeleteByID(result.ID); deleteErr != nil {
+			return data, errors.Join(err, fmt.Errorf("failed to delete website %s after failed creation; %w", result.ID, deleteErr))
+		}
+		return data, err
+	}
+	return result, err
 }
 
 func (r *websiteMonitoringConfigRestResource) Update(data *WebsiteMonitoringConfig) (*WebsiteMonitoringConfig, error) {
@@ -48,7 +71,33 @@ func (r *websiteMonitoringConfigRestResource) Update(data *WebsiteMonitoringConf
 	if err != nil {
 		return data, err
 	}
-	return r.validateResponseAndConvertToStruct(response)
+	return r.writePrivacySettings(response, data)
+}
+
+// writePrivacySettings writes the provided privacy settings and reads back only the written sub resources
+func (r *websiteMonitoringConfigRestResource) writePrivacySettings(response []byte, data *WebsiteMonitoringConfig) (*WebsiteMonitoringConfig, error) {
+	settings := data.MonitoringPrivacySettings
+	result, err := r.validateResponseAndConvertToStruct(response)
+	if err != nil {
+		return result, err
+	}
+	if err = r.privacySettingsClient.write(r.entityPath(result.ID), settings); err != nil {
+		return result, err
+	}
+	selection := MonitoringPrivacySettingsSelection{
+		GeoLocation:     settings.GeoLocation != nil,
+		IPMasking:       settings.IPMasking != nil,
+		GeoMappingRules: settings.GeoMappingRules != nil,
+	}
+	result.MonitoringPrivacySettings, err = r.privacySettingsClient.read(r.entityPath(result.ID), selection)
+	if err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+func (r *websiteMonitoringConfigRestResource) entityPath(id string) string {
+	return fmt.Sprintf("%s/%s", r.resourcePath, id)
 }
 
 func (r *websiteMonitoringConfigRestResource) validateResponseAndConvertToStruct(data []byte) (*WebsiteMonitoringConfig, error) {
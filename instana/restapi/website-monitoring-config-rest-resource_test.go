@@ -17,6 +17,24 @@ const websiteMonitoringConfigAppName = "website-config-app-name"
 var websiteMonitoringConfigSerialized = []byte("serialized")
 var nameQueryParameter = map[string]string{"name": websiteMonitoringConfigName}
 
+const websiteMonitoringConfigPath = WebsiteMonitoringConfigResourcePath + "/" + websiteMonitoringConfigID
+const websiteMonitoringConfigGeoMappingRules = "10.0.0.0/8,Europe,Germany"
+
+func expectWebsitePrivacySettingsRead(client *mocks.MockRestClient) {
+	client.EXPECT().Get(websiteMonitoringConfigPath+"/geo-location").Times(1).Return([]byte(`{"geoDetailRemoval":"REMOVE_CITY"}`), nil)
+	client.EXPECT().Get(websiteMonitoringConfigPath+"/ip-masking").Times(1).Return([]byte(`{"ipMasking":"STRICT"}`), nil)
+	client.EXPECT().GetCSV(websiteMonitoringConfigPath+"/geo-mapping-rules").Times(1).Return([]byte(websiteMonitoringConfigGeoMappingRules), nil)
+}
+
+func makeExpectedWebsitePrivacySettings() MonitoringPrivacySettings {
+	geoMappingRules := websiteMonitoringConfigGeoMappingRules
+	return MonitoringPrivacySettings{
+		GeoLocation:     &GeoLocationConfiguration{GeoDetailRemoval: GeoDetailRemovalRemoveCity},
+		IPMasking:       &IPMaskingConfiguration{IPMasking: IPMaskingStrict},
+		GeoMappingRules: &geoMappingRules,
+	}
+}
+
 func makeTestWebsiteMonitoringConfig() *WebsiteMonitoringConfig {
 	return &WebsiteMonitoringConfig{
 		ID:      websiteMonitoringConfigID,
@@ -131,6 +149,7 @@ func TestShouldSuccessfullyExecuteGetOperationOfWebsiteMonitoringConfigRestResou
 
 	require.NoError(t, err)
 	require.Equal(t, websiteMonitoringConfig, result)
+	require.Equal(t, MonitoringPrivacySettings{}, result.MonitoringPrivacySettings)
 }
 
 func TestShouldReturnErrorWhenExecutingGetOperationOfWebsiteMonitoringConfigRestResourceAndGetOperationFails(t *testing.T) {
@@ -169,6 +188,73 @@ func TestShouldReturnErrorWhenExecutingGetOperationOfWebsiteMonitoringConfigRest
 	require.Equal(t, expectedError, err)
 }
 
+// ########################################################
+// GET Privacy Settings Operation Tests
+// ########################################################
+
+func TestShouldReadAllSelectedPrivacySettingsOfWebsiteMonitoringConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+
+	expectWebsitePrivacySettingsRead(client)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetPrivacySettings(websiteMonitoringConfigID, MonitoringPrivacySettingsSelection{GeoLocation: true, IPMasking: true, GeoMappingRules: true})
+
+	require.NoError(t, err)
+	require.Equal(t, makeExpectedWebsitePrivacySettings(), result)
+}
+
+func TestShouldOnlyReadSelectedPrivacySettingsOfWebsiteMonitoringConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+
+	client.EXPECT().Get(websiteMonitoringConfigPath+"/ip-masking").Times(1).Return([]byte(`{"ipMasking":"STRICT"}`), nil)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetPrivacySettings(websiteMonitoringConfigID, MonitoringPrivacySettingsSelection{IPMasking: true})
+
+	require.NoError(t, err)
+	require.Equal(t, MonitoringPrivacySettings{IPMasking: &IPMaskingConfiguration{IPMasking: IPMaskingStrict}}, result)
+}
+
+func TestShouldNotCallTheAPIWhenNoPrivacySettingsOfWebsiteMonitoringConfigAreSelected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetPrivacySettings(websiteMonitoringConfigID, MonitoringPrivacySettingsSelection{})
+
+	require.NoError(t, err)
+	require.Equal(t, MonitoringPrivacySettings{}, result)
+}
+
+func TestShouldReturnErrorWhenPrivacySettingsOfWebsiteMonitoringConfigCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+	expectedError := errors.New("error")
+
+	client.EXPECT().Get(websiteMonitoringConfigPath+"/geo-location").Times(1).Return(nil, expectedError)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetPrivacySettings(websiteMonitoringConfigID, MonitoringPrivacySettingsSelection{GeoLocation: true, IPMasking: true})
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+}
+
 // ########################################################
 // Create Operation Tests
 // ########################################################
@@ -191,6 +277,82 @@ func TestShouldSuccessfullyExecuteCreateOperationOfWebsiteMonitoringConfigRestRe
 	require.Equal(t, websiteMonitoringConfig, result)
 }
 
+func TestShouldWriteProvidedPrivacySettingsWhenExecutingCreateOperationOfWebsiteMonitoringConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
+	websiteMonitoringConfig.MonitoringPrivacySettings = makeExpectedWebsitePrivacySettings()
+	createdWebsiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
+
+	gomock.InOrder(
+		client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil),
+		client.EXPECT().PutWithoutID(websiteMonitoringConfig.GeoLocation, websiteMonitoringConfigPath+"/geo-location").Times(1).Return([]byte{}, nil),
+		client.EXPECT().PutWithoutID(websiteMonitoringConfig.IPMasking, websiteMonitoringConfigPath+"/ip-masking").Times(1).Return([]byte{}, nil),
+		client.EXPECT().PutCSV(websiteMonitoringConfigGeoMappingRules, websiteMonitoringConfigPath+"/geo-mapping-rules").Times(1).Return([]byte{}, nil),
+	)
+	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(createdWebsiteMonitoringConfig, nil)
+	expectWebsitePrivacySettingsRead(client)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(websiteMonitoringConfig)
+
+	require.NoError(t, err)
+	require.Equal(t, websiteMonitoringConfig, result)
+}
+
+func TestShouldDeleteCreatedWebsiteWhenPrivacySettingsCannotBeWrittenOnCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+	expectedError := errors.New("error")
+	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
+	websiteMonitoringConfig.MonitoringPrivacySettings = makeExpectedWebsitePrivacySettings()
+
+	gomock.InOrder(
+		client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil),
+		client.EXPECT().PutWithoutID(websiteMonitoringConfig.GeoLocation, websiteMonitoringConfigPath+"/geo-location").Times(1).Return(nil, expectedError),
+		client.EXPECT().Delete(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(nil),
+	)
+	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(makeTestWebsiteMonitoringConfig(), nil)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(websiteMonitoringConfig)
+
+	require.Error(t, err)
+	require.Equal(t, expectedError, err)
+	require.Equal(t, websiteMonitoringConfig, result)
+}
+
+func TestShouldReturnBothErrorsWhenCreatedWebsiteCannotBeDeletedAfterPrivacySettingsCannotBeWritten(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+	expectedError := errors.New("error")
+	deleteError := errors.New("delete error")
+	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
+	websiteMonitoringConfig.MonitoringPrivacySettings = makeExpectedWebsitePrivacySettings()
+
+	client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(makeTestWebsiteMonitoringConfig(), nil)
+	client.EXPECT().PutWithoutID(websiteMonitoringConfig.GeoLocation, websiteMonitoringConfigPath+"/geo-location").Times(1).Return(nil, expectedError)
+	client.EXPECT().Delete(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(deleteError)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Create(websiteMonitoringConfig)
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, expectedError)
+	require.ErrorIs(t, err, deleteError)
+	require.ErrorContains(t, err, "failed to delete website "+websiteMonitoringConfigID)
+}
+
 func TestShouldReturnErrorWhenExecutingCreateOperationOfWebsiteMonitoringConfigRestResourceAndPostOperationFails(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -251,6 +413,27 @@ func TestShouldSuccessfullyExecuteUpdateOperationOfWebsiteMonitoringConfigRestRe
 	require.Equal(t, websiteMonitoringConfig, result)
 }
 
+func TestShouldOnlyReadBackWrittenPrivacySettingsWhenExecutingUpdateOperationOfWebsiteMonitoringConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteMonitoringConfig](ctrl)
+	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
+	websiteMonitoringConfig.IPMasking = &IPMaskingConfiguration{IPMasking: IPMaskingStrict}
+
+	client.EXPECT().PutByQuery(WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(makeTestWebsiteMonitoringConfig(), nil)
+	client.EXPECT().PutWithoutID(websiteMonitoringConfig.IPMasking, websiteMonitoringConfigPath+"/ip-masking").Times(1).Return([]byte{}, nil)
+	client.EXPECT().Get(websiteMonitoringConfigPath+"/ip-masking").Times(1).Return([]byte(`{"ipMasking":"STRICT"}`), nil)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Update(websiteMonitoringConfig)
+
+	require.NoError(t, err)
+	require.Equal(t, websiteMonitoringConfig, result)
+}
+
 func TestShouldReturnErrorWhenExecutingUpdateOperationOfWebsiteMonitoringConfigRestResourceAndPutOperationFails(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,103 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NewSourceMapUploadConfigRestResource creates a new REST resource for the source map files of a source map upload
+// configuration of a website. The Instana API only provides endpoints to upload source map files to and to clear the
+// source map files of an existing source map upload configuration. Therefore, the configuration itself is not created,
+// read or deleted by this resource. Create and update clear the previously uploaded source map files and upload the
+// given source map files again. Delete clears the uploaded source map files.
+func NewSourceMapUploadConfigRestResource(unmarshaller JSONUnmarshaller[*SourceMapUploadConfig], client RestClient) RestResource[*SourceMapUploadConfig] {
+	return &sourceMapUploadConfigRestResource{
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type sourceMapUploadConfigRestResource struct {
+	unmarshaller JSONUnmarshaller[*SourceMapUploadConfig]
+	client       RestClient
+}
+
+func (r *sourceMapUploadConfigRestResource) GetAll() (*[]*SourceMapUploadConfig, error) {
+	return nil, errors.New("reading all source map upload configs is not supported as they are scoped to a website")
+}
+
+// GetOne returns the source map upload configuration with the IDs of the website and the configuration only as the
+// Instana API does not provide an endpoint to read a source map upload configuration
+func (r *sourceMapUploadConfigRestResource) GetOne(id string) (*SourceMapUploadConfig, error) {
+	websiteID, configID, err := ParseSourceMapUploadConfigID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &SourceMapUploadConfig{ID: configID, WebsiteID: websiteID}, nil
+}
+
+// Create clears the source map files of the source map upload configuration and uploads the given source map files
+func (r *sourceMapUploadConfigRestResource) Create(data *SourceMapUploadConfig) (*SourceMapUploadConfig, error) {
+	err := r.clear(data.WebsiteID, data.ID)
+	if err != nil {
+		return data, err
+	}
+	result := data
+	for _, upload := range data.Uploads {
+		result, err = r.upload(data, upload)
+		if err != nil {
+			return data, err
+		}
+	}
+	return result, nil
+}
+
+func (r *sourceMapUploadConfigRestResource) upload(config *SourceMapUploadConfig, upload SourceMapUpload) (*SourceMapUploadConfig, error) {
+	formData := map[string]string{"url": upload.URL}
+	if upload.FileFormat != nil {
+		formData["fileFormat"] = *upload.FileFormat
+	}
+	file := MultipartFile{ParamName: "sourceMap", FileName: upload.FileName, Content: upload.Content}
+	response, err := r.client.PutMultipartForm(r.instancePath(config.WebsiteID, config.ID)+"/form", formData, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload source map file %s; %w", upload.FileName, err)
+	}
+	result, err := r.unmarshaller.Unmarshal(response)
+	if err != nil {
+		return nil, err
+	}
+	result.WebsiteID = config.WebsiteID
+	return result, nil
+}
+
+// Update replaces the uploaded source map files of the source map upload configuration with the given source map files
+func (r *sourceMapUploadConfigRestResource) Update(data *SourceMapUploadConfig) (*SourceMapUploadConfig, error) {
+	return r.Create(data)
+}
+
+func (r *sourceMapUploadConfigRestResource) Delete(data *SourceMapUploadConfig) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+// DeleteByID clears the uploaded source map files of the source map upload configuration. The configuration itself is
+// kept as the Instana API does not provide an endpoint to delete it
+func (r *sourceMapUploadConfigRestResource) DeleteByID(id string) error {
+	websiteID, configID, err := ParseSourceMapUploadConfigID(id)
+	if err != nil {
+		return err
+	}
+	err = r.clear(websiteID, configID)
+	if err != nil && !errors.Is(err, ErrEntityNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (r *sourceMapUploadConfigRestResource) clear(websiteID string, configID string) error {
+	_, err := r.client.PutWithoutBody(r.instancePath(websiteID, configID) + "/clear")
+	return err
+}
+
+func (r *sourceMapUploadConfigRestResource) instancePath(websiteID string, configID string) string {
+	return fmt.Sprintf("%s/%s%s/%s", WebsiteMonitoringConfigResourcePath, websiteID, sourceMapUploadPathElement, configID)
+}
@@ -0,0 +1,216 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	sourceMapWebsiteID         = "website-id"
+	sourceMapConfigID          = "sourcemap-config-id"
+	sourceMapCompositeID       = sourceMapWebsiteID + "/" + sourceMapConfigID
+	sourceMapInstancePath      = WebsiteMonitoringConfigResourcePath + "/" + sourceMapWebsiteID + "/sourcemap-upload/" + sourceMapConfigID
+	sourceMapConfigPayload     = `{"id":"sourcemap-config-id","description":"description","metadata":[]}`
+	sourceMapConfigFormPayload = `{"id":"sourcemap-config-id","description":"description","metadata":[{"format":"JS_MAP","type":"JS_MAP","url":"https://example.com/main.js"}]}`
+)
+
+func createSourceMapUploadConfigRestResource(ctrl *gomock.Controller) (*mocks.MockRestClient, RestResource[*SourceMapUploadConfig]) {
+	restClient := mocks.NewMockRestClient(ctrl)
+	return restClient, NewSourceMapUploadConfigRestResource(NewDefaultJSONUnmarshaller(&SourceMapUploadConfig{}), restClient)
+}
+
+func makeSourceMapUploadConfig() *SourceMapUploadConfig {
+	description := "description"
+	return &SourceMapUploadConfig{
+		ID:          sourceMapConfigID,
+		WebsiteID:   sourceMapWebsiteID,
+		Description: &description,
+		Metadata:    []SourceMapFileMeta{},
+	}
+}
+
+func TestShouldCreateAndParseSourceMapUploadConfigID(t *testing.T) {
+	require.Equal(t, sourceMapCompositeID, NewSourceMapUploadConfigID(sourceMapWebsiteID, sourceMapConfigID))
+
+	websiteID, configID, err := ParseSourceMapUploadConfigID(sourceMapCompositeID)
+
+	require.NoError(t, err)
+	require.Equal(t, sourceMapWebsiteID, websiteID)
+	require.Equal(t, sourceMapConfigID, configID)
+}
+
+func TestShouldFailToParseInvalidSourceMapUploadConfigID(t *testing.T) {
+	for _, id := range []string{"", "website-id", "website-id/", "/config-id", "a/b/c"} {
+		_, _, err := ParseSourceMapUploadConfigID(id)
+
+		require.Error(t, err, id)
+	}
+}
+
+func TestShouldReturnErrorWhenGettingAllSourceMapUploadConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, sut := createSourceMapUploadConfigRestResource(ctrl)
+
+	_, err := sut.GetAll()
+
+	require.Error(t, err)
+}
+
+func TestShouldReturnSourceMapUploadConfigWithIDsOnlyWhenGettingOneSourceMapUploadConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, sut := createSourceMapUploadConfigRestResource(ctrl)
+
+	result, err := sut.GetOne(sourceMapCompositeID)
+
+	require.NoError(t, err)
+	require.Equal(t, &SourceMapUploadConfig{ID: sourceMapConfigID, WebsiteID: sourceMapWebsiteID}, result)
+}
+
+func TestShouldFailToGetOneSourceMapUploadConfigWhenIDIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, sut := createSourceMapUploadConfigRestResource(ctrl)
+
+	_, err := sut.GetOne(sourceMapConfigID)
+
+	require.Error(t, err)
+}
+
+func TestShouldClearSourceMapUploadConfigAndUploadSourceMapFilesOnCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileFormat := "JS_MAP"
+	config := &SourceMapUploadConfig{ID: sourceMapConfigID, WebsiteID: sourceMapWebsiteID}
+	config.Uploads = []SourceMapUpload{{FileName: "main.js.map", Content: []byte("content"), URL: "https://example.com/main.js", FileFormat: &fileFormat}}
+	restClient, sut := createSourceMapUploadConfigRestResource(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().PutWithoutBody(sourceMapInstancePath+"/clear").Times(1).Return([]byte{}, nil),
+		restClient.EXPECT().PutMultipartForm(
+			sourceMapInstancePath+"/form",
+			map[string]string{"url": "https://example.com/main.js", "fileFormat": fileFormat},
+			MultipartFile{ParamName: "sourceMap", FileName: "main.js.map", Content: []byte("content")},
+		).Times(1).Return([]byte(sourceMapConfigFormPayload), nil),
+	)
+
+	result, err := sut.Create(config)
+
+	require.NoError(t, err)
+	expected := makeSourceMapUploadConfig()
+	expected.Metadata = []SourceMapFileMeta{{Format: "JS_MAP", Type: "JS_MAP", URL: "https://example.com/main.js"}}
+	require.Equal(t, expected, result)
+}
+
+func TestShouldOnlyClearSourceMapUploadConfigOnCreateWhenNoSourceMapFilesAreProvided(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := &SourceMapUploadConfig{ID: sourceMapConfigID, WebsiteID: sourceMapWebsiteID}
+	restClient, sut := createSourceMapUploadConfigRestResource(ctrl)
+	restClient.EXPECT().PutWithoutBody(sourceMapInstancePath+"/clear").Times(1).Return([]byte{}, nil)
+
+	result, err := sut.Create(config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldFailToCreateSourceMapUploadConfigWhenUploadFails(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := &SourceMapUploadConfig{ID: sourceMapConfigID, WebsiteID: sourceMapWebsiteID}
+	config.Uploads = []SourceMapUpload{{FileName: "main.js.map", Content: []byte("content"), URL: "https://example.com/main.js"}}
+	restClient, sut := createSourceMapUploadConfigRestResource(ctrl)
+	restClient.EXPECT().PutWithoutBody(sourceMapInstancePath+"/clear").Times(1).Return([]byte{}, nil)
+	restClient.EXPECT().PutMultipartForm(sourceMapInstancePath+"/form", map[string]string{"url": "https://example.com/main.js"}, gomock.Any()).Times(1).Return(nil, expectedError)
+
+	result, err := sut.Create(config)
+
+	require.ErrorIs(t, err, expectedError)
+	require.Equal(t, config, result)
+}
+
+func TestShouldFailToCreateSourceMapUploadConfigWhenClearFails(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := &SourceMapUploadConfig{ID: sourceMapConfigID, WebsiteID: sourceMapWebsiteID}
+	config.Uploads = []SourceMapUpload{{FileName: "main.js.map", Content: []byte("content"), URL: "https://example.com/main.js"}}
+	restClient, sut := createSourceMapUploadConfigRestResource(ctrl)
+	restClient.EXPECT().PutWithoutBody(sourceMapInstancePath+"/clear").Times(1).Return(nil, expectedError)
+
+	_, err := sut.Create(config)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldClearSourceMapUploadConfigAndUploadSourceMapFilesOnUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	config := &SourceMapUploadConfig{ID: sourceMapConfigID, WebsiteID: sourceMapWebsiteID}
+	config.Uploads = []SourceMapUpload{{FileName: "main.js.map", Content: []byte("content"), URL: "https://example.com/main.js"}}
+	restClient, sut := createSourceMapUploadConfigRestResource(ctrl)
+	gomock.InOrder(
+		restClient.EXPECT().PutWithoutBody(sourceMapInstancePath+"/clear").Times(1).Return([]byte{}, nil),
+		restClient.EXPECT().PutMultipartForm(sourceMapInstancePath+"/form", map[string]string{"url": "https://example.com/main.js"}, gomock.Any()).Times(1).Return([]byte(sourceMapConfigPayload), nil),
+	)
+
+	result, err := sut.Update(config)
+
+	require.NoError(t, err)
+	require.Equal(t, makeSourceMapUploadConfig(), result)
+}
+
+func TestShouldClearSourceMapUploadConfigOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createSourceMapUploadConfigRestResource(ctrl)
+	restClient.EXPECT().PutWithoutBody(sourceMapInstancePath+"/clear").Times(1).Return([]byte{}, nil)
+
+	err := sut.Delete(makeSourceMapUploadConfig())
+
+	require.NoError(t, err)
+}
+
+func TestShouldIgnoreNotFoundErrorWhenDeletingSourceMapUploadConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createSourceMapUploadConfigRestResource(ctrl)
+	restClient.EXPECT().PutWithoutBody(sourceMapInstancePath+"/clear").Times(1).Return(nil, ErrEntityNotFound)
+
+	err := sut.DeleteByID(sourceMapCompositeID)
+
+	require.NoError(t, err)
+}
+
+func TestShouldFailToDeleteSourceMapUploadConfigWhenClearFails(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient, sut := createSourceMapUploadConfigRestResource(ctrl)
+	restClient.EXPECT().PutWithoutBody(sourceMapInstancePath+"/clear").Times(1).Return(nil, expectedError)
+
+	err := sut.DeleteByID(sourceMapCompositeID)
+
+	require.Equal(t, expectedError, err)
+}
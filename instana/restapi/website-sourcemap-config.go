@@ -0,0 +1,60 @@
+package restapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	sourceMapUploadPathElement = "/sourcemap-upload"
+	sourceMapIDSeparator       = "/"
+)
+
+// SourceMapUploadConfig data structure of a source map upload configuration of a website of the Instana API. The
+// configuration is a sub resource of the website and therefore identified by the combination of the website ID and
+// the ID of the source map upload configuration.
+type SourceMapUploadConfig struct {
+	ID          string              `json:"id,omitempty"`
+	WebsiteID   string              `json:"-"`
+	Description *string             `json:"description,omitempty"`
+	Metadata    []SourceMapFileMeta `json:"metadata,omitempty"`
+	Uploads     []SourceMapUpload   `json:"-"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the combined ID of the website and
+// the source map upload configuration
+func (c *SourceMapUploadConfig) GetIDForResourcePath() string {
+	return NewSourceMapUploadConfigID(c.WebsiteID, c.ID)
+}
+
+// SourceMapFileMeta data structure of the meta data of a source map file uploaded to a source map upload configuration
+type SourceMapFileMeta struct {
+	Format string `json:"format"`
+	Type   string `json:"type"`
+	URL    string `json:"url"`
+	Size   int64  `json:"size,omitempty"`
+}
+
+// SourceMapUpload a source map file which is uploaded to a source map upload configuration via the form based upload
+type SourceMapUpload struct {
+	FileName   string
+	Content    []byte
+	URL        string
+	FileFormat *string
+}
+
+// NewSourceMapUploadConfigID creates the combined ID of a source map upload configuration from the website ID and the
+// ID of the source map upload configuration
+func NewSourceMapUploadConfigID(websiteID string, sourceMapConfigID string) string {
+	return websiteID + sourceMapIDSeparator + sourceMapConfigID
+}
+
+// ParseSourceMapUploadConfigID splits the combined ID of a source map upload configuration into the website ID and the
+// ID of the source map upload configuration
+func ParseSourceMapUploadConfigID(id string) (string, string, error) {
+	parts := strings.Split(id, sourceMapIDSeparator)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("invalid source map upload config ID %s; expected format <websiteId>/<sourceMapConfigId>", id)
+	}
+	return parts[0], parts[1], nil
+}
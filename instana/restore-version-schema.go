@@ -0,0 +1,74 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceFieldRestoreVersion constant value for field restore_version of smart alert configs which support the version history
+const ResourceFieldRestoreVersion = "restore_version"
+
+var restoreVersionSchema = &schema.Schema{
+	Type:         schema.TypeInt,
+	Optional:     true,
+	ValidateFunc: validation.IntAtLeast(1),
+	Description:  "The creation timestamp of a version of the version history of the alert configuration. When changed, the alert configuration is restored to the given version during update. The restore is a one-time operation: the next apply after the restore overwrites the restored version with the configuration of the resource unless the configuration is aligned with the restored version. Ignored on create",
+}
+
+// validateRestoreVersionChange ensures that the restore version is not changed together with other fields of an existing alert configuration as the restored version replaces the whole configuration
+func validateRestoreVersionChange(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange(ResourceFieldRestoreVersion) {
+		return nil
+	}
+	if created, ok := d.Get(ResourceFieldRestoreVersion).(int); !ok || created <= 0 {
+		return nil
+	}
+	for _, key := range d.GetChangedKeysPrefix("") {
+		if key != ResourceFieldRestoreVersion && !strings.HasPrefix(key, ResourceFieldBaselineRefreshTrigger) {
+			return fmt.Errorf("%s cannot be changed together with other attributes; %s has changed", ResourceFieldRestoreVersion, key)
+		}
+	}
+	return nil
+}
+
+// restoreVersionOnChange restores the version of the given alert configuration referenced by the restore version field when the field has changed. The restored alert configuration is returned together with the flag true when a version has been restored
+func restoreVersionOnChange[T restapi.EnableAwareInstanaDataObject](d *schema.ResourceData, config T, restResourceProvider func() restapi.AlertConfigRestResource[T]) (T, bool, error) {
+	if !d.HasChange(ResourceFieldRestoreVersion) {
+		return config, false, nil
+	}
+	created, ok := d.Get(ResourceFieldRestoreVersion).(int)
+	if !ok || created <= 0 {
+		return config, false, nil
+	}
+	restResource := restResourceProvider()
+	id := config.GetIDForResourcePath()
+	if err := restResource.RestoreVersion(id, int64(created)); err != nil {
+		return config, true, err
+	}
+	restored, err := restResource.GetOne(id)
+	return restored, true, err
+}
+
+// restoreVersionWarning reports a warning after a version has been restored during the update of an alert configuration. The restored configuration is stored in the terraform state, so the next plan shows the differences to the configuration of the resource which would overwrite the restored version
+func restoreVersionWarning(d *schema.ResourceData) diag.Diagnostics {
+	if d.IsNewResource() || !d.HasChange(ResourceFieldRestoreVersion) {
+		return nil
+	}
+	created, ok := d.Get(ResourceFieldRestoreVersion).(int)
+	if !ok || created <= 0 {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("alert configuration %s has been restored to version %d", d.Id(), created),
+			Detail:   "The restore is a one-time operation. The restored configuration is stored in the terraform state and the next plan shows the differences to the configuration of the resource. Align the configuration of the resource with the restored version, otherwise the next apply overwrites the restored version.",
+		},
+	}
+}
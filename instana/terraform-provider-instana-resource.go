@@ -19,6 +19,9 @@ type ResourceMetaData struct {
 	ResourceIDField    *string
 	CreateOnly         bool
 	DeprecationMessage string
+	CustomizeDiff      schema.CustomizeDiffFunc
+	//DeleteByDataObject when set the resource is deleted via RestResource.Delete using the data object mapped from the terraform state instead of RestResource.DeleteByID. Required for resources which need more than the ID to be deleted
+	DeleteByDataObject bool
 }
 
 // ResourceHandle resource specific implementation which provides metadata and maps data from/to terraform state. Together with TerraformResource terraform schema resources can be created
@@ -38,6 +41,36 @@ type ResourceHandle[T restapi.InstanaDataObject] interface {
 	SetComputedFields(d *schema.ResourceData) error
 }
 
+// PostUpdateHook optional extension of a ResourceHandle for resources which require additional API calls after the resource has been updated
+type PostUpdateHook[T restapi.InstanaDataObject] interface {
+	//PostUpdate is executed after the successful update of the resource and before the terraform state is updated. The returned data object is used to update the state
+	PostUpdate(d *schema.ResourceData, api restapi.InstanaAPI, obj T) (T, error)
+}
+
+// PostReadHook optional extension of a ResourceHandle for resources which require additional API calls after the resource has been read
+type PostReadHook[T restapi.InstanaDataObject] interface {
+	//PostRead is executed after the successful read of the resource and before the terraform state is updated. The returned data object is used to update the state
+	PostRead(d *schema.ResourceData, api restapi.InstanaAPI, obj T) (T, error)
+}
+
+// UpdateHandler optional extension of a ResourceHandle for resources which require a custom update of the resource instead of the default update operation of the RestResource
+type UpdateHandler[T restapi.InstanaDataObject] interface {
+	//Update is executed instead of RestResource.Update. The returned data object is used to update the state
+	Update(d *schema.ResourceData, api restapi.InstanaAPI, obj T) (T, error)
+}
+
+// PostApplyHook optional extension of a ResourceHandle for resources which require additional API calls after the resource has been created or updated, e.g. to verify the applied configuration
+type PostApplyHook[T restapi.InstanaDataObject] interface {
+	//PostApply is executed after the successful create or update of the resource and after the terraform state has been updated. The data object is the one mapped from the terraform state. The returned diagnostics are reported to terraform
+	PostApply(d *schema.ResourceData, api restapi.InstanaAPI, obj T) diag.Diagnostics
+}
+
+// PostDeleteHook optional extension of a ResourceHandle for resources which need to report additional information after the resource has been deleted
+type PostDeleteHook[T restapi.InstanaDataObject] interface {
+	//PostDelete is executed after the successful deletion of the resource and after the resource has been removed from the terraform state. The data object is the one mapped from the terraform state. The returned diagnostics are reported to terraform
+	PostDelete(d *schema.ResourceData, api restapi.InstanaAPI, obj T) diag.Diagnostics
+}
+
 // NewTerraformResource creates a new terraform resource for the given handle
 func NewTerraformResource[T restapi.InstanaDataObject](handle ResourceHandle[T]) TerraformResource {
 	return &terraformResourceImpl[T]{
@@ -83,7 +116,7 @@ func (r *terraformResourceImpl[T]) Create(_ context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+	return r.postApply(d, instanaAPI, createRequest)
 }
 
 // Read defines the read operation for the terraform resource
@@ -102,6 +135,12 @@ func (r *terraformResourceImpl[T]) Read(_ context.Context, d *schema.ResourceDat
 		}
 		return diag.FromErr(err)
 	}
+	if hook, ok := r.resourceHandle.(PostReadHook[T]); ok {
+		obj, err = hook.PostRead(d, instanaAPI, obj)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	err = r.resourceHandle.UpdateState(d, obj)
 	if err != nil {
 		return diag.FromErr(err)
@@ -125,14 +164,32 @@ func (r *terraformResourceImpl[T]) Update(_ context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	updatedObject, err := r.resourceHandle.GetRestResource(instanaAPI).Update(obj)
+	var updatedObject T
+	if handler, ok := r.resourceHandle.(UpdateHandler[T]); ok {
+		updatedObject, err = handler.Update(d, instanaAPI, obj)
+	} else {
+		updatedObject, err = r.resourceHandle.GetRestResource(instanaAPI).Update(obj)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if hook, ok := r.resourceHandle.(PostUpdateHook[T]); ok {
+		updatedObject, err = hook.PostUpdate(d, instanaAPI, updatedObject)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	err = r.resourceHandle.UpdateState(d, updatedObject)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	return r.postApply(d, instanaAPI, obj)
+}
+
+func (r *terraformResourceImpl[T]) postApply(d *schema.ResourceData, api restapi.InstanaAPI, obj T) diag.Diagnostics {
+	if hook, ok := r.resourceHandle.(PostApplyHook[T]); ok {
+		return hook.PostApply(d, api, obj)
+	}
 	return nil
 }
 
@@ -150,18 +207,28 @@ func (r *terraformResourceImpl[T]) Delete(_ context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = r.resourceHandle.GetRestResource(instanaAPI).DeleteByID(object.GetIDForResourcePath())
+	if r.resourceHandle.MetaData().DeleteByDataObject {
+		err = r.resourceHandle.GetRestResource(instanaAPI).Delete(object)
+	} else {
+		err = r.resourceHandle.GetRestResource(instanaAPI).DeleteByID(object.GetIDForResourcePath())
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId("")
+	if hook, ok := r.resourceHandle.(PostDeleteHook[T]); ok {
+		return hook.PostDelete(d, instanaAPI, object)
+	}
 	return nil
 }
 
 func (r *terraformResourceImpl[T]) ToSchemaResource() *schema.Resource {
 	metaData := r.resourceHandle.MetaData()
 	var updateOperation schema.UpdateContextFunc
-	if r.resourceHandle.MetaData().CreateOnly {
+	if !r.hasUpdatableFields(metaData.Schema) {
+		//all fields force a new resource; terraform does not allow to define an update operation in this case
+		updateOperation = nil
+	} else if r.resourceHandle.MetaData().CreateOnly {
 		updateOperation = r.NoUpdateSupported
 	} else {
 		updateOperation = r.Update
@@ -178,7 +245,19 @@ func (r *terraformResourceImpl[T]) ToSchemaResource() *schema.Resource {
 		SchemaVersion:      metaData.SchemaVersion,
 		StateUpgraders:     r.resourceHandle.StateUpgraders(),
 		DeprecationMessage: metaData.DeprecationMessage,
+		CustomizeDiff:      metaData.CustomizeDiff,
+	}
+}
+
+// hasUpdatableFields returns true when at least one configurable field of the schema can be updated in place. Terraform
+// rejects resources which define an update operation although all configurable fields force a new resource
+func (r *terraformResourceImpl[T]) hasUpdatableFields(schemaMap map[string]*schema.Schema) bool {
+	for _, s := range schemaMap {
+		if !s.ForceNew && (s.Optional || s.Required) {
+			return true
+		}
 	}
+	return false
 }
 
 func (r *terraformResourceImpl[T]) importState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
@@ -8,6 +8,7 @@ import (
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -22,12 +23,23 @@ func TestTerraformProviderInstanaResource(t *testing.T) {
 	t.Run("should fail to read test object from instana API when id is missing", ut.shouldFailToReadTestObjectFromInstanaAPIWhenResourceIDIsMissing)
 	t.Run("should fail to read test object from instana API and delete resource when role does not exist", ut.shouldFailToReadTestObjectFromInstanaAPIAndDeleteResourceWhenRoleDoesNotExist)
 	t.Run("should fail to read test object from instana API and return error code when API call fails", ut.shouldFailToReadTestObjectFromInstanaAPIAndReturnErrorWhenAPICallFails)
+	t.Run("should execute post read hook of resource handle after read", ut.shouldExecutePostReadHookOfResourceHandleAfterRead)
+	t.Run("should return error when post read hook of resource handle fails", ut.shouldReturnErrorWhenPostReadHookOfResourceHandleFails)
 	t.Run("should create test object through Instana API", ut.shouldCreateTestObjectThroughInstanaAPI)
 	t.Run("should return error when create test object fails through Instana API", ut.shouldReturnErrorWhenCreateTestObjectFailsThroughInstanaAPI)
 	t.Run("should update test object through Instana API", ut.shouldUpdateTestObjectThroughInstanaAPI)
 	t.Run("should return error when update test object fails through Instana API", ut.shouldReturnErrorWhenUpdateTestObjectFailsThroughInstanaAPI)
+	t.Run("should execute post update hook of resource handle after update", ut.shouldExecutePostUpdateHookOfResourceHandleAfterUpdate)
+	t.Run("should use update handler of resource handle instead of default update when provided", ut.shouldUseUpdateHandlerOfResourceHandleInsteadOfDefaultUpdateWhenProvided)
+	t.Run("should execute post apply hook of resource handle after create", ut.shouldExecutePostApplyHookOfResourceHandleAfterCreate)
+	t.Run("should execute post apply hook of resource handle after update", ut.shouldExecutePostApplyHookOfResourceHandleAfterUpdate)
 	t.Run("should delete test object through Instana API", ut.shouldDeleteTestObjectThroughInstanaAPI)
 	t.Run("should return error when delete test object fails through Instana API", ut.shouldReturnErrorWhenDeleteTestObjectFailsThroughInstanaAPI)
+	t.Run("should delete test object by data object through Instana API when configured", ut.shouldDeleteTestObjectByDataObjectThroughInstanaAPIWhenConfigured)
+	t.Run("should execute post delete hook of resource handle after delete", ut.shouldExecutePostDeleteHookOfResourceHandleAfterDelete)
+	t.Run("should not execute post delete hook of resource handle when delete fails", ut.shouldNotExecutePostDeleteHookOfResourceHandleWhenDeleteFails)
+	t.Run("should not define update operation when all fields force a new resource", ut.shouldNotDefineUpdateOperationWhenAllFieldsForceANewResource)
+	t.Run("should define update operation and pass internal validation for all resources of the provider", ut.shouldDefineUpdateOperationAndPassInternalValidationForAllResourcesOfTheProvider)
 }
 
 type terraformProviderInstanaResourceUnitTest struct{}
@@ -38,7 +50,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldSuccessfullyReadTestObj
 	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
 		resourceData := r.createEmptyAlertingChannelResourceData(t)
 		resourceData.SetId(alertingChannelEmailID)
-		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(expectedModel, nil).Times(1)
@@ -70,7 +82,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldFailToReadTestObjectFro
 	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
 		resourceData := r.createEmptyAlertingChannelResourceData(t)
 		resourceData.SetId(alertingChannelEmailID)
-		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(&restapi.AlertingChannel{}, restapi.ErrEntityNotFound).Times(1)
@@ -89,7 +101,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldFailToReadTestObjectFro
 		resourceData := r.createEmptyAlertingChannelResourceData(t)
 		resourceData.SetId(alertingChannelEmailID)
 		expectedError := errors.New("test")
-		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
@@ -104,13 +116,60 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldFailToReadTestObjectFro
 	})
 }
 
+func (r *terraformProviderInstanaResourceUnitTest) shouldExecutePostReadHookOfResourceHandleAfterRead(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		resourceData.SetId("id")
+		setValueOnResourceData(t, resourceData, MonitoringPrivacySettingsFieldIPMasking, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldIPMaskingMode: "DEFAULT"}})
+		mockTestObjectApi := mocks.NewMockWebsiteMonitoringConfigRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().WebsiteMonitoringConfig().Return(mockTestObjectApi).Times(2)
+		gomock.InOrder(
+			mockTestObjectApi.EXPECT().GetOne(gomock.Eq("id")).Return(&restapi.WebsiteMonitoringConfig{ID: "id", Name: "name"}, nil).Times(1),
+			mockTestObjectApi.EXPECT().GetPrivacySettings(gomock.Eq("id"), gomock.Eq(restapi.MonitoringPrivacySettingsSelection{IPMasking: true})).Return(restapi.MonitoringPrivacySettings{
+				IPMasking: &restapi.IPMaskingConfiguration{IPMasking: restapi.IPMaskingStrict},
+			}, nil).Times(1),
+		)
+
+		diag := NewTerraformResource(resourceHandle).Read(context.TODO(), resourceData, providerMeta)
+
+		assert.Nil(t, diag)
+		assert.Equal(t, "name", resourceData.Get(WebsiteMonitoringConfigFieldName))
+		assert.Equal(t, []interface{}{map[string]interface{}{MonitoringPrivacySettingsFieldIPMaskingMode: "STRICT"}}, resourceData.Get(MonitoringPrivacySettingsFieldIPMasking))
+	})
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenPostReadHookOfResourceHandleFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteMonitoringConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewWebsiteMonitoringConfigResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		resourceData.SetId("id")
+		expectedError := errors.New("test")
+		mockTestObjectApi := mocks.NewMockWebsiteMonitoringConfigRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().WebsiteMonitoringConfig().Return(mockTestObjectApi).Times(2)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Eq("id")).Return(&restapi.WebsiteMonitoringConfig{ID: "id", Name: "name"}, nil).Times(1)
+		mockTestObjectApi.EXPECT().GetPrivacySettings(gomock.Eq("id"), gomock.Any()).Return(restapi.MonitoringPrivacySettings{}, expectedError).Times(1)
+
+		diag := NewTerraformResource(resourceHandle).Read(context.TODO(), resourceData, providerMeta)
+
+		assert.NotNil(t, diag)
+		assert.True(t, diag.HasError())
+		assert.Equal(t, diag[0].Summary, expectedError.Error())
+		assert.Equal(t, "id", resourceData.Id())
+	})
+}
+
 func (r *terraformProviderInstanaResourceUnitTest) shouldCreateTestObjectThroughInstanaAPI(t *testing.T) {
 	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
 	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
 		data := r.createTestAlertingChannelEmailData()
 		resourceData := r.createAlertingChannelResourceData(data, t)
 		expectedModel := r.createTestAlertingChannelEmailObject()
-		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockTestObjectApi.EXPECT().Create(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
@@ -129,7 +188,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenCreateTe
 		data := r.createTestAlertingChannelEmailData()
 		resourceData := r.createAlertingChannelResourceData(data, t)
 		expectedError := errors.New("test")
-		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockTestObjectApi.EXPECT().Create(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
@@ -149,7 +208,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldUpdateTestObjectThrough
 		data := r.createTestAlertingChannelEmailData()
 		resourceData := r.createAlertingChannelResourceData(data, t)
 		expectedModel := r.createTestAlertingChannelEmailObject()
-		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockTestObjectApi.EXPECT().Update(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
@@ -168,7 +227,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenUpdateTe
 		data := r.createTestAlertingChannelEmailData()
 		resourceData := r.createAlertingChannelResourceData(data, t)
 		expectedError := errors.New("test")
-		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockTestObjectApi.EXPECT().Update(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
@@ -182,6 +241,154 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenUpdateTe
 	})
 }
 
+func (r *terraformProviderInstanaResourceUnitTest) shouldExecutePostUpdateHookOfResourceHandleAfterUpdate(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.MobileAppAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewMobileAppAlertConfigResourceHandle()
+		resourceData := testHelper.CreateResourceDataForResourceHandle(resourceHandle, map[string]interface{}{
+			MobileAppAlertConfigFieldName:       "name",
+			MobileAppAlertConfigFieldSeverity:   restapi.SeverityWarning.GetTerraformRepresentation(),
+			ResourceFieldBaselineRefreshTrigger: map[string]interface{}{"key": "value"},
+			ResourceFieldThreshold: []interface{}{
+				map[string]interface{}{
+					ResourceFieldThresholdStatic: []interface{}{
+						map[string]interface{}{
+							ResourceFieldThresholdOperator:    ">=",
+							ResourceFieldThresholdStaticValue: 5.0,
+						},
+					},
+				},
+			},
+		})
+		resourceData.SetId("id")
+		updatedModel := r.createTestMobileAppAlertConfigObject("updated")
+		refreshedModel := r.createTestMobileAppAlertConfigObject("refreshed")
+		mockTestObjectApi := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.MobileAppAlertConfig](ctrl)
+
+		mockInstanaAPI.EXPECT().MobileAppAlertConfigs().Return(mockTestObjectApi).Times(2)
+		mockTestObjectApi.EXPECT().Update(gomock.AssignableToTypeOf(&restapi.MobileAppAlertConfig{})).Return(updatedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().UpdateBaseline(gomock.Eq("id")).Return(nil).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Eq("id")).Return(refreshedModel, nil).Times(1)
+
+		diag := NewTerraformResource(resourceHandle).Update(context.TODO(), resourceData, providerMeta)
+
+		assert.Nil(t, diag)
+		assert.Equal(t, "refreshed", resourceData.Get(MobileAppAlertConfigFieldName))
+	})
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldUseUpdateHandlerOfResourceHandleInsteadOfDefaultUpdateWhenProvided(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewWebsiteAlertConfigResourceHandle()
+		data := map[string]interface{}{
+			WebsiteAlertConfigFieldName:     "name",
+			WebsiteAlertConfigFieldSeverity: restapi.SeverityWarning.GetTerraformRepresentation(),
+			WebsiteAlertConfigFieldRule: []interface{}{
+				map[string]interface{}{
+					WebsiteAlertConfigFieldRuleThroughput: []interface{}{
+						map[string]interface{}{
+							WebsiteAlertConfigFieldRuleMetricName: "onLoadTime",
+						},
+					},
+				},
+			},
+			ResourceFieldThreshold: []interface{}{
+				map[string]interface{}{
+					ResourceFieldThresholdStatic: []interface{}{
+						map[string]interface{}{
+							ResourceFieldThresholdOperator:    ">=",
+							ResourceFieldThresholdStaticValue: 5.0,
+						},
+					},
+				},
+			},
+			WebsiteAlertConfigFieldTimeThreshold: []interface{}{
+				map[string]interface{}{
+					WebsiteAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+						map[string]interface{}{
+							WebsiteAlertConfigFieldTimeThresholdTimeWindow: 600000,
+						},
+					},
+				},
+			},
+		}
+		previousData := make(map[string]interface{})
+		for k, v := range data {
+			previousData[k] = v
+		}
+		previousData[WebsiteAlertConfigFieldEnabled] = true
+		data[WebsiteAlertConfigFieldEnabled] = false
+		resourceData := createResourceDataWithChangesForResourceHandle(t, resourceHandle, "id", previousData, data)
+		refreshedModel := &restapi.WebsiteAlertConfig{
+			ID:            "id",
+			Name:          "refreshed",
+			Severity:      restapi.SeverityWarning.GetAPIRepresentation(),
+			Rule:          restapi.WebsiteAlertRule{AlertType: "throughput", MetricName: "onLoadTime"},
+			Threshold:     restapi.Threshold{Type: "staticThreshold", Operator: restapi.ThresholdOperatorGreaterThanOrEqual},
+			TimeThreshold: restapi.WebsiteTimeThreshold{Type: "violationsInSequence"},
+			Enabled:       utils.BoolPtr(false),
+		}
+		mockTestObjectApi := mocks.NewMockBaselineAwareAlertConfigRestResource[*restapi.WebsiteAlertConfig](ctrl)
+
+		mockInstanaAPI.EXPECT().WebsiteAlertConfig().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().UpdateEnabledState(gomock.AssignableToTypeOf(&restapi.WebsiteAlertConfig{})).Return(refreshedModel, nil).Times(1)
+
+		diag := NewTerraformResource(resourceHandle).Update(context.TODO(), resourceData, providerMeta)
+
+		assert.Nil(t, diag)
+		assert.Equal(t, "refreshed", resourceData.Get(WebsiteAlertConfigFieldName))
+		assert.False(t, resourceData.Get(WebsiteAlertConfigFieldEnabled).(bool))
+	})
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldExecutePostApplyHookOfResourceHandleAfterCreate(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		data := r.createTestAlertingChannelEmailData()
+		data[AlertingChannelFieldVerifyOnApply] = true
+		resourceData := r.createAlertingChannelResourceData(data, t)
+		expectedModel := r.createTestAlertingChannelEmailObject()
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(2)
+		gomock.InOrder(
+			mockTestObjectApi.EXPECT().Create(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1),
+			mockTestObjectApi.EXPECT().SendTestNotification(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(errors.New("test")).Times(1),
+		)
+
+		resourceHandle := NewAlertingChannelResourceHandle()
+		diag := NewTerraformResource(resourceHandle).Create(context.TODO(), resourceData, providerMeta)
+
+		assert.NotNil(t, diag)
+		assert.True(t, diag.HasError())
+		r.verifyTestObjectModelAppliedToResource(expectedModel, resourceData, t)
+	})
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldExecutePostApplyHookOfResourceHandleAfterUpdate(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		data := r.createTestAlertingChannelEmailData()
+		data[AlertingChannelFieldVerifyOnApply] = true
+		resourceData := r.createAlertingChannelResourceData(data, t)
+		expectedModel := r.createTestAlertingChannelEmailObject()
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(2)
+		gomock.InOrder(
+			mockTestObjectApi.EXPECT().Update(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1),
+			mockTestObjectApi.EXPECT().SendTestNotification(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(nil).Times(1),
+		)
+
+		resourceHandle := NewAlertingChannelResourceHandle()
+		diag := NewTerraformResource(resourceHandle).Update(context.TODO(), resourceData, providerMeta)
+
+		assert.Nil(t, diag)
+		r.verifyTestObjectModelAppliedToResource(expectedModel, resourceData, t)
+	})
+}
+
 func (r *terraformProviderInstanaResourceUnitTest) shouldDeleteTestObjectThroughInstanaAPI(t *testing.T) {
 	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
 	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
@@ -189,7 +396,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldDeleteTestObjectThrough
 		data := r.createTestAlertingChannelEmailData()
 		resourceData := r.createAlertingChannelResourceData(data, t)
 		resourceData.SetId(id)
-		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(nil).Times(1)
@@ -210,7 +417,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenDeleteTe
 		resourceData := r.createAlertingChannelResourceData(data, t)
 		resourceData.SetId(id)
 		expectedError := errors.New("test")
-		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
+		mockTestObjectApi := mocks.NewMockAlertingChannelRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(expectedError).Times(1)
@@ -225,6 +432,29 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenDeleteTe
 	})
 }
 
+func (r *terraformProviderInstanaResourceUnitTest) shouldDeleteTestObjectByDataObjectThroughInstanaAPIWhenConfigured(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.BuiltinEventState](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		id := "test-id"
+		resourceHandle := NewBuiltinEventStateResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		resourceData.SetId(id)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldBuiltinEventID, id)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldEnabled, false)
+		setValueOnResourceData(t, resourceData, BuiltinEventStateFieldPreviousEnabled, true)
+		previousEnabled := true
+		mockTestObjectApi := mocks.NewMockRestResource[*restapi.BuiltinEventState](ctrl)
+
+		mockInstanaAPI.EXPECT().BuiltinEventStates().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().Delete(gomock.Eq(&restapi.BuiltinEventState{ID: id, Enabled: false, PreviousEnabled: &previousEnabled})).Return(nil).Times(1)
+
+		diag := NewTerraformResource(resourceHandle).Delete(context.TODO(), resourceData, providerMeta)
+
+		assert.Nil(t, diag)
+		assert.Empty(t, resourceData.Id())
+	})
+}
+
 func (r *terraformProviderInstanaResourceUnitTest) verifyTestObjectModelAppliedToResource(model *restapi.AlertingChannel, resourceData *schema.ResourceData, t *testing.T) {
 	assert.Equal(t, model.ID, resourceData.Id())
 	assert.Equal(t, resourceNameWithoutPrefixAndSuffix, resourceData.Get(AlertingChannelFieldName))
@@ -252,6 +482,25 @@ func (r *terraformProviderInstanaResourceUnitTest) createTestAlertingChannelEmai
 	}
 }
 
+func (r *terraformProviderInstanaResourceUnitTest) createTestMobileAppAlertConfigObject(name string) *restapi.MobileAppAlertConfig {
+	return &restapi.MobileAppAlertConfig{
+		ID:       "id",
+		Name:     name,
+		Severity: restapi.SeverityWarning.GetAPIRepresentation(),
+		Rule: restapi.MobileAppAlertRule{
+			AlertType:  "throughput",
+			MetricName: "crashes",
+		},
+		Threshold: restapi.Threshold{
+			Type:     "staticThreshold",
+			Operator: restapi.ThresholdOperatorGreaterThanOrEqual,
+		},
+		TimeThreshold: restapi.MobileAppTimeThreshold{
+			Type: "violationsInSequence",
+		},
+	}
+}
+
 func (r *terraformProviderInstanaResourceUnitTest) createTestAlertingChannelEmailData() map[string]interface{} {
 	emails := make([]interface{}, 2)
 	emails[0] = "Email1"
@@ -276,3 +525,66 @@ func (r *terraformProviderInstanaResourceUnitTest) createAlertingChannelResource
 	schemaMap := NewAlertingChannelResourceHandle().MetaData().Schema
 	return schema.TestResourceDataRaw(t, schemaMap, data)
 }
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldNotDefineUpdateOperationWhenAllFieldsForceANewResource(t *testing.T) {
+	schemaResource := NewTerraformResource(NewGroupMembershipResourceHandle()).ToSchemaResource()
+
+	assert.Nil(t, schemaResource.UpdateContext)
+	assert.Nil(t, schemaResource.InternalValidate(nil, true))
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldDefineUpdateOperationAndPassInternalValidationForAllResourcesOfTheProvider(t *testing.T) {
+	for name, resource := range Provider().ResourcesMap {
+		hasUpdatableField := false
+		for _, s := range resource.Schema {
+			if !s.ForceNew && (s.Optional || s.Required) {
+				hasUpdatableField = true
+			}
+		}
+
+		assert.Equal(t, hasUpdatableField, resource.UpdateContext != nil, "unexpected update operation of resource %s", name)
+		assert.Nil(t, resource.InternalValidate(nil, true), "internal validation of resource %s failed", name)
+	}
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldExecutePostDeleteHookOfResourceHandleAfterDelete(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewHostAgentConfigurationResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		resourceData.SetId("id")
+		setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldConfiguration, "remoteBranch: main\n")
+		mockTestObjectApi := mocks.NewMockRestResource[*restapi.HostAgentConfiguration](ctrl)
+
+		mockInstanaAPI.EXPECT().HostAgentConfigurations().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq("id")).Return(nil).Times(1)
+
+		diag := NewTerraformResource(resourceHandle).Delete(context.TODO(), resourceData, providerMeta)
+
+		assert.Len(t, diag, 1)
+		assert.False(t, diag.HasError())
+		assert.Contains(t, diag[0].Summary, "host agent configuration is not removed")
+		assert.Equal(t, "", resourceData.Id())
+	})
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldNotExecutePostDeleteHookOfResourceHandleWhenDeleteFails(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewHostAgentConfigurationResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		resourceData.SetId("id")
+		setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldConfiguration, "remoteBranch: main\n")
+		mockTestObjectApi := mocks.NewMockRestResource[*restapi.HostAgentConfiguration](ctrl)
+
+		mockInstanaAPI.EXPECT().HostAgentConfigurations().Return(mockTestObjectApi).Times(1)
+		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq("id")).Return(errors.New("test")).Times(1)
+
+		diag := NewTerraformResource(resourceHandle).Delete(context.TODO(), resourceData, providerMeta)
+
+		assert.Len(t, diag, 1)
+		assert.True(t, diag.HasError())
+		assert.Equal(t, "test", diag[0].Summary)
+		assert.Equal(t, "id", resourceData.Id())
+	})
+}
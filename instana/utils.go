@@ -1,9 +1,12 @@
 package instana
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v3"
 
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 
@@ -171,9 +174,53 @@ func NormalizeJSONString(jsonString string) string {
 	if err != nil {
 		return jsonString
 	}
-	bytes, err := json.Marshal(raw)
+	data, err := json.Marshal(raw)
 	if err != nil {
 		return jsonString
 	}
-	return string(bytes)
+	return string(data)
+}
+
+// ParseYAMLObject parses the given YAML document into a map. The document must be a non-empty YAML mapping which can be converted to JSON
+func ParseYAMLObject(yamlString string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := yaml.Unmarshal([]byte(yamlString), &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse yaml; %s", err)
+	}
+	if len(result) == 0 {
+		return nil, errors.New("yaml document must be a non-empty mapping")
+	}
+	if _, err = json.Marshal(result); err != nil {
+		return nil, fmt.Errorf("yaml document cannot be converted to json; %s", err)
+	}
+	return result, nil
+}
+
+// NormalizeYAMLString returns the canonical representation of the given YAML document with sorted keys, an indentation of two spaces and
+// without comments. The input is returned as is when it is not a valid YAML mapping
+func NormalizeYAMLString(yamlString string) string {
+	document, err := ParseYAMLObject(yamlString)
+	if err != nil {
+		return yamlString
+	}
+	result, err := MarshalYAMLObject(document)
+	if err != nil {
+		return yamlString
+	}
+	return result
+}
+
+// MarshalYAMLObject renders the given map as canonical YAML document with sorted keys and an indentation of two spaces
+func MarshalYAMLObject(document map[string]interface{}) (string, error) {
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
 }
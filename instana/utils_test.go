@@ -39,6 +39,13 @@ func TestUtils(t *testing.T) {
 	t.Run("should return nil when pointer is requested but value is not set in map", unitTest.shouldShouldReturnNilWhenPointerIsRequestedButNotSetInMap)
 	t.Run("should convert interface slice to target int slice", unitTest.shouldShouldConvertInterfaceSliceToTargetIntSlice)
 	t.Run("should get pointer from map", unitTest.shouldTestGetPointerFromMap)
+	t.Run("should parse yaml object", unitTest.shouldParseYAMLObject)
+	t.Run("should fail to parse yaml object when yaml is not valid", unitTest.shouldFailToParseYAMLObjectWhenYAMLIsNotValid)
+	t.Run("should fail to parse yaml object when yaml is not a mapping", unitTest.shouldFailToParseYAMLObjectWhenYAMLIsNotAMapping)
+	t.Run("should fail to parse yaml object when yaml is empty", unitTest.shouldFailToParseYAMLObjectWhenYAMLIsEmpty)
+	t.Run("should fail to parse yaml object when yaml cannot be converted to json", unitTest.shouldFailToParseYAMLObjectWhenYAMLCannotBeConvertedToJSON)
+	t.Run("should normalize yaml string", unitTest.shouldNormalizeYAMLString)
+	t.Run("should return input when yaml string cannot be normalized", unitTest.shouldReturnInputWhenYAMLStringCannotBeNormalized)
 }
 
 type utilsUnitTest struct{}
@@ -387,3 +394,64 @@ func createShouldReturnNilValueWhenKeyIsAvailableInMapAndValueIsNil() func(t *te
 	}
 
 }
+
+func (r *utilsUnitTest) shouldParseYAMLObject(t *testing.T) {
+	result, err := ParseYAMLObject("com.instana.plugin.host:\n  tags:\n    - production\n  enabled: true\n")
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"com.instana.plugin.host": map[string]interface{}{
+			"tags":    []interface{}{"production"},
+			"enabled": true,
+		},
+	}, result)
+}
+
+func (r *utilsUnitTest) shouldFailToParseYAMLObjectWhenYAMLIsNotValid(t *testing.T) {
+	_, err := ParseYAMLObject("key: [value")
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to parse yaml")
+}
+
+func (r *utilsUnitTest) shouldFailToParseYAMLObjectWhenYAMLIsNotAMapping(t *testing.T) {
+	_, err := ParseYAMLObject("- value1\n- value2\n")
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to parse yaml")
+}
+
+func (r *utilsUnitTest) shouldFailToParseYAMLObjectWhenYAMLIsEmpty(t *testing.T) {
+	_, err := ParseYAMLObject("# only a comment\n")
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "non-empty mapping")
+}
+
+func (r *utilsUnitTest) shouldFailToParseYAMLObjectWhenYAMLCannotBeConvertedToJSON(t *testing.T) {
+	_, err := ParseYAMLObject("key: .inf\n")
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot be converted to json")
+}
+
+func (r *utilsUnitTest) shouldNormalizeYAMLString(t *testing.T) {
+	input := `
+# comment
+zkey:   value
+akey:
+    nested: 1
+    list: [ "a",  b ]
+`
+
+	result := NormalizeYAMLString(input)
+
+	require.Equal(t, "akey:\n  list:\n    - a\n    - b\n  nested: 1\nzkey: value\n", result)
+	require.Equal(t, result, NormalizeYAMLString(result))
+}
+
+func (r *utilsUnitTest) shouldReturnInputWhenYAMLStringCannotBeNormalized(t *testing.T) {
+	input := "key: [value"
+
+	require.Equal(t, input, NormalizeYAMLString(input))
+}
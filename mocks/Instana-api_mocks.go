@@ -49,10 +49,10 @@ func (mr *MockInstanaAPIMockRecorder) APITokens() *gomock.Call {
 }
 
 // AlertingChannels mocks base method.
-func (m *MockInstanaAPI) AlertingChannels() restapi.RestResource[*restapi.AlertingChannel] {
+func (m *MockInstanaAPI) AlertingChannels() restapi.AlertingChannelRestResource {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AlertingChannels")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.AlertingChannel])
+	ret0, _ := ret[0].(restapi.AlertingChannelRestResource)
 	return ret0
 }
 
@@ -76,11 +76,39 @@ func (mr *MockInstanaAPIMockRecorder) AlertingConfigurations() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertingConfigurations", reflect.TypeOf((*MockInstanaAPI)(nil).AlertingConfigurations))
 }
 
+// ApdexConfigs mocks base method.
+func (m *MockInstanaAPI) ApdexConfigs() restapi.RestResource[*restapi.ApdexConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApdexConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ApdexConfig])
+	return ret0
+}
+
+// ApdexConfigs indicates an expected call of ApdexConfigs.
+func (mr *MockInstanaAPIMockRecorder) ApdexConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApdexConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ApdexConfigs))
+}
+
+// ApdexReports mocks base method.
+func (m *MockInstanaAPI) ApdexReports() restapi.ApdexReportResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApdexReports")
+	ret0, _ := ret[0].(restapi.ApdexReportResource)
+	return ret0
+}
+
+// ApdexReports indicates an expected call of ApdexReports.
+func (mr *MockInstanaAPIMockRecorder) ApdexReports() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApdexReports", reflect.TypeOf((*MockInstanaAPI)(nil).ApdexReports))
+}
+
 // ApplicationAlertConfigs mocks base method.
-func (m *MockInstanaAPI) ApplicationAlertConfigs() restapi.RestResource[*restapi.ApplicationAlertConfig] {
+func (m *MockInstanaAPI) ApplicationAlertConfigs() restapi.BaselineAwareAlertConfigRestResource[*restapi.ApplicationAlertConfig] {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ApplicationAlertConfigs")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.ApplicationAlertConfig])
+	ret0, _ := ret[0].(restapi.BaselineAwareAlertConfigRestResource[*restapi.ApplicationAlertConfig])
 	return ret0
 }
 
@@ -118,6 +146,20 @@ func (mr *MockInstanaAPIMockRecorder) BuiltinEventSpecifications() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuiltinEventSpecifications", reflect.TypeOf((*MockInstanaAPI)(nil).BuiltinEventSpecifications))
 }
 
+// BuiltinEventStates mocks base method.
+func (m *MockInstanaAPI) BuiltinEventStates() restapi.RestResource[*restapi.BuiltinEventState] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuiltinEventStates")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.BuiltinEventState])
+	return ret0
+}
+
+// BuiltinEventStates indicates an expected call of BuiltinEventStates.
+func (mr *MockInstanaAPIMockRecorder) BuiltinEventStates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuiltinEventStates", reflect.TypeOf((*MockInstanaAPI)(nil).BuiltinEventStates))
+}
+
 // CustomDashboards mocks base method.
 func (m *MockInstanaAPI) CustomDashboards() restapi.RestResource[*restapi.CustomDashboard] {
 	m.ctrl.T.Helper()
@@ -146,11 +188,25 @@ func (mr *MockInstanaAPIMockRecorder) CustomEventSpecifications() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomEventSpecifications", reflect.TypeOf((*MockInstanaAPI)(nil).CustomEventSpecifications))
 }
 
+// CustomPayloadConfiguration mocks base method.
+func (m *MockInstanaAPI) CustomPayloadConfiguration() restapi.CustomPayloadConfigurationRestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomPayloadConfiguration")
+	ret0, _ := ret[0].(restapi.CustomPayloadConfigurationRestResource)
+	return ret0
+}
+
+// CustomPayloadConfiguration indicates an expected call of CustomPayloadConfiguration.
+func (mr *MockInstanaAPIMockRecorder) CustomPayloadConfiguration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomPayloadConfiguration", reflect.TypeOf((*MockInstanaAPI)(nil).CustomPayloadConfiguration))
+}
+
 // GlobalApplicationAlertConfigs mocks base method.
-func (m *MockInstanaAPI) GlobalApplicationAlertConfigs() restapi.RestResource[*restapi.ApplicationAlertConfig] {
+func (m *MockInstanaAPI) GlobalApplicationAlertConfigs() restapi.AlertConfigRestResource[*restapi.ApplicationAlertConfig] {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GlobalApplicationAlertConfigs")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.ApplicationAlertConfig])
+	ret0, _ := ret[0].(restapi.AlertConfigRestResource[*restapi.ApplicationAlertConfig])
 	return ret0
 }
 
@@ -160,6 +216,48 @@ func (mr *MockInstanaAPIMockRecorder) GlobalApplicationAlertConfigs() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalApplicationAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).GlobalApplicationAlertConfigs))
 }
 
+// GlobalSyntheticAlertConfigs mocks base method.
+func (m *MockInstanaAPI) GlobalSyntheticAlertConfigs() restapi.RestResource[*restapi.SyntheticAlertConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GlobalSyntheticAlertConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SyntheticAlertConfig])
+	return ret0
+}
+
+// GlobalSyntheticAlertConfigs indicates an expected call of GlobalSyntheticAlertConfigs.
+func (mr *MockInstanaAPIMockRecorder) GlobalSyntheticAlertConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalSyntheticAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).GlobalSyntheticAlertConfigs))
+}
+
+// GroupMemberships mocks base method.
+func (m *MockInstanaAPI) GroupMemberships() restapi.RestResource[*restapi.GroupMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMemberships")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GroupMembership])
+	return ret0
+}
+
+// GroupMemberships indicates an expected call of GroupMemberships.
+func (mr *MockInstanaAPIMockRecorder) GroupMemberships() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMemberships", reflect.TypeOf((*MockInstanaAPI)(nil).GroupMemberships))
+}
+
+// GroupPermissionSets mocks base method.
+func (m *MockInstanaAPI) GroupPermissionSets() restapi.RestResource[*restapi.GroupPermissionSet] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupPermissionSets")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GroupPermissionSet])
+	return ret0
+}
+
+// GroupPermissionSets indicates an expected call of GroupPermissionSets.
+func (mr *MockInstanaAPIMockRecorder) GroupPermissionSets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupPermissionSets", reflect.TypeOf((*MockInstanaAPI)(nil).GroupPermissionSets))
+}
+
 // Groups mocks base method.
 func (m *MockInstanaAPI) Groups() restapi.RestResource[*restapi.Group] {
 	m.ctrl.T.Helper()
@@ -174,6 +272,202 @@ func (mr *MockInstanaAPIMockRecorder) Groups() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockInstanaAPI)(nil).Groups))
 }
 
+// HostAgentConfigurations mocks base method.
+func (m *MockInstanaAPI) HostAgentConfigurations() restapi.RestResource[*restapi.HostAgentConfiguration] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostAgentConfigurations")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.HostAgentConfiguration])
+	return ret0
+}
+
+// HostAgentConfigurations indicates an expected call of HostAgentConfigurations.
+func (mr *MockInstanaAPIMockRecorder) HostAgentConfigurations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostAgentConfigurations", reflect.TypeOf((*MockInstanaAPI)(nil).HostAgentConfigurations))
+}
+
+// HostAgents mocks base method.
+func (m *MockInstanaAPI) HostAgents() restapi.HostAgentResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostAgents")
+	ret0, _ := ret[0].(restapi.HostAgentResource)
+	return ret0
+}
+
+// HostAgents indicates an expected call of HostAgents.
+func (mr *MockInstanaAPIMockRecorder) HostAgents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostAgents", reflect.TypeOf((*MockInstanaAPI)(nil).HostAgents))
+}
+
+// HttpEndpointConfigs mocks base method.
+func (m *MockInstanaAPI) HttpEndpointConfigs() restapi.RestResource[*restapi.HttpEndpointConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HttpEndpointConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.HttpEndpointConfig])
+	return ret0
+}
+
+// HttpEndpointConfigs indicates an expected call of HttpEndpointConfigs.
+func (mr *MockInstanaAPIMockRecorder) HttpEndpointConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HttpEndpointConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).HttpEndpointConfigs))
+}
+
+// IdpGroupMappings mocks base method.
+func (m *MockInstanaAPI) IdpGroupMappings() restapi.RestResource[*restapi.IdpGroupMapping] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IdpGroupMappings")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.IdpGroupMapping])
+	return ret0
+}
+
+// IdpGroupMappings indicates an expected call of IdpGroupMappings.
+func (mr *MockInstanaAPIMockRecorder) IdpGroupMappings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdpGroupMappings", reflect.TypeOf((*MockInstanaAPI)(nil).IdpGroupMappings))
+}
+
+// IdpSettings mocks base method.
+func (m *MockInstanaAPI) IdpSettings() restapi.RestResource[*restapi.IdpSettings] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IdpSettings")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.IdpSettings])
+	return ret0
+}
+
+// IdpSettings indicates an expected call of IdpSettings.
+func (mr *MockInstanaAPIMockRecorder) IdpSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdpSettings", reflect.TypeOf((*MockInstanaAPI)(nil).IdpSettings))
+}
+
+// InfraAlertConfigs mocks base method.
+func (m *MockInstanaAPI) InfraAlertConfigs() restapi.RestResource[*restapi.InfraAlertConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InfraAlertConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.InfraAlertConfig])
+	return ret0
+}
+
+// InfraAlertConfigs indicates an expected call of InfraAlertConfigs.
+func (mr *MockInstanaAPIMockRecorder) InfraAlertConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InfraAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).InfraAlertConfigs))
+}
+
+// MaintenanceWindowConfigs mocks base method.
+func (m *MockInstanaAPI) MaintenanceWindowConfigs() restapi.RestResource[*restapi.MaintenanceWindowConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaintenanceWindowConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.MaintenanceWindowConfig])
+	return ret0
+}
+
+// MaintenanceWindowConfigs indicates an expected call of MaintenanceWindowConfigs.
+func (mr *MockInstanaAPIMockRecorder) MaintenanceWindowConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaintenanceWindowConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MaintenanceWindowConfigs))
+}
+
+// ManualServiceConfigs mocks base method.
+func (m *MockInstanaAPI) ManualServiceConfigs() restapi.ManualServiceConfigRestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManualServiceConfigs")
+	ret0, _ := ret[0].(restapi.ManualServiceConfigRestResource)
+	return ret0
+}
+
+// ManualServiceConfigs indicates an expected call of ManualServiceConfigs.
+func (mr *MockInstanaAPIMockRecorder) ManualServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManualServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ManualServiceConfigs))
+}
+
+// MobileAppAlertConfigs mocks base method.
+func (m *MockInstanaAPI) MobileAppAlertConfigs() restapi.BaselineAwareAlertConfigRestResource[*restapi.MobileAppAlertConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppAlertConfigs")
+	ret0, _ := ret[0].(restapi.BaselineAwareAlertConfigRestResource[*restapi.MobileAppAlertConfig])
+	return ret0
+}
+
+// MobileAppAlertConfigs indicates an expected call of MobileAppAlertConfigs.
+func (mr *MockInstanaAPIMockRecorder) MobileAppAlertConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppAlertConfigs))
+}
+
+// MobileAppConfigs mocks base method.
+func (m *MockInstanaAPI) MobileAppConfigs() restapi.RestResource[*restapi.MobileAppConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.MobileAppConfig])
+	return ret0
+}
+
+// MobileAppConfigs indicates an expected call of MobileAppConfigs.
+func (mr *MockInstanaAPIMockRecorder) MobileAppConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppConfigs))
+}
+
+// Releases mocks base method.
+func (m *MockInstanaAPI) Releases() restapi.RestResource[*restapi.Release] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Releases")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.Release])
+	return ret0
+}
+
+// Releases indicates an expected call of Releases.
+func (mr *MockInstanaAPIMockRecorder) Releases() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Releases", reflect.TypeOf((*MockInstanaAPI)(nil).Releases))
+}
+
+// ServiceConfigOrder mocks base method.
+func (m *MockInstanaAPI) ServiceConfigOrder() restapi.RestResource[*restapi.ServiceConfigOrder] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceConfigOrder")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ServiceConfigOrder])
+	return ret0
+}
+
+// ServiceConfigOrder indicates an expected call of ServiceConfigOrder.
+func (mr *MockInstanaAPIMockRecorder) ServiceConfigOrder() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceConfigOrder", reflect.TypeOf((*MockInstanaAPI)(nil).ServiceConfigOrder))
+}
+
+// ServiceConfigs mocks base method.
+func (m *MockInstanaAPI) ServiceConfigs() restapi.RestResource[*restapi.ServiceConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ServiceConfig])
+	return ret0
+}
+
+// ServiceConfigs indicates an expected call of ServiceConfigs.
+func (mr *MockInstanaAPIMockRecorder) ServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ServiceConfigs))
+}
+
+// SessionSettings mocks base method.
+func (m *MockInstanaAPI) SessionSettings() restapi.RestResource[*restapi.SessionSettings] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SessionSettings")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SessionSettings])
+	return ret0
+}
+
+// SessionSettings indicates an expected call of SessionSettings.
+func (mr *MockInstanaAPIMockRecorder) SessionSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionSettings", reflect.TypeOf((*MockInstanaAPI)(nil).SessionSettings))
+}
+
 // SliConfigs mocks base method.
 func (m *MockInstanaAPI) SliConfigs() restapi.RestResource[*restapi.SliConfig] {
 	m.ctrl.T.Helper()
@@ -188,6 +482,48 @@ func (mr *MockInstanaAPIMockRecorder) SliConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SliConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).SliConfigs))
 }
 
+// SourceMapUploadConfigs mocks base method.
+func (m *MockInstanaAPI) SourceMapUploadConfigs() restapi.RestResource[*restapi.SourceMapUploadConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourceMapUploadConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SourceMapUploadConfig])
+	return ret0
+}
+
+// SourceMapUploadConfigs indicates an expected call of SourceMapUploadConfigs.
+func (mr *MockInstanaAPIMockRecorder) SourceMapUploadConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourceMapUploadConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).SourceMapUploadConfigs))
+}
+
+// SyntheticCallsSettings mocks base method.
+func (m *MockInstanaAPI) SyntheticCallsSettings() restapi.RestResource[*restapi.SyntheticCallsSettings] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyntheticCallsSettings")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SyntheticCallsSettings])
+	return ret0
+}
+
+// SyntheticCallsSettings indicates an expected call of SyntheticCallsSettings.
+func (mr *MockInstanaAPIMockRecorder) SyntheticCallsSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticCallsSettings", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticCallsSettings))
+}
+
+// SyntheticCredentials mocks base method.
+func (m *MockInstanaAPI) SyntheticCredentials() restapi.RestResource[*restapi.SyntheticCredential] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyntheticCredentials")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.SyntheticCredential])
+	return ret0
+}
+
+// SyntheticCredentials indicates an expected call of SyntheticCredentials.
+func (mr *MockInstanaAPIMockRecorder) SyntheticCredentials() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticCredentials", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticCredentials))
+}
+
 // SyntheticLocation mocks base method.
 func (m *MockInstanaAPI) SyntheticLocation() restapi.ReadOnlyRestResource[*restapi.SyntheticLocation] {
 	m.ctrl.T.Helper()
@@ -216,11 +552,39 @@ func (mr *MockInstanaAPIMockRecorder) SyntheticTest() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticTest", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticTest))
 }
 
+// UserInvitations mocks base method.
+func (m *MockInstanaAPI) UserInvitations() restapi.RestResource[*restapi.UserInvitation] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserInvitations")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.UserInvitation])
+	return ret0
+}
+
+// UserInvitations indicates an expected call of UserInvitations.
+func (mr *MockInstanaAPIMockRecorder) UserInvitations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserInvitations", reflect.TypeOf((*MockInstanaAPI)(nil).UserInvitations))
+}
+
+// Users mocks base method.
+func (m *MockInstanaAPI) Users() restapi.ReadOnlyRestResource[*restapi.User] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Users")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.User])
+	return ret0
+}
+
+// Users indicates an expected call of Users.
+func (mr *MockInstanaAPIMockRecorder) Users() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockInstanaAPI)(nil).Users))
+}
+
 // WebsiteAlertConfig mocks base method.
-func (m *MockInstanaAPI) WebsiteAlertConfig() restapi.RestResource[*restapi.WebsiteAlertConfig] {
+func (m *MockInstanaAPI) WebsiteAlertConfig() restapi.BaselineAwareAlertConfigRestResource[*restapi.WebsiteAlertConfig] {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WebsiteAlertConfig")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.WebsiteAlertConfig])
+	ret0, _ := ret[0].(restapi.BaselineAwareAlertConfigRestResource[*restapi.WebsiteAlertConfig])
 	return ret0
 }
 
@@ -231,10 +595,10 @@ func (mr *MockInstanaAPIMockRecorder) WebsiteAlertConfig() *gomock.Call {
 }
 
 // WebsiteMonitoringConfig mocks base method.
-func (m *MockInstanaAPI) WebsiteMonitoringConfig() restapi.RestResource[*restapi.WebsiteMonitoringConfig] {
+func (m *MockInstanaAPI) WebsiteMonitoringConfig() restapi.WebsiteMonitoringConfigRestResource {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WebsiteMonitoringConfig")
-	ret0, _ := ret[0].(restapi.RestResource[*restapi.WebsiteMonitoringConfig])
+	ret0, _ := ret[0].(restapi.WebsiteMonitoringConfigRestResource)
 	return ret0
 }
 
@@ -159,6 +159,1061 @@ func (mr *MockRestResourceMockRecorder[T]) Update(data interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRestResource[T])(nil).Update), data)
 }
 
+// MockBaselineAwareRestResource is a mock of BaselineAwareRestResource interface.
+type MockBaselineAwareRestResource[T restapi.InstanaDataObject] struct {
+	ctrl     *gomock.Controller
+	recorder *MockBaselineAwareRestResourceMockRecorder[T]
+}
+
+// MockBaselineAwareRestResourceMockRecorder is the mock recorder for MockBaselineAwareRestResource.
+type MockBaselineAwareRestResourceMockRecorder[T restapi.InstanaDataObject] struct {
+	mock *MockBaselineAwareRestResource[T]
+}
+
+// NewMockBaselineAwareRestResource creates a new mock instance.
+func NewMockBaselineAwareRestResource[T restapi.InstanaDataObject](ctrl *gomock.Controller) *MockBaselineAwareRestResource[T] {
+	mock := &MockBaselineAwareRestResource[T]{ctrl: ctrl}
+	mock.recorder = &MockBaselineAwareRestResourceMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBaselineAwareRestResource[T]) EXPECT() *MockBaselineAwareRestResourceMockRecorder[T] {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBaselineAwareRestResource[T]) Create(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBaselineAwareRestResourceMockRecorder[T]) Create(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBaselineAwareRestResource[T])(nil).Create), data)
+}
+
+// Delete mocks base method.
+func (m *MockBaselineAwareRestResource[T]) Delete(data T) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBaselineAwareRestResourceMockRecorder[T]) Delete(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBaselineAwareRestResource[T])(nil).Delete), data)
+}
+
+// DeleteByID mocks base method.
+func (m *MockBaselineAwareRestResource[T]) DeleteByID(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockBaselineAwareRestResourceMockRecorder[T]) DeleteByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockBaselineAwareRestResource[T])(nil).DeleteByID), id)
+}
+
+// GetAll mocks base method.
+func (m *MockBaselineAwareRestResource[T]) GetAll() (*[]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].(*[]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBaselineAwareRestResourceMockRecorder[T]) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBaselineAwareRestResource[T])(nil).GetAll))
+}
+
+// GetOne mocks base method.
+func (m *MockBaselineAwareRestResource[T]) GetOne(id string) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", id)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne.
+func (mr *MockBaselineAwareRestResourceMockRecorder[T]) GetOne(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockBaselineAwareRestResource[T])(nil).GetOne), id)
+}
+
+// Update mocks base method.
+func (m *MockBaselineAwareRestResource[T]) Update(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBaselineAwareRestResourceMockRecorder[T]) Update(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBaselineAwareRestResource[T])(nil).Update), data)
+}
+
+// UpdateBaseline mocks base method.
+func (m *MockBaselineAwareRestResource[T]) UpdateBaseline(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBaseline", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBaseline indicates an expected call of UpdateBaseline.
+func (mr *MockBaselineAwareRestResourceMockRecorder[T]) UpdateBaseline(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBaseline", reflect.TypeOf((*MockBaselineAwareRestResource[T])(nil).UpdateBaseline), id)
+}
+
+// MockEnableAwareInstanaDataObject is a mock of EnableAwareInstanaDataObject interface.
+type MockEnableAwareInstanaDataObject struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnableAwareInstanaDataObjectMockRecorder
+}
+
+// MockEnableAwareInstanaDataObjectMockRecorder is the mock recorder for MockEnableAwareInstanaDataObject.
+type MockEnableAwareInstanaDataObjectMockRecorder struct {
+	mock *MockEnableAwareInstanaDataObject
+}
+
+// NewMockEnableAwareInstanaDataObject creates a new mock instance.
+func NewMockEnableAwareInstanaDataObject(ctrl *gomock.Controller) *MockEnableAwareInstanaDataObject {
+	mock := &MockEnableAwareInstanaDataObject{ctrl: ctrl}
+	mock.recorder = &MockEnableAwareInstanaDataObjectMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnableAwareInstanaDataObject) EXPECT() *MockEnableAwareInstanaDataObjectMockRecorder {
+	return m.recorder
+}
+
+// GetEnabled mocks base method.
+func (m *MockEnableAwareInstanaDataObject) GetEnabled() *bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnabled")
+	ret0, _ := ret[0].(*bool)
+	return ret0
+}
+
+// GetEnabled indicates an expected call of GetEnabled.
+func (mr *MockEnableAwareInstanaDataObjectMockRecorder) GetEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnabled", reflect.TypeOf((*MockEnableAwareInstanaDataObject)(nil).GetEnabled))
+}
+
+// GetIDForResourcePath mocks base method.
+func (m *MockEnableAwareInstanaDataObject) GetIDForResourcePath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIDForResourcePath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetIDForResourcePath indicates an expected call of GetIDForResourcePath.
+func (mr *MockEnableAwareInstanaDataObjectMockRecorder) GetIDForResourcePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDForResourcePath", reflect.TypeOf((*MockEnableAwareInstanaDataObject)(nil).GetIDForResourcePath))
+}
+
+// MockEnableAwareRestResource is a mock of EnableAwareRestResource interface.
+type MockEnableAwareRestResource[T restapi.EnableAwareInstanaDataObject] struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnableAwareRestResourceMockRecorder[T]
+}
+
+// MockEnableAwareRestResourceMockRecorder is the mock recorder for MockEnableAwareRestResource.
+type MockEnableAwareRestResourceMockRecorder[T restapi.EnableAwareInstanaDataObject] struct {
+	mock *MockEnableAwareRestResource[T]
+}
+
+// NewMockEnableAwareRestResource creates a new mock instance.
+func NewMockEnableAwareRestResource[T restapi.EnableAwareInstanaDataObject](ctrl *gomock.Controller) *MockEnableAwareRestResource[T] {
+	mock := &MockEnableAwareRestResource[T]{ctrl: ctrl}
+	mock.recorder = &MockEnableAwareRestResourceMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnableAwareRestResource[T]) EXPECT() *MockEnableAwareRestResourceMockRecorder[T] {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockEnableAwareRestResource[T]) Create(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockEnableAwareRestResourceMockRecorder[T]) Create(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEnableAwareRestResource[T])(nil).Create), data)
+}
+
+// Delete mocks base method.
+func (m *MockEnableAwareRestResource[T]) Delete(data T) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockEnableAwareRestResourceMockRecorder[T]) Delete(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEnableAwareRestResource[T])(nil).Delete), data)
+}
+
+// DeleteByID mocks base method.
+func (m *MockEnableAwareRestResource[T]) DeleteByID(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockEnableAwareRestResourceMockRecorder[T]) DeleteByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockEnableAwareRestResource[T])(nil).DeleteByID), id)
+}
+
+// GetAll mocks base method.
+func (m *MockEnableAwareRestResource[T]) GetAll() (*[]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].(*[]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockEnableAwareRestResourceMockRecorder[T]) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockEnableAwareRestResource[T])(nil).GetAll))
+}
+
+// GetOne mocks base method.
+func (m *MockEnableAwareRestResource[T]) GetOne(id string) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", id)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne.
+func (mr *MockEnableAwareRestResourceMockRecorder[T]) GetOne(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockEnableAwareRestResource[T])(nil).GetOne), id)
+}
+
+// Update mocks base method.
+func (m *MockEnableAwareRestResource[T]) Update(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockEnableAwareRestResourceMockRecorder[T]) Update(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEnableAwareRestResource[T])(nil).Update), data)
+}
+
+// UpdateEnabledState mocks base method.
+func (m *MockEnableAwareRestResource[T]) UpdateEnabledState(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnabledState", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEnabledState indicates an expected call of UpdateEnabledState.
+func (mr *MockEnableAwareRestResourceMockRecorder[T]) UpdateEnabledState(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnabledState", reflect.TypeOf((*MockEnableAwareRestResource[T])(nil).UpdateEnabledState), data)
+}
+
+// MockVersionsAwareRestResource is a mock of VersionsAwareRestResource interface.
+type MockVersionsAwareRestResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockVersionsAwareRestResourceMockRecorder
+}
+
+// MockVersionsAwareRestResourceMockRecorder is the mock recorder for MockVersionsAwareRestResource.
+type MockVersionsAwareRestResourceMockRecorder struct {
+	mock *MockVersionsAwareRestResource
+}
+
+// NewMockVersionsAwareRestResource creates a new mock instance.
+func NewMockVersionsAwareRestResource(ctrl *gomock.Controller) *MockVersionsAwareRestResource {
+	mock := &MockVersionsAwareRestResource{ctrl: ctrl}
+	mock.recorder = &MockVersionsAwareRestResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVersionsAwareRestResource) EXPECT() *MockVersionsAwareRestResourceMockRecorder {
+	return m.recorder
+}
+
+// GetVersions mocks base method.
+func (m *MockVersionsAwareRestResource) GetVersions(id string) (*[]*restapi.ConfigVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersions", id)
+	ret0, _ := ret[0].(*[]*restapi.ConfigVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersions indicates an expected call of GetVersions.
+func (mr *MockVersionsAwareRestResourceMockRecorder) GetVersions(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockVersionsAwareRestResource)(nil).GetVersions), id)
+}
+
+// RestoreVersion mocks base method.
+func (m *MockVersionsAwareRestResource) RestoreVersion(id string, created int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVersion", id, created)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreVersion indicates an expected call of RestoreVersion.
+func (mr *MockVersionsAwareRestResourceMockRecorder) RestoreVersion(id, created interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVersion", reflect.TypeOf((*MockVersionsAwareRestResource)(nil).RestoreVersion), id, created)
+}
+
+// MockAlertConfigRestResource is a mock of AlertConfigRestResource interface.
+type MockAlertConfigRestResource[T restapi.EnableAwareInstanaDataObject] struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertConfigRestResourceMockRecorder[T]
+}
+
+// MockAlertConfigRestResourceMockRecorder is the mock recorder for MockAlertConfigRestResource.
+type MockAlertConfigRestResourceMockRecorder[T restapi.EnableAwareInstanaDataObject] struct {
+	mock *MockAlertConfigRestResource[T]
+}
+
+// NewMockAlertConfigRestResource creates a new mock instance.
+func NewMockAlertConfigRestResource[T restapi.EnableAwareInstanaDataObject](ctrl *gomock.Controller) *MockAlertConfigRestResource[T] {
+	mock := &MockAlertConfigRestResource[T]{ctrl: ctrl}
+	mock.recorder = &MockAlertConfigRestResourceMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertConfigRestResource[T]) EXPECT() *MockAlertConfigRestResourceMockRecorder[T] {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAlertConfigRestResource[T]) Create(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAlertConfigRestResourceMockRecorder[T]) Create(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAlertConfigRestResource[T])(nil).Create), data)
+}
+
+// Delete mocks base method.
+func (m *MockAlertConfigRestResource[T]) Delete(data T) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAlertConfigRestResourceMockRecorder[T]) Delete(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAlertConfigRestResource[T])(nil).Delete), data)
+}
+
+// DeleteByID mocks base method.
+func (m *MockAlertConfigRestResource[T]) DeleteByID(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockAlertConfigRestResourceMockRecorder[T]) DeleteByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockAlertConfigRestResource[T])(nil).DeleteByID), id)
+}
+
+// GetAll mocks base method.
+func (m *MockAlertConfigRestResource[T]) GetAll() (*[]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].(*[]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAlertConfigRestResourceMockRecorder[T]) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAlertConfigRestResource[T])(nil).GetAll))
+}
+
+// GetOne mocks base method.
+func (m *MockAlertConfigRestResource[T]) GetOne(id string) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", id)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne.
+func (mr *MockAlertConfigRestResourceMockRecorder[T]) GetOne(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockAlertConfigRestResource[T])(nil).GetOne), id)
+}
+
+// GetVersions mocks base method.
+func (m *MockAlertConfigRestResource[T]) GetVersions(id string) (*[]*restapi.ConfigVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersions", id)
+	ret0, _ := ret[0].(*[]*restapi.ConfigVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersions indicates an expected call of GetVersions.
+func (mr *MockAlertConfigRestResourceMockRecorder[T]) GetVersions(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockAlertConfigRestResource[T])(nil).GetVersions), id)
+}
+
+// RestoreVersion mocks base method.
+func (m *MockAlertConfigRestResource[T]) RestoreVersion(id string, created int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVersion", id, created)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreVersion indicates an expected call of RestoreVersion.
+func (mr *MockAlertConfigRestResourceMockRecorder[T]) RestoreVersion(id, created interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVersion", reflect.TypeOf((*MockAlertConfigRestResource[T])(nil).RestoreVersion), id, created)
+}
+
+// Update mocks base method.
+func (m *MockAlertConfigRestResource[T]) Update(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAlertConfigRestResourceMockRecorder[T]) Update(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAlertConfigRestResource[T])(nil).Update), data)
+}
+
+// UpdateEnabledState mocks base method.
+func (m *MockAlertConfigRestResource[T]) UpdateEnabledState(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnabledState", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEnabledState indicates an expected call of UpdateEnabledState.
+func (mr *MockAlertConfigRestResourceMockRecorder[T]) UpdateEnabledState(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnabledState", reflect.TypeOf((*MockAlertConfigRestResource[T])(nil).UpdateEnabledState), data)
+}
+
+// MockBaselineAwareAlertConfigRestResource is a mock of BaselineAwareAlertConfigRestResource interface.
+type MockBaselineAwareAlertConfigRestResource[T restapi.EnableAwareInstanaDataObject] struct {
+	ctrl     *gomock.Controller
+	recorder *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]
+}
+
+// MockBaselineAwareAlertConfigRestResourceMockRecorder is the mock recorder for MockBaselineAwareAlertConfigRestResource.
+type MockBaselineAwareAlertConfigRestResourceMockRecorder[T restapi.EnableAwareInstanaDataObject] struct {
+	mock *MockBaselineAwareAlertConfigRestResource[T]
+}
+
+// NewMockBaselineAwareAlertConfigRestResource creates a new mock instance.
+func NewMockBaselineAwareAlertConfigRestResource[T restapi.EnableAwareInstanaDataObject](ctrl *gomock.Controller) *MockBaselineAwareAlertConfigRestResource[T] {
+	mock := &MockBaselineAwareAlertConfigRestResource[T]{ctrl: ctrl}
+	mock.recorder = &MockBaselineAwareAlertConfigRestResourceMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) EXPECT() *MockBaselineAwareAlertConfigRestResourceMockRecorder[T] {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) Create(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]) Create(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBaselineAwareAlertConfigRestResource[T])(nil).Create), data)
+}
+
+// Delete mocks base method.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) Delete(data T) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]) Delete(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBaselineAwareAlertConfigRestResource[T])(nil).Delete), data)
+}
+
+// DeleteByID mocks base method.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) DeleteByID(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]) DeleteByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockBaselineAwareAlertConfigRestResource[T])(nil).DeleteByID), id)
+}
+
+// GetAll mocks base method.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) GetAll() (*[]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].(*[]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBaselineAwareAlertConfigRestResource[T])(nil).GetAll))
+}
+
+// GetOne mocks base method.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) GetOne(id string) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", id)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne.
+func (mr *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]) GetOne(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockBaselineAwareAlertConfigRestResource[T])(nil).GetOne), id)
+}
+
+// GetVersions mocks base method.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) GetVersions(id string) (*[]*restapi.ConfigVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersions", id)
+	ret0, _ := ret[0].(*[]*restapi.ConfigVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersions indicates an expected call of GetVersions.
+func (mr *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]) GetVersions(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockBaselineAwareAlertConfigRestResource[T])(nil).GetVersions), id)
+}
+
+// RestoreVersion mocks base method.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) RestoreVersion(id string, created int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVersion", id, created)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreVersion indicates an expected call of RestoreVersion.
+func (mr *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]) RestoreVersion(id, created interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVersion", reflect.TypeOf((*MockBaselineAwareAlertConfigRestResource[T])(nil).RestoreVersion), id, created)
+}
+
+// Update mocks base method.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) Update(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]) Update(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBaselineAwareAlertConfigRestResource[T])(nil).Update), data)
+}
+
+// UpdateBaseline mocks base method.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) UpdateBaseline(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBaseline", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBaseline indicates an expected call of UpdateBaseline.
+func (mr *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]) UpdateBaseline(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBaseline", reflect.TypeOf((*MockBaselineAwareAlertConfigRestResource[T])(nil).UpdateBaseline), id)
+}
+
+// UpdateEnabledState mocks base method.
+func (m *MockBaselineAwareAlertConfigRestResource[T]) UpdateEnabledState(data T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnabledState", data)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEnabledState indicates an expected call of UpdateEnabledState.
+func (mr *MockBaselineAwareAlertConfigRestResourceMockRecorder[T]) UpdateEnabledState(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnabledState", reflect.TypeOf((*MockBaselineAwareAlertConfigRestResource[T])(nil).UpdateEnabledState), data)
+}
+
+// MockAlertingChannelRestResource is a mock of AlertingChannelRestResource interface.
+type MockAlertingChannelRestResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertingChannelRestResourceMockRecorder
+}
+
+// MockAlertingChannelRestResourceMockRecorder is the mock recorder for MockAlertingChannelRestResource.
+type MockAlertingChannelRestResourceMockRecorder struct {
+	mock *MockAlertingChannelRestResource
+}
+
+// NewMockAlertingChannelRestResource creates a new mock instance.
+func NewMockAlertingChannelRestResource(ctrl *gomock.Controller) *MockAlertingChannelRestResource {
+	mock := &MockAlertingChannelRestResource{ctrl: ctrl}
+	mock.recorder = &MockAlertingChannelRestResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertingChannelRestResource) EXPECT() *MockAlertingChannelRestResourceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAlertingChannelRestResource) Create(data *restapi.AlertingChannel) (*restapi.AlertingChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", data)
+	ret0, _ := ret[0].(*restapi.AlertingChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAlertingChannelRestResourceMockRecorder) Create(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAlertingChannelRestResource)(nil).Create), data)
+}
+
+// Delete mocks base method.
+func (m *MockAlertingChannelRestResource) Delete(data *restapi.AlertingChannel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAlertingChannelRestResourceMockRecorder) Delete(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAlertingChannelRestResource)(nil).Delete), data)
+}
+
+// DeleteByID mocks base method.
+func (m *MockAlertingChannelRestResource) DeleteByID(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockAlertingChannelRestResourceMockRecorder) DeleteByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockAlertingChannelRestResource)(nil).DeleteByID), id)
+}
+
+// GetAll mocks base method.
+func (m *MockAlertingChannelRestResource) GetAll() (*[]*restapi.AlertingChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].(*[]*restapi.AlertingChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAlertingChannelRestResourceMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAlertingChannelRestResource)(nil).GetAll))
+}
+
+// GetOne mocks base method.
+func (m *MockAlertingChannelRestResource) GetOne(id string) (*restapi.AlertingChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", id)
+	ret0, _ := ret[0].(*restapi.AlertingChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne.
+func (mr *MockAlertingChannelRestResourceMockRecorder) GetOne(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockAlertingChannelRestResource)(nil).GetOne), id)
+}
+
+// SendTestNotification mocks base method.
+func (m *MockAlertingChannelRestResource) SendTestNotification(channel *restapi.AlertingChannel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTestNotification", channel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendTestNotification indicates an expected call of SendTestNotification.
+func (mr *MockAlertingChannelRestResourceMockRecorder) SendTestNotification(channel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTestNotification", reflect.TypeOf((*MockAlertingChannelRestResource)(nil).SendTestNotification), channel)
+}
+
+// Update mocks base method.
+func (m *MockAlertingChannelRestResource) Update(data *restapi.AlertingChannel) (*restapi.AlertingChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", data)
+	ret0, _ := ret[0].(*restapi.AlertingChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAlertingChannelRestResourceMockRecorder) Update(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAlertingChannelRestResource)(nil).Update), data)
+}
+
+// MockManualServiceConfigRestResource is a mock of ManualServiceConfigRestResource interface.
+type MockManualServiceConfigRestResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockManualServiceConfigRestResourceMockRecorder
+}
+
+// MockManualServiceConfigRestResourceMockRecorder is the mock recorder for MockManualServiceConfigRestResource.
+type MockManualServiceConfigRestResourceMockRecorder struct {
+	mock *MockManualServiceConfigRestResource
+}
+
+// NewMockManualServiceConfigRestResource creates a new mock instance.
+func NewMockManualServiceConfigRestResource(ctrl *gomock.Controller) *MockManualServiceConfigRestResource {
+	mock := &MockManualServiceConfigRestResource{ctrl: ctrl}
+	mock.recorder = &MockManualServiceConfigRestResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManualServiceConfigRestResource) EXPECT() *MockManualServiceConfigRestResourceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockManualServiceConfigRestResource) Create(data *restapi.ManualServiceConfig) (*restapi.ManualServiceConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", data)
+	ret0, _ := ret[0].(*restapi.ManualServiceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockManualServiceConfigRestResourceMockRecorder) Create(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockManualServiceConfigRestResource)(nil).Create), data)
+}
+
+// Delete mocks base method.
+func (m *MockManualServiceConfigRestResource) Delete(data *restapi.ManualServiceConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockManualServiceConfigRestResourceMockRecorder) Delete(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockManualServiceConfigRestResource)(nil).Delete), data)
+}
+
+// DeleteByID mocks base method.
+func (m *MockManualServiceConfigRestResource) DeleteByID(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockManualServiceConfigRestResourceMockRecorder) DeleteByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockManualServiceConfigRestResource)(nil).DeleteByID), id)
+}
+
+// GetAll mocks base method.
+func (m *MockManualServiceConfigRestResource) GetAll() (*[]*restapi.ManualServiceConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].(*[]*restapi.ManualServiceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockManualServiceConfigRestResourceMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockManualServiceConfigRestResource)(nil).GetAll))
+}
+
+// GetOne mocks base method.
+func (m *MockManualServiceConfigRestResource) GetOne(id string) (*restapi.ManualServiceConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", id)
+	ret0, _ := ret[0].(*restapi.ManualServiceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne.
+func (mr *MockManualServiceConfigRestResourceMockRecorder) GetOne(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockManualServiceConfigRestResource)(nil).GetOne), id)
+}
+
+// ReplaceAll mocks base method.
+func (m *MockManualServiceConfigRestResource) ReplaceAll(configs restapi.ManualServiceConfigs) (*[]*restapi.ManualServiceConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAll", configs)
+	ret0, _ := ret[0].(*[]*restapi.ManualServiceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceAll indicates an expected call of ReplaceAll.
+func (mr *MockManualServiceConfigRestResourceMockRecorder) ReplaceAll(configs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAll", reflect.TypeOf((*MockManualServiceConfigRestResource)(nil).ReplaceAll), configs)
+}
+
+// Update mocks base method.
+func (m *MockManualServiceConfigRestResource) Update(data *restapi.ManualServiceConfig) (*restapi.ManualServiceConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", data)
+	ret0, _ := ret[0].(*restapi.ManualServiceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockManualServiceConfigRestResourceMockRecorder) Update(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockManualServiceConfigRestResource)(nil).Update), data)
+}
+
+// MockWebsiteMonitoringConfigRestResource is a mock of WebsiteMonitoringConfigRestResource interface.
+type MockWebsiteMonitoringConfigRestResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebsiteMonitoringConfigRestResourceMockRecorder
+}
+
+// MockWebsiteMonitoringConfigRestResourceMockRecorder is the mock recorder for MockWebsiteMonitoringConfigRestResource.
+type MockWebsiteMonitoringConfigRestResourceMockRecorder struct {
+	mock *MockWebsiteMonitoringConfigRestResource
+}
+
+// NewMockWebsiteMonitoringConfigRestResource creates a new mock instance.
+func NewMockWebsiteMonitoringConfigRestResource(ctrl *gomock.Controller) *MockWebsiteMonitoringConfigRestResource {
+	mock := &MockWebsiteMonitoringConfigRestResource{ctrl: ctrl}
+	mock.recorder = &MockWebsiteMonitoringConfigRestResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebsiteMonitoringConfigRestResource) EXPECT() *MockWebsiteMonitoringConfigRestResourceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebsiteMonitoringConfigRestResource) Create(data *restapi.WebsiteMonitoringConfig) (*restapi.WebsiteMonitoringConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", data)
+	ret0, _ := ret[0].(*restapi.WebsiteMonitoringConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebsiteMonitoringConfigRestResourceMockRecorder) Create(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebsiteMonitoringConfigRestResource)(nil).Create), data)
+}
+
+// Delete mocks base method.
+func (m *MockWebsiteMonitoringConfigRestResource) Delete(data *restapi.WebsiteMonitoringConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebsiteMonitoringConfigRestResourceMockRecorder) Delete(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebsiteMonitoringConfigRestResource)(nil).Delete), data)
+}
+
+// DeleteByID mocks base method.
+func (m *MockWebsiteMonitoringConfigRestResource) DeleteByID(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockWebsiteMonitoringConfigRestResourceMockRecorder) DeleteByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockWebsiteMonitoringConfigRestResource)(nil).DeleteByID), id)
+}
+
+// GetAll mocks base method.
+func (m *MockWebsiteMonitoringConfigRestResource) GetAll() (*[]*restapi.WebsiteMonitoringConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].(*[]*restapi.WebsiteMonitoringConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockWebsiteMonitoringConfigRestResourceMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockWebsiteMonitoringConfigRestResource)(nil).GetAll))
+}
+
+// GetOne mocks base method.
+func (m *MockWebsiteMonitoringConfigRestResource) GetOne(id string) (*restapi.WebsiteMonitoringConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", id)
+	ret0, _ := ret[0].(*restapi.WebsiteMonitoringConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne.
+func (mr *MockWebsiteMonitoringConfigRestResourceMockRecorder) GetOne(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockWebsiteMonitoringConfigRestResource)(nil).GetOne), id)
+}
+
+// GetPrivacySettings mocks base method.
+func (m *MockWebsiteMonitoringConfigRestResource) GetPrivacySettings(id string, selection restapi.MonitoringPrivacySettingsSelection) (restapi.MonitoringPrivacySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivacySettings", id, selection)
+	ret0, _ := ret[0].(restapi.MonitoringPrivacySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacySettings indicates an expected call of GetPrivacySettings.
+func (mr *MockWebsiteMonitoringConfigRestResourceMockRecorder) GetPrivacySettings(id, selection interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacySettings", reflect.TypeOf((*MockWebsiteMonitoringConfigRestResource)(nil).GetPrivacySettings), id, selection)
+}
+
+// Update mocks base method.
+func (m *MockWebsiteMonitoringConfigRestResource) Update(data *restapi.WebsiteMonitoringConfig) (*restapi.WebsiteMonitoringConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", data)
+	ret0, _ := ret[0].(*restapi.WebsiteMonitoringConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebsiteMonitoringConfigRestResourceMockRecorder) Update(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebsiteMonitoringConfigRestResource)(nil).Update), data)
+}
+
 // MockReadOnlyRestResource is a mock of ReadOnlyRestResource interface.
 type MockReadOnlyRestResource[T restapi.InstanaDataObject] struct {
 	ctrl     *gomock.Controller
@@ -212,6 +1267,82 @@ func (mr *MockReadOnlyRestResourceMockRecorder[T]) GetOne(id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockReadOnlyRestResource[T])(nil).GetOne), id)
 }
 
+// MockApdexReportResource is a mock of ApdexReportResource interface.
+type MockApdexReportResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockApdexReportResourceMockRecorder
+}
+
+// MockApdexReportResourceMockRecorder is the mock recorder for MockApdexReportResource.
+type MockApdexReportResourceMockRecorder struct {
+	mock *MockApdexReportResource
+}
+
+// NewMockApdexReportResource creates a new mock instance.
+func NewMockApdexReportResource(ctrl *gomock.Controller) *MockApdexReportResource {
+	mock := &MockApdexReportResource{ctrl: ctrl}
+	mock.recorder = &MockApdexReportResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApdexReportResource) EXPECT() *MockApdexReportResourceMockRecorder {
+	return m.recorder
+}
+
+// GetReports mocks base method.
+func (m *MockApdexReportResource) GetReports(apdexID string, from, to int64) ([]*restapi.ApdexReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", apdexID, from, to)
+	ret0, _ := ret[0].([]*restapi.ApdexReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockApdexReportResourceMockRecorder) GetReports(apdexID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockApdexReportResource)(nil).GetReports), apdexID, from, to)
+}
+
+// MockHostAgentResource is a mock of HostAgentResource interface.
+type MockHostAgentResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockHostAgentResourceMockRecorder
+}
+
+// MockHostAgentResourceMockRecorder is the mock recorder for MockHostAgentResource.
+type MockHostAgentResourceMockRecorder struct {
+	mock *MockHostAgentResource
+}
+
+// NewMockHostAgentResource creates a new mock instance.
+func NewMockHostAgentResource(ctrl *gomock.Controller) *MockHostAgentResource {
+	mock := &MockHostAgentResource{ctrl: ctrl}
+	mock.recorder = &MockHostAgentResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHostAgentResource) EXPECT() *MockHostAgentResourceMockRecorder {
+	return m.recorder
+}
+
+// GetHostAgents mocks base method.
+func (m *MockHostAgentResource) GetHostAgents(query string, offline bool) ([]*restapi.HostAgent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostAgents", query, offline)
+	ret0, _ := ret[0].([]*restapi.HostAgent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostAgents indicates an expected call of GetHostAgents.
+func (mr *MockHostAgentResourceMockRecorder) GetHostAgents(query, offline interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostAgents", reflect.TypeOf((*MockHostAgentResource)(nil).GetHostAgents), query, offline)
+}
+
 // MockJSONUnmarshaller is a mock of JSONUnmarshaller interface.
 type MockJSONUnmarshaller[T any] struct {
 	ctrl     *gomock.Controller
@@ -48,6 +48,34 @@ func (mr *MockRestClientMockRecorder) Delete(resourceID, resourceBasePath interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestClient)(nil).Delete), resourceID, resourceBasePath)
 }
 
+// DeleteByQuery mocks base method.
+func (m *MockRestClient) DeleteByQuery(resourcePath string, queryParams map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByQuery", resourcePath, queryParams)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByQuery indicates an expected call of DeleteByQuery.
+func (mr *MockRestClientMockRecorder) DeleteByQuery(resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByQuery", reflect.TypeOf((*MockRestClient)(nil).DeleteByQuery), resourcePath, queryParams)
+}
+
+// DeleteWithoutID mocks base method.
+func (m *MockRestClient) DeleteWithoutID(resourcePath string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithoutID", resourcePath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWithoutID indicates an expected call of DeleteWithoutID.
+func (mr *MockRestClientMockRecorder) DeleteWithoutID(resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithoutID", reflect.TypeOf((*MockRestClient)(nil).DeleteWithoutID), resourcePath)
+}
+
 // Get mocks base method.
 func (m *MockRestClient) Get(resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +91,36 @@ func (mr *MockRestClientMockRecorder) Get(resourcePath interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRestClient)(nil).Get), resourcePath)
 }
 
+// GetByQuery mocks base method.
+func (m *MockRestClient) GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByQuery", resourcePath, queryParams)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByQuery indicates an expected call of GetByQuery.
+func (mr *MockRestClientMockRecorder) GetByQuery(resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByQuery", reflect.TypeOf((*MockRestClient)(nil).GetByQuery), resourcePath, queryParams)
+}
+
+// GetCSV mocks base method.
+func (m *MockRestClient) GetCSV(resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCSV", resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCSV indicates an expected call of GetCSV.
+func (mr *MockRestClientMockRecorder) GetCSV(resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCSV", reflect.TypeOf((*MockRestClient)(nil).GetCSV), resourcePath)
+}
+
 // GetOne mocks base method.
 func (m *MockRestClient) GetOne(id, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +181,21 @@ func (mr *MockRestClientMockRecorder) PostWithID(data, resourcePath interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithID", reflect.TypeOf((*MockRestClient)(nil).PostWithID), data, resourcePath)
 }
 
+// PostWithQuery mocks base method.
+func (m *MockRestClient) PostWithQuery(data restapi.InstanaDataObject, resourcePath string, queryParams map[string]string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostWithQuery", data, resourcePath, queryParams)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostWithQuery indicates an expected call of PostWithQuery.
+func (mr *MockRestClientMockRecorder) PostWithQuery(data, resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithQuery", reflect.TypeOf((*MockRestClient)(nil).PostWithQuery), data, resourcePath, queryParams)
+}
+
 // Put mocks base method.
 func (m *MockRestClient) Put(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -152,3 +225,63 @@ func (mr *MockRestClientMockRecorder) PutByQuery(resourcePath, is, queryParams i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutByQuery", reflect.TypeOf((*MockRestClient)(nil).PutByQuery), resourcePath, is, queryParams)
 }
+
+// PutCSV mocks base method.
+func (m *MockRestClient) PutCSV(data, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutCSV", data, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutCSV indicates an expected call of PutCSV.
+func (mr *MockRestClientMockRecorder) PutCSV(data, resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCSV", reflect.TypeOf((*MockRestClient)(nil).PutCSV), data, resourcePath)
+}
+
+// PutMultipartForm mocks base method.
+func (m *MockRestClient) PutMultipartForm(resourcePath string, formData map[string]string, file restapi.MultipartFile) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutMultipartForm", resourcePath, formData, file)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutMultipartForm indicates an expected call of PutMultipartForm.
+func (mr *MockRestClientMockRecorder) PutMultipartForm(resourcePath, formData, file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMultipartForm", reflect.TypeOf((*MockRestClient)(nil).PutMultipartForm), resourcePath, formData, file)
+}
+
+// PutWithoutBody mocks base method.
+func (m *MockRestClient) PutWithoutBody(resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWithoutBody", resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWithoutBody indicates an expected call of PutWithoutBody.
+func (mr *MockRestClientMockRecorder) PutWithoutBody(resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithoutBody", reflect.TypeOf((*MockRestClient)(nil).PutWithoutBody), resourcePath)
+}
+
+// PutWithoutID mocks base method.
+func (m *MockRestClient) PutWithoutID(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWithoutID", data, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWithoutID indicates an expected call of PutWithoutID.
+func (mr *MockRestClientMockRecorder) PutWithoutID(data, resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithoutID", reflect.TypeOf((*MockRestClient)(nil).PutWithoutID), data, resourcePath)
+}